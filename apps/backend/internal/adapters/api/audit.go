@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/go-faster/jx"
+)
+
+func (h *dobbyHandler) ListAuditEntries(ctx context.Context, params oas.ListAuditEntriesParams) ([]oas.AuditEntry, error) {
+	log.Println("Got a request GET /audit")
+
+	entries, err := h.financeService.ListAuditEntries(ctx, params.ToLogicModel())
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	res := make([]oas.AuditEntry, len(entries))
+	for i, e := range entries {
+		entry, err := mapAuditEntryToOAS(&e)
+		if err != nil {
+			return nil, h.NewError(ctx, err)
+		}
+		res[i] = *entry
+	}
+	return res, nil
+}
+
+func mapAuditEntryToOAS(e *service.AuditEntry) (*oas.AuditEntry, error) {
+	res := &oas.AuditEntry{
+		ID:       e.ID,
+		At:       e.At,
+		Entity:   oas.AuditEntity(e.Entity),
+		EntityId: e.EntityID,
+		Action:   oas.AuditEntryAction(e.Action),
+	}
+	if e.ActorID != nil {
+		res.ActorId = oas.NewOptUUID(*e.ActorID)
+	}
+	if e.Before != nil {
+		before, err := snapshotToOAS(e.Before)
+		if err != nil {
+			return nil, err
+		}
+		res.Before = oas.NewOptAuditEntryBefore(oas.AuditEntryBefore(before))
+	}
+	if e.After != nil {
+		after, err := snapshotToOAS(e.After)
+		if err != nil {
+			return nil, err
+		}
+		res.After = oas.NewOptAuditEntryAfter(oas.AuditEntryAfter(after))
+	}
+	return res, nil
+}
+
+func snapshotToOAS(raw json.RawMessage) (map[string]jx.Raw, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	res := make(map[string]jx.Raw, len(fields))
+	for k, v := range fields {
+		res[k] = jx.Raw(v)
+	}
+	return res, nil
+}
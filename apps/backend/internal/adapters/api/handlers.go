@@ -17,22 +17,30 @@ var _ oas.Handler = (*dobbyHandler)(nil)
 func (h *dobbyHandler) GetCurrentUser(ctx context.Context) (*oas.User, error) {
 	log.Println("Got a request @/me")
 
-	idStr, ok := GetUserID(ctx)
-	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
-	userName := "TheMan"
-
-	if ok {
-		log.Printf("Authenticated User ID: %s\n", idStr)
-		if parsed, err := uuid.Parse(idStr); err == nil {
-			userID = parsed
-		}
-		userName = "Authenticated User"
+	userID, ok := service.UserIDFromContext(ctx)
+	if !ok {
+		return nil, h.NewError(ctx, errors.New("no authenticated user in context"))
 	}
 
-	return &oas.User{
-		ID:   userID,
-		Name: userName,
-	}, nil
+	u, err := h.financeService.GetUser(ctx, userID)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return mapUserToOAS(u), nil
+}
+
+func (h *dobbyHandler) ListUsers(ctx context.Context) ([]oas.User, error) {
+	log.Println("Got a request @/users")
+	users, err := h.financeService.ListUsers(ctx)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	res := make([]oas.User, len(users))
+	for i, u := range users {
+		res[i] = *mapUserToOAS(&u)
+	}
+	return res, nil
 }
 
 func (h *dobbyHandler) GetCurrentPeriod(ctx context.Context) (*oas.PeriodSummary, error) {
@@ -57,7 +65,34 @@ func (h *dobbyHandler) GetPeriod(ctx context.Context, params oas.GetPeriodParams
 		return nil, h.NewError(ctx, err)
 	}
 
-	return mapPeriodSummaryToOAS(summary), nil
+	return &oas.PeriodSummaryHeaders{ETag: etag(summary.Period.Version), Response: *mapPeriodSummaryToOAS(summary)}, nil
+}
+
+func (h *dobbyHandler) GetMemberSpending(ctx context.Context, params oas.GetMemberSpendingParams) (oas.GetMemberSpendingRes, error) {
+	log.Printf("Got a request GET /periods/%s/member-spending\n", params.PeriodId)
+
+	members, err := h.financeService.GetMemberSpending(ctx, params.PeriodId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.GetMemberSpendingNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	res := make(oas.GetMemberSpendingOKApplicationJSON, len(members))
+	for i, m := range members {
+		res[i] = oas.MemberSpending{
+			UserId:           optUUIDFromPtr(m.UserID),
+			FormerMember:     oas.NewOptBool(m.FormerMember),
+			Spent:            m.Spent,
+			Income:           m.Income,
+			TransactionCount: m.TransactionCount,
+		}
+		if m.UserName != "" {
+			res[i].UserName = oas.NewOptString(m.UserName)
+		}
+	}
+	return &res, nil
 }
 
 func (h *dobbyHandler) ListEnvelopes(ctx context.Context) ([]oas.Envelope, error) {
@@ -69,10 +104,7 @@ func (h *dobbyHandler) ListEnvelopes(ctx context.Context) ([]oas.Envelope, error
 
 	res := make([]oas.Envelope, len(envelopes))
 	for i, e := range envelopes {
-		res[i] = oas.Envelope{
-			ID:   e.ID,
-			Name: e.Name,
-		}
+		res[i] = *mapEnvelopeToOAS(&e)
 	}
 	return res, nil
 }
@@ -97,53 +129,131 @@ func (h *dobbyHandler) ListPeriods(ctx context.Context) ([]oas.PeriodListItem, e
 	return res, nil
 }
 
+func (h *dobbyHandler) ListPeriodGaps(ctx context.Context) ([]oas.PeriodGap, error) {
+	log.Println("Got a request @/periods/gaps")
+	gaps, err := h.financeService.ListPeriodGaps(ctx)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	res := make([]oas.PeriodGap, len(gaps))
+	for i, g := range gaps {
+		res[i] = oas.PeriodGap{StartDate: g.Start, EndDate: g.End}
+	}
+	return res, nil
+}
+
 func (h *dobbyHandler) UpdatePeriod(ctx context.Context, req *oas.UpdatePeriod, params oas.UpdatePeriodParams) (oas.UpdatePeriodRes, error) {
 	log.Printf("Got a request PATCH /periods/%s\n", params.PeriodId)
 
-	if !req.DefaultEnvelopeId.IsSet() {
-		summary, err := h.financeService.GetPeriodSummary(ctx, params.PeriodId)
-		if err != nil {
-			if errors.Is(err, service.ErrNotFound) {
-				return &oas.UpdatePeriodNotFound{}, nil
-			}
-			return nil, h.NewError(ctx, err)
-		}
-		return mapPeriodSummaryToOAS(summary), nil
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
 	}
 
-	var defaultEnvID *uuid.UUID
-	if !req.DefaultEnvelopeId.IsNull() {
-		// IsSet() and !IsNull() are already confirmed above, so Get() always succeeds here.
-		id, _ := req.DefaultEnvelopeId.Get()
-		defaultEnvID = &id
+	existing, err := h.financeService.GetPeriod(ctx, params.PeriodId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.UpdatePeriodNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
 	}
+	p := *existing
+	req.ApplyToModel(&p)
+	p.Version = version
 
-	summary, err := h.financeService.UpdatePeriod(ctx, params.PeriodId, defaultEnvID)
+	summary, err := h.financeService.UpdatePeriod(ctx, p)
 	if err != nil {
 		if errors.Is(err, service.ErrNotFound) {
 			return &oas.UpdatePeriodNotFound{}, nil
 		}
 		return nil, h.NewError(ctx, err)
 	}
-	return mapPeriodSummaryToOAS(summary), nil
+	return &oas.PeriodSummaryHeaders{ETag: etag(summary.Period.Version), Response: *mapPeriodSummaryToOAS(summary)}, nil
+}
+
+func (h *dobbyHandler) DeletePeriod(ctx context.Context, params oas.DeletePeriodParams) (oas.DeletePeriodRes, error) {
+	log.Printf("Got a request DELETE /periods/%s\n", params.PeriodId)
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	err = h.financeService.DeletePeriod(ctx, params.PeriodId, version)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.DeletePeriodNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return &oas.DeletePeriodNoContent{}, nil
 }
 
-func (h *dobbyHandler) CreateEnvelope(ctx context.Context, req *oas.CreateEnvelope) (*oas.Envelope, error) {
+func (h *dobbyHandler) GetEnvelope(ctx context.Context, params oas.GetEnvelopeParams) (oas.GetEnvelopeRes, error) {
+	log.Printf("Got a request GET /envelopes/%s\n", params.EnvelopeId)
+
+	env, err := h.financeService.GetEnvelope(ctx, params.EnvelopeId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.GetEnvelopeNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return &oas.EnvelopeHeaders{ETag: etag(env.Version), Response: *mapEnvelopeToOAS(env)}, nil
+}
+
+func (h *dobbyHandler) UpdateEnvelope(ctx context.Context, req *oas.UpdateEnvelope, params oas.UpdateEnvelopeParams) (oas.UpdateEnvelopeRes, error) {
+	log.Printf("Got a request PATCH /envelopes/%s\n", params.EnvelopeId)
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	existing, err := h.financeService.GetEnvelope(ctx, params.EnvelopeId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.UpdateEnvelopeNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	req.ApplyToModel(existing)
+	existing.Version = version
+
+	updated, err := h.financeService.UpdateEnvelope(ctx, *existing)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.UpdateEnvelopeNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return &oas.EnvelopeHeaders{ETag: etag(updated.Version), Response: *mapEnvelopeToOAS(updated)}, nil
+}
+
+func (h *dobbyHandler) CreateEnvelope(ctx context.Context, req *oas.CreateEnvelope, params oas.CreateEnvelopeParams) (*oas.Envelope, error) {
 	log.Println("Got a request POST /envelopes")
+	ctx = withIdempotencyKey(ctx, params.IdempotencyKey)
 	env, err := h.financeService.CreateEnvelope(ctx, req.ToLogicModel())
 	if err != nil {
 		return nil, h.NewError(ctx, err)
 	}
-	return &oas.Envelope{
-		ID:   env.ID,
-		Name: env.Name,
-	}, nil
+	return mapEnvelopeToOAS(env), nil
 }
 
 func (h *dobbyHandler) DeleteEnvelope(ctx context.Context, params oas.DeleteEnvelopeParams) (oas.DeleteEnvelopeRes, error) {
 	log.Printf("Got a request DELETE /envelopes/%s\n", params.EnvelopeId)
 
-	err := h.financeService.DeleteEnvelope(ctx, params.EnvelopeId)
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	err = h.financeService.DeleteEnvelope(ctx, params.EnvelopeId, version)
 	if err != nil {
 		if errors.Is(err, service.ErrNotFound) {
 			return &oas.DeleteEnvelopeNotFound{}, nil
@@ -154,9 +264,10 @@ func (h *dobbyHandler) DeleteEnvelope(ctx context.Context, params oas.DeleteEnve
 	return &oas.DeleteEnvelopeNoContent{}, nil
 }
 
-func (h *dobbyHandler) CreatePeriod(ctx context.Context, req *oas.CreatePeriod) (*oas.PeriodSummary, error) {
+func (h *dobbyHandler) CreatePeriod(ctx context.Context, req *oas.CreatePeriod, params oas.CreatePeriodParams) (*oas.PeriodSummary, error) {
 	log.Println("Got a request POST /periods")
-	p, err := h.financeService.CreatePeriod(ctx, &req.StartDate, &req.EndDate)
+	ctx = withIdempotencyKey(ctx, params.IdempotencyKey)
+	p, err := h.financeService.CreatePeriod(ctx, ptrFromOptDate(req.StartDate), ptrFromOptDate(req.EndDate))
 	if err != nil {
 		return nil, h.NewError(ctx, err)
 	}
@@ -169,56 +280,60 @@ func (h *dobbyHandler) CreatePeriod(ctx context.Context, req *oas.CreatePeriod)
 	return mapPeriodSummaryToOAS(summary), nil
 }
 
-func (h *dobbyHandler) CreateTransaction(ctx context.Context, req *oas.CreateTransaction) (oas.CreateTransactionRes, error) {
+func (h *dobbyHandler) CreateTransaction(ctx context.Context, req *oas.CreateTransaction, params oas.CreateTransactionParams) (oas.CreateTransactionRes, error) {
 	log.Println("Got a request POST /transactions")
+	ctx = withIdempotencyKey(ctx, params.IdempotencyKey)
 
 	t := req.ToLogicModel()
-	if t.Date.IsZero() {
-		t.Date = time.Now()
-	}
 
-	periods, err := h.financeService.ListPeriods(ctx)
+	recorded, err := h.financeService.RecordTransaction(ctx, t)
 	if err != nil {
 		return nil, h.NewError(ctx, err)
 	}
 
-	var periodID uuid.UUID
-	for _, p := range periods {
-		if (t.Date.After(p.StartDate) || t.Date.Equal(p.StartDate)) && (t.Date.Before(p.EndDate) || t.Date.Equal(p.EndDate)) {
-			periodID = p.ID
-			break
-		}
-	}
+	return mapTransactionToOAS(recorded), nil
+}
 
-	if periodID == uuid.Nil {
-		return nil, h.NewError(ctx, errors.New("no period found for transaction date"))
-	}
+func (h *dobbyHandler) ListTransactions(ctx context.Context, params oas.ListTransactionsParams) (*oas.ListTransactionsOKHeaders, error) {
+	log.Println("Got a request GET /transactions")
 
-	t.PeriodID = periodID
-	recorded, err := h.financeService.RecordTransaction(ctx, t)
+	page, err := h.financeService.ListTransactions(ctx, params.ToLogicModel(), params.Cursor.Or(""))
 	if err != nil {
 		return nil, h.NewError(ctx, err)
 	}
 
-	return mapTransactionToOAS(recorded), nil
+	res := &oas.ListTransactionsOKHeaders{
+		Response: make([]oas.Transaction, len(page.Transactions)),
+	}
+	for i, t := range page.Transactions {
+		res.Response[i] = *mapTransactionToOAS(&t)
+	}
+	if page.NextCursor != "" {
+		res.XNextCursor = oas.NewOptString(page.NextCursor)
+	}
+	return res, nil
 }
 
-func (h *dobbyHandler) ListTransactions(ctx context.Context, params oas.ListTransactionsParams) ([]oas.Transaction, error) {
-	log.Println("Got a request GET /transactions")
+func (h *dobbyHandler) SearchTransactions(ctx context.Context, params oas.SearchTransactionsParams) ([]oas.TransactionSearchResult, error) {
+	log.Printf("Got a request GET /transactions/search?q=%s\n", params.Q)
 
-	filter := service.TransactionFilter{}
-	if v, ok := params.PeriodId.Get(); ok {
-		filter.PeriodID = &v
-	}
-
-	transactions, err := h.financeService.ListTransactions(ctx, filter)
+	results, err := h.financeService.SearchTransactions(ctx, params.Q, params.Limit.Or(0))
 	if err != nil {
 		return nil, h.NewError(ctx, err)
 	}
 
-	res := make([]oas.Transaction, len(transactions))
-	for i, t := range transactions {
-		res[i] = *mapTransactionToOAS(&t)
+	res := make([]oas.TransactionSearchResult, len(results))
+	for i, r := range results {
+		highlights := make([]oas.TextRange, len(r.Highlights))
+		for j, hl := range r.Highlights {
+			highlights[j] = oas.TextRange{Start: hl.Start, End: hl.End}
+		}
+		res[i] = oas.TransactionSearchResult{
+			Transaction: *mapTransactionToOAS(&r.Transaction),
+			Rank:        r.Rank,
+			Snippet:     r.Snippet,
+			Highlights:  highlights,
+		}
 	}
 	return res, nil
 }
@@ -234,12 +349,17 @@ func (h *dobbyHandler) GetTransaction(ctx context.Context, params oas.GetTransac
 		return nil, h.NewError(ctx, err)
 	}
 
-	return mapTransactionToOAS(t), nil
+	return &oas.TransactionHeaders{ETag: etag(t.Version), Response: *mapTransactionToOAS(t)}, nil
 }
 
 func (h *dobbyHandler) UpdateTransaction(ctx context.Context, req *oas.UpdateTransaction, params oas.UpdateTransactionParams) (oas.UpdateTransactionRes, error) {
 	log.Printf("Got a request PATCH /transactions/%s\n", params.TransactionId)
 
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
 	existing, err := h.financeService.GetTransaction(ctx, params.TransactionId)
 	if err != nil {
 		if errors.Is(err, service.ErrNotFound) {
@@ -249,6 +369,7 @@ func (h *dobbyHandler) UpdateTransaction(ctx context.Context, req *oas.UpdateTra
 	}
 
 	req.ApplyToModel(existing)
+	existing.Version = version
 
 	// If date changed, we might need to update PeriodID
 	if _, ok := req.Date.Get(); ok {
@@ -259,7 +380,7 @@ func (h *dobbyHandler) UpdateTransaction(ctx context.Context, req *oas.UpdateTra
 
 		var periodID uuid.UUID
 		for _, p := range periods {
-			if (existing.Date.After(p.StartDate) || existing.Date.Equal(p.StartDate)) && (existing.Date.Before(p.EndDate) || existing.Date.Equal(p.EndDate)) {
+			if p.Contains(existing.Date) {
 				periodID = p.ID
 				break
 			}
@@ -275,13 +396,18 @@ func (h *dobbyHandler) UpdateTransaction(ctx context.Context, req *oas.UpdateTra
 		return nil, h.NewError(ctx, err)
 	}
 
-	return mapTransactionToOAS(updated), nil
+	return &oas.TransactionHeaders{ETag: etag(updated.Version), Response: *mapTransactionToOAS(updated)}, nil
 }
 
 func (h *dobbyHandler) DeleteTransaction(ctx context.Context, params oas.DeleteTransactionParams) (oas.DeleteTransactionRes, error) {
 	log.Printf("Got a request DELETE /transactions/%s\n", params.TransactionId)
 
-	err := h.financeService.DeleteTransaction(ctx, params.TransactionId)
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	err = h.financeService.DeleteTransaction(ctx, params.TransactionId, version)
 	if err != nil {
 		if errors.Is(err, service.ErrNotFound) {
 			return &oas.DeleteTransactionNotFound{}, nil
@@ -292,6 +418,44 @@ func (h *dobbyHandler) DeleteTransaction(ctx context.Context, params oas.DeleteT
 	return &oas.DeleteTransactionNoContent{}, nil
 }
 
+func (h *dobbyHandler) CreateTransfer(ctx context.Context, req *oas.CreateTransfer) (*oas.Transfer, error) {
+	log.Println("Got a request POST /transfers")
+
+	tr, err := h.financeService.TransferFunds(ctx, req.FromEnvelopeId, req.ToEnvelopeId, req.Amount, req.PeriodId)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	return &oas.Transfer{
+		ID:             tr.ID,
+		PeriodId:       tr.PeriodID,
+		FromEnvelopeId: tr.FromEnvelopeID,
+		ToEnvelopeId:   tr.ToEnvelopeID,
+		Amount:         tr.Amount,
+		Date:           tr.Date,
+	}, nil
+}
+
+func mapUserToOAS(u *service.User) *oas.User {
+	res := &oas.User{
+		ID:   u.ID,
+		Name: u.Name,
+	}
+	if u.Email != "" {
+		res.Email = oas.NewOptString(u.Email)
+	}
+	return res
+}
+
+func mapEnvelopeToOAS(e *service.Envelope) *oas.Envelope {
+	return &oas.Envelope{
+		ID:             e.ID,
+		Name:           e.Name,
+		RolloverPolicy: oas.RolloverPolicy(e.RolloverPolicy),
+		DeletedAt:      optDateTimeFromPtr(e.DeletedAt),
+	}
+}
+
 func mapTransactionToOAS(t *service.Transaction) *oas.Transaction {
 	return &oas.Transaction{
 		ID:          t.ID,
@@ -301,18 +465,41 @@ func mapTransactionToOAS(t *service.Transaction) *oas.Transaction {
 		Description: oas.NewOptString(t.Description),
 		Date:        t.Date,
 		Category:    oas.NewOptString(t.Category),
+		TransferId:  optUUIDFromPtr(t.TransferID),
+		ExternalRef: optStringFromPtr(t.ExternalRef),
+		CreatedBy:   optUUIDFromPtr(t.CreatedBy),
+		UpdatedBy:   optUUIDFromPtr(t.UpdatedBy),
+		Splits:      mapSplitsToOAS(t.Splits),
+		DeletedAt:   optDateTimeFromPtr(t.DeletedAt),
 	}
 }
 
+func mapSplitsToOAS(splits []service.TransactionSplit) []oas.TransactionSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+	res := make([]oas.TransactionSplit, len(splits))
+	for i, line := range splits {
+		res[i] = oas.TransactionSplit{
+			EnvelopeId: line.EnvelopeID,
+			Amount:     line.Amount,
+			Category:   oas.NewOptString(line.Category),
+		}
+	}
+	return res
+}
+
 func mapPeriodSummaryToOAS(s *service.PeriodSummary) *oas.PeriodSummary {
 	envSummaries := make([]oas.EnvelopeSummary, len(s.EnvelopeStats))
 	for i, stat := range s.EnvelopeStats {
 		envSummaries[i] = oas.EnvelopeSummary{
-			EnvelopeId:   stat.Envelope.ID,
-			EnvelopeName: stat.Envelope.Name,
-			Amount:       stat.Allocated,
-			Spent:        stat.Spent,
-			Remaining:    stat.Remaining,
+			EnvelopeId:             stat.Envelope.ID,
+			EnvelopeName:           stat.Envelope.Name,
+			Amount:                 stat.Allocated,
+			Spent:                  stat.Spent,
+			CarriedOver:            stat.CarriedOver,
+			Remaining:              stat.Remaining,
+			ProjectedEndingBalance: oas.NewOptInt64(stat.ProjectedEndingBalance),
 		}
 	}
 
@@ -323,6 +510,7 @@ func mapPeriodSummaryToOAS(s *service.PeriodSummary) *oas.PeriodSummary {
 		TotalBudget:            s.TotalBudget,
 		TotalRemaining:         s.TotalRemaining,
 		TotalSpent:             s.TotalSpent,
+		TotalCarriedOver:       s.TotalCarriedOver,
 		ProjectedEndingBalance: oas.NewOptInt64(s.ProjectedEndingBalance),
 		EnvelopeSummaries:      envSummaries,
 	}
@@ -337,6 +525,35 @@ func optUUIDFromPtr(p *uuid.UUID) oas.OptUUID {
 	return oas.NewOptUUID(*p)
 }
 
+func optStringFromPtr(p *string) oas.OptString {
+	if p == nil {
+		return oas.OptString{}
+	}
+	return oas.NewOptString(*p)
+}
+
+func optDateTimeFromPtr(p *time.Time) oas.OptDateTime {
+	if p == nil {
+		return oas.OptDateTime{}
+	}
+	return oas.NewOptDateTime(*p)
+}
+
+func ptrFromOptDate(o oas.OptDate) *time.Time {
+	if v, ok := o.Get(); ok {
+		return &v
+	}
+	return nil
+}
+
+// withIdempotencyKey makes the request idempotent if the client sent an Idempotency-Key header.
+func withIdempotencyKey(ctx context.Context, key oas.OptString) context.Context {
+	if v, ok := key.Get(); ok {
+		return service.WithIdempotencyKey(ctx, v)
+	}
+	return ctx
+}
+
 func (h *dobbyHandler) NewError(ctx context.Context, err error) *oas.ErrorStatusCode {
 	var code int
 	switch {
@@ -344,9 +561,13 @@ func (h *dobbyHandler) NewError(ctx context.Context, err error) *oas.ErrorStatus
 		code = 404
 	case errors.Is(err, service.ErrValidation):
 		code = 400
+	case errors.Is(err, service.ErrForbidden):
+		code = 403
 	case errors.Is(err, service.ErrPeriodOverlap), errors.Is(err, service.ErrConflict):
 		code = 409
-	case errors.Is(err, service.ErrInsufficientFunds):
+	case errors.Is(err, service.ErrPreconditionFailed):
+		code = 412
+	case errors.Is(err, service.ErrInsufficientFunds), errors.Is(err, service.ErrIdempotencyKeyReused):
 		code = 422
 	default:
 		code = 500
@@ -1,6 +1,9 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -17,7 +20,8 @@ func TestMapPeriodSummaryToOAS(t *testing.T) {
 		},
 		TotalBudget:            1000,
 		TotalSpent:             500,
-		TotalRemaining:         500,
+		TotalCarriedOver:       200,
+		TotalRemaining:         700,
 		ProjectedEndingBalance: -100,
 		EnvelopeStats: []service.EnvelopeStat{
 			{
@@ -25,9 +29,10 @@ func TestMapPeriodSummaryToOAS(t *testing.T) {
 					ID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					Name: "Groceries",
 				},
-				Allocated: 1000,
-				Spent:     500,
-				Remaining: 500,
+				Allocated:   1000,
+				Spent:       500,
+				CarriedOver: 200,
+				Remaining:   700,
 			},
 		},
 	}
@@ -45,4 +50,35 @@ func TestMapPeriodSummaryToOAS(t *testing.T) {
 	if oasSummary.EnvelopeSummaries[0].Spent != 500 {
 		t.Errorf("expected EnvelopeSummaries[0].Spent to be 500, got %d", oasSummary.EnvelopeSummaries[0].Spent)
 	}
+
+	if oasSummary.EnvelopeSummaries[0].CarriedOver != 200 {
+		t.Errorf("expected EnvelopeSummaries[0].CarriedOver to be 200, got %d", oasSummary.EnvelopeSummaries[0].CarriedOver)
+	}
+
+	if oasSummary.TotalCarriedOver != 200 {
+		t.Errorf("expected TotalCarriedOver to be 200, got %d", oasSummary.TotalCarriedOver)
+	}
+}
+
+func TestNewErrorStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{fmt.Errorf("%w: envelope", service.ErrNotFound), 404},
+		{fmt.Errorf("%w: name is required", service.ErrValidation), 400},
+		{service.ErrForbidden, 403},
+		{service.ErrPeriodOverlap, 409},
+		{fmt.Errorf("%w: period still has transactions", service.ErrConflict), 409},
+		{service.ErrPreconditionFailed, 412},
+		{service.ErrInsufficientFunds, 422},
+		{service.ErrIdempotencyKeyReused, 422},
+		{errors.New("connection refused"), 500},
+	}
+	h := &dobbyHandler{}
+	for _, tt := range tests {
+		if got := h.NewError(context.Background(), tt.err); got.StatusCode != tt.want || got.Response.Code != tt.want {
+			t.Errorf("%v: expected %d, got %d", tt.err, tt.want, got.StatusCode)
+		}
+	}
 }
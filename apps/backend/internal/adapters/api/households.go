@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+)
+
+func (h *dobbyHandler) GetHousehold(ctx context.Context) (*oas.Household, error) {
+	log.Println("Got a request GET /household")
+
+	household, err := h.financeService.GetHousehold(ctx)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return mapHouseholdToOAS(household), nil
+}
+
+func (h *dobbyHandler) CreateInvitation(ctx context.Context, req oas.OptCreateInvitation) (*oas.Invitation, error) {
+	log.Println("Got a request POST /household/invitations")
+
+	var role service.Role
+	if v, ok := req.Value.Role.Get(); req.Set && ok {
+		role = service.Role(v)
+	}
+	inv, err := h.financeService.CreateInvitation(ctx, role)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return &oas.Invitation{
+		Code:      inv.Code,
+		Role:      oas.Role(inv.Role),
+		ExpiresAt: inv.ExpiresAt,
+	}, nil
+}
+
+func (h *dobbyHandler) JoinHousehold(ctx context.Context, req *oas.JoinHousehold) (*oas.Household, error) {
+	log.Println("Got a request POST /household/join")
+
+	household, err := h.financeService.JoinHousehold(ctx, req.Code)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return mapHouseholdToOAS(household), nil
+}
+
+func (h *dobbyHandler) UpdateMember(ctx context.Context, req *oas.UpdateMember, params oas.UpdateMemberParams) (oas.UpdateMemberRes, error) {
+	log.Printf("Got a request PUT /household/members/%s\n", params.UserId)
+
+	m, err := h.financeService.UpdateMember(ctx, service.Member{
+		User:        service.User{ID: params.UserId},
+		Role:        service.Role(req.Role),
+		EnvelopeIDs: req.EnvelopeIds,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.UpdateMemberNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+	return mapMemberToOAS(m), nil
+}
+
+func mapMemberToOAS(m *service.Member) *oas.Member {
+	res := &oas.Member{
+		ID:          m.ID,
+		Name:        m.Name,
+		Role:        oas.Role(m.Role),
+		EnvelopeIds: m.EnvelopeIDs,
+	}
+	if res.EnvelopeIds == nil {
+		res.EnvelopeIds = []uuid.UUID{}
+	}
+	if m.Email != "" {
+		res.Email = oas.NewOptString(m.Email)
+	}
+	return res
+}
+
+func mapHouseholdToOAS(household *service.Household) *oas.Household {
+	members := make([]oas.Member, len(household.Members))
+	for i, m := range household.Members {
+		members[i] = *mapMemberToOAS(&m)
+	}
+	return &oas.Household{
+		ID:      household.ID,
+		Name:    household.Name,
+		Members: members,
+	}
+}
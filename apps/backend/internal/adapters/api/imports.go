@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"log"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+func (h *dobbyHandler) ImportTransactions(ctx context.Context, req *oas.ImportRequest) (*oas.ImportResult, error) {
+	log.Println("Got a request POST /imports")
+
+	res, err := h.financeService.ImportTransactions(ctx, req.ToLogicModel())
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	rows := make([]oas.ImportRow, len(res.Rows))
+	for i, row := range res.Rows {
+		rows[i] = oas.ImportRow{
+			Line:   row.Line,
+			Status: oas.ImportRowStatus(row.Status),
+		}
+		if row.Status == service.ImportRowInvalid {
+			rows[i].Error = oas.NewOptString(row.Error)
+		} else {
+			rows[i].Transaction = oas.NewOptTransaction(*mapTransactionToOAS(&row.Transaction))
+		}
+	}
+
+	return &oas.ImportResult{
+		Committed:      res.Committed,
+		NewCount:       res.Count(service.ImportRowNew),
+		DuplicateCount: res.Count(service.ImportRowDuplicate),
+		InvalidCount:   res.Count(service.ImportRowInvalid),
+		Rows:           rows,
+	}, nil
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+type introspectionResponse struct {
+	Active bool   `json:"active"`
+	Sub    string `json:"sub"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Exp    int64  `json:"exp"`
+
+	Username string `json:"username"`
+}
+
+const (
+	negativeCacheTTL = 10 * time.Second // How long rejected tokens are remembered
+	cacheSweepSize   = 1024             // Expired entries are purged once the cache grows to this size
+)
+
+var errInvalidToken = errors.New("invalid token")
+
+// introspector validates tokens with the OAuth 2.0 token introspection endpoint (RFC 7662).
+// Results are cached by token hash, and concurrent lookups of the same token share one request.
+type introspector struct {
+	introspectionURL string
+	clientID         string
+	clientSecret     string
+	httpClient       *http.Client
+	cacheTTL         time.Duration // Upper bound for caching active tokens, zero disables the cache
+	now              func() time.Time
+
+	mu       sync.Mutex
+	cache    map[string]introspectionEntry
+	inflight singleflight.Group
+}
+
+type introspectionEntry struct {
+	claims    *tokenClaims // Nil for rejected tokens
+	expiresAt time.Time
+}
+
+func newIntrospector(introspectionURL, clientID, clientSecret string, cacheTTL time.Duration, httpClient *http.Client) *introspector {
+	return &introspector{
+		introspectionURL: introspectionURL,
+		clientID:         clientID,
+		clientSecret:     clientSecret,
+		httpClient:       httpClient,
+		cacheTTL:         cacheTTL,
+		now:              time.Now,
+		cache:            make(map[string]introspectionEntry),
+	}
+}
+
+func (i *introspector) Introspect(ctx context.Context, token string) (*tokenClaims, error) {
+	if i.cacheTTL <= 0 {
+		return i.fetch(ctx, token)
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+	if claims, ok := i.cached(key); ok {
+		if claims == nil {
+			return nil, errInvalidToken
+		}
+		return claims, nil
+	}
+
+	// The shared request must not fail for everyone when the first caller goes away.
+	sharedCtx := context.WithoutCancel(ctx)
+	v, err, _ := i.inflight.Do(key, func() (any, error) {
+		claims, err := i.fetch(sharedCtx, token)
+		switch {
+		case err == nil:
+			expiresAt := i.now().Add(i.cacheTTL)
+			if !claims.ExpiresAt.IsZero() && claims.ExpiresAt.Before(expiresAt) {
+				expiresAt = claims.ExpiresAt
+			}
+			i.store(key, introspectionEntry{claims: claims, expiresAt: expiresAt})
+		case errors.Is(err, errInvalidToken):
+			i.store(key, introspectionEntry{expiresAt: i.now().Add(negativeCacheTTL)})
+		}
+		return claims, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*tokenClaims), nil
+}
+
+func (i *introspector) cached(key string) (*tokenClaims, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	entry, ok := i.cache[key]
+	if !ok || !i.now().Before(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.claims, true
+}
+
+func (i *introspector) store(key string, entry introspectionEntry) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	now := i.now()
+	if !now.Before(entry.expiresAt) {
+		return
+	}
+	if len(i.cache) >= cacheSweepSize {
+		for k, e := range i.cache {
+			if !now.Before(e.expiresAt) {
+				delete(i.cache, k)
+			}
+		}
+	}
+	i.cache[key] = entry
+}
+
+// fetch asks the introspection endpoint about the token.
+func (i *introspector) fetch(ctx context.Context, token string) (*tokenClaims, error) {
+	data := url.Values{}
+	data.Set("token", token)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", i.introspectionURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create introspection request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(i.clientID, i.clientSecret)
+
+	resp, err := i.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("introspection request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("introspection returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var ir introspectionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ir); err != nil {
+		return nil, fmt.Errorf("failed to decode introspection response: %w", err)
+	}
+
+	if !ir.Active {
+		return nil, errInvalidToken
+	}
+
+	claims := &tokenClaims{Subject: ir.Sub, Name: ir.Name, Email: ir.Email}
+	if claims.Name == "" {
+		claims.Name = ir.Username
+	}
+	if ir.Exp != 0 {
+		claims.ExpiresAt = time.Unix(ir.Exp, 0)
+	}
+	return claims, nil
+}
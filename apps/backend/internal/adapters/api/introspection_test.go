@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIntrospectorCache(t *testing.T) {
+	now := time.Now()
+	release := make(chan struct{})
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		r.ParseForm()
+		switch r.PostForm.Get("token") {
+		case "slow":
+			<-release
+			fallthrough
+		case "valid":
+			json.NewEncoder(w).Encode(introspectionResponse{Active: true, Sub: "user-1", Exp: now.Add(time.Minute).Unix()})
+		default:
+			json.NewEncoder(w).Encode(introspectionResponse{Active: false})
+		}
+	}))
+	defer srv.Close()
+
+	i := newIntrospector(srv.URL, "client", "secret", 5*time.Minute, srv.Client())
+	i.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	expectRequests := func(t *testing.T, want int32) {
+		t.Helper()
+		if got := requests.Swap(0); got != want {
+			t.Errorf("expected %d introspection requests, got %d", want, got)
+		}
+	}
+
+	t.Run("active tokens are cached until expiry", func(t *testing.T) {
+		for range 3 {
+			claims, err := i.Introspect(ctx, "valid")
+			if err != nil || claims.Subject != "user-1" {
+				t.Fatalf("unexpected result: %+v, %v", claims, err)
+			}
+		}
+		expectRequests(t, 1)
+
+		// The token expires before the configured TTL.
+		now = now.Add(2 * time.Minute)
+		i.Introspect(ctx, "valid")
+		expectRequests(t, 1)
+	})
+
+	t.Run("rejected tokens are cached briefly", func(t *testing.T) {
+		for range 3 {
+			if _, err := i.Introspect(ctx, "revoked"); !errors.Is(err, errInvalidToken) {
+				t.Fatalf("expected invalid token, got %v", err)
+			}
+		}
+		expectRequests(t, 1)
+
+		now = now.Add(negativeCacheTTL)
+		i.Introspect(ctx, "revoked")
+		expectRequests(t, 1)
+	})
+
+	t.Run("concurrent lookups share one request", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Go(func() {
+				if _, err := i.Introspect(ctx, "slow"); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			})
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		expectRequests(t, 1)
+	})
+}
@@ -0,0 +1,366 @@
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // register SHA-256 for crypto.Hash
+	_ "crypto/sha512" // register SHA-384 and SHA-512 for crypto.Hash
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	jwksRefreshInterval    = time.Hour        // Keys are refetched at least this often to pick up rotations
+	jwksMinRefreshInterval = time.Minute      // Unknown key IDs trigger a refetch at most this often
+	jwtLeeway              = 30 * time.Second // Tolerated clock skew for exp and nbf
+)
+
+// jwksVerifier validates JWT access tokens locally against the signing keys published by the identity provider.
+// Keys are cached, so that token validation keeps working while the provider is slow or unreachable.
+type jwksVerifier struct {
+	jwksURL    string
+	issuer     string
+	audience   string // Not checked when empty
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	lastAttempt time.Time
+	inflight    singleflight.Group
+}
+
+func newJWKSVerifier(jwksURL, issuer, audience string, httpClient *http.Client) *jwksVerifier {
+	return &jwksVerifier{
+		jwksURL:    jwksURL,
+		issuer:     issuer,
+		audience:   audience,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type jwtPayload struct {
+	Iss   string   `json:"iss"`
+	Sub   string   `json:"sub"`
+	Aud   audience `json:"aud"`
+	Exp   *float64 `json:"exp"`
+	Nbf   *float64 `json:"nbf"`
+	Name  string   `json:"name"`
+	Email string   `json:"email"`
+
+	PreferredUsername string `json:"preferred_username"`
+}
+
+// audience is the aud claim, which is either a single string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return fmt.Errorf("aud must be a string or an array of strings")
+	}
+	*a = multiple
+	return nil
+}
+
+func (v *jwksVerifier) Verify(ctx context.Context, token string) (*tokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid token: malformed JWT")
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid token: malformed header: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: malformed signature")
+	}
+
+	key, err := v.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	var payload jwtPayload
+	if err := decodeSegment(parts[1], &payload); err != nil {
+		return nil, fmt.Errorf("invalid token: malformed payload: %w", err)
+	}
+
+	now := v.now()
+	switch {
+	case payload.Iss != v.issuer:
+		return nil, fmt.Errorf("invalid token: unexpected issuer %q", payload.Iss)
+	case v.audience != "" && !slices.Contains(payload.Aud, v.audience):
+		return nil, fmt.Errorf("invalid token: audience does not include %q", v.audience)
+	case payload.Exp == nil:
+		return nil, fmt.Errorf("invalid token: missing exp")
+	case now.After(unixTime(*payload.Exp).Add(jwtLeeway)):
+		return nil, fmt.Errorf("invalid token: expired")
+	case payload.Nbf != nil && now.Add(jwtLeeway).Before(unixTime(*payload.Nbf)):
+		return nil, fmt.Errorf("invalid token: not valid yet")
+	case payload.Sub == "":
+		return nil, fmt.Errorf("invalid token: missing sub")
+	}
+
+	name := payload.Name
+	if name == "" {
+		name = payload.PreferredUsername
+	}
+	return &tokenClaims{
+		Subject:   payload.Sub,
+		Name:      name,
+		Email:     payload.Email,
+		ExpiresAt: unixTime(*payload.Exp),
+	}, nil
+}
+
+// key returns the signing key with the given ID. A stale key set is refetched in the background while the
+// cached keys stay in use, so verification does not wait for a slow or unreachable provider. Only an unknown
+// key waits for the refetch. Concurrent refetches share a single request.
+func (v *jwksVerifier) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok, refresh := v.cached(kid)
+	switch {
+	case refresh && ok:
+		go func() {
+			// Not bound to the request, which is answered with the cached key in the meantime.
+			if err := v.sharedRefresh(context.Background()); err != nil {
+				slog.Warn("Failed to refresh JWKS, using cached keys", "error", err)
+			}
+		}()
+	case refresh:
+		if err := v.sharedRefresh(context.WithoutCancel(ctx)); err != nil {
+			return nil, err
+		}
+		key, ok, _ = v.cached(kid)
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid token: unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// cached looks the key up in the cached key set and tells whether the set is to be refetched,
+// which is then accounted as an attempt.
+func (v *jwksVerifier) cached(kid string) (key crypto.PublicKey, ok, refresh bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	key, ok = v.lookup(kid)
+	sinceAttempt := v.now().Sub(v.lastAttempt)
+	refresh = (!ok && sinceAttempt > jwksMinRefreshInterval) || sinceAttempt > jwksRefreshInterval
+	if refresh {
+		v.lastAttempt = v.now()
+	}
+	return key, ok, refresh
+}
+
+// sharedRefresh refetches the key set, joining a refetch already in flight.
+func (v *jwksVerifier) sharedRefresh(ctx context.Context) error {
+	_, err, _ := v.inflight.Do("jwks", func() (any, error) {
+		return nil, v.refresh(ctx)
+	})
+	return err
+}
+
+func (v *jwksVerifier) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, true
+		}
+	}
+	key, ok := v.keys[kid]
+	return key, ok
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (v *jwksVerifier) refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", v.jwksURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("JWKS request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("JWKS endpoint returned status %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use == "enc" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			slog.Warn("Skipping unsupported JWK", "kid", jwk.Kid, "error", err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return errors.New("JWKS contains no usable signing keys")
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.mu.Unlock()
+	return nil
+}
+
+func (jwk jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("malformed n")
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("malformed e")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		x, errX := base64.RawURLEncoding.DecodeString(jwk.X)
+		y, errY := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if errX != nil || errY != nil || len(x) != size || len(y) != size {
+			return nil, fmt.Errorf("malformed coordinates")
+		}
+		return ecdsa.ParseUncompressedPublicKey(curve, slices.Concat([]byte{4}, x, y))
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if jwk.Crv != "Ed25519" || err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("unsupported OKP key")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+}
+
+// verifySignature checks a JWS signature. Only asymmetric algorithms are accepted.
+func verifySignature(alg string, key crypto.PublicKey, input, signature []byte) error {
+	if alg == "EdDSA" {
+		pub, ok := key.(ed25519.PublicKey)
+		if !ok || !ed25519.Verify(pub, input, signature) {
+			return errors.New("signature verification failed")
+		}
+		return nil
+	}
+
+	var hash crypto.Hash
+	switch strings.TrimLeft(alg, "RSPE") {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write(input)
+	digest := h.Sum(nil)
+
+	switch alg[:2] {
+	case "RS", "PS":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key does not match algorithm %q", alg)
+		}
+		if alg[0] == 'R' {
+			return rsa.VerifyPKCS1v15(pub, hash, digest, signature)
+		}
+		return rsa.VerifyPSS(pub, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	case "ES":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key does not match algorithm %q", alg)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("signature verification failed")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return errors.New("signature verification failed")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported algorithm %q", alg)
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
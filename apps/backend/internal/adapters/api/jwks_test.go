@@ -0,0 +1,284 @@
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer   = "https://idp.example.com"
+	testAudience = "dobby-backend"
+)
+
+type testSigner struct {
+	kid string
+	alg string
+	key crypto.Signer
+}
+
+func (s testSigner) jwk() map[string]string {
+	enc := base64.RawURLEncoding.EncodeToString
+	switch pub := s.key.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{"kty": "RSA", "kid": s.kid, "n": enc(pub.N.Bytes()), "e": enc(big.NewInt(int64(pub.E)).Bytes())}
+	case *ecdsa.PublicKey:
+		raw, _ := pub.Bytes()
+		size := (len(raw) - 1) / 2
+		return map[string]string{"kty": "EC", "kid": s.kid, "crv": "P-256", "x": enc(raw[1 : 1+size]), "y": enc(raw[1+size:])}
+	case ed25519.PublicKey:
+		return map[string]string{"kty": "OKP", "kid": s.kid, "crv": "Ed25519", "x": enc(pub)}
+	}
+	panic("unsupported key")
+}
+
+func (s testSigner) sign(t *testing.T, claims map[string]any) string {
+	t.Helper()
+	enc := func(v any) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	input := enc(map[string]string{"alg": s.alg, "kid": s.kid, "typ": "JWT"}) + "." + enc(claims)
+
+	var sig []byte
+	var err error
+	switch key := s.key.(type) {
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(key, []byte(input))
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256([]byte(input))
+		r, s, signErr := ecdsa.Sign(rand.Reader, key, digest[:])
+		sig, err = make([]byte, 64), signErr
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+	default:
+		digest := sha256.Sum256([]byte(input))
+		sig, err = key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func newTestSigners(t *testing.T) (rs, es, ed testSigner) {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testSigner{"rsa-1", "RS256", rsaKey}, testSigner{"ec-1", "ES256", ecKey}, testSigner{"ed-1", "EdDSA", edKey}
+}
+
+func serveJWKS(t *testing.T, signers *atomic.Pointer[[]testSigner], requests *atomic.Int32) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var keys []map[string]string
+		for _, s := range *signers.Load() {
+			keys = append(keys, s.jwk())
+		}
+		json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestJWKSVerifier(t *testing.T) {
+	rs, es, ed := newTestSigners(t)
+	signers := &atomic.Pointer[[]testSigner]{}
+	signers.Store(&[]testSigner{rs, es, ed})
+	var requests atomic.Int32
+	srv := serveJWKS(t, signers, &requests)
+
+	now := time.Now()
+	claims := func(overrides map[string]any) map[string]any {
+		c := map[string]any{
+			"iss":   testIssuer,
+			"sub":   "user-1",
+			"aud":   []string{"project", testAudience},
+			"exp":   now.Add(time.Hour).Unix(),
+			"name":  "Jane Doe",
+			"email": "jane@example.com",
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{name: "RS256", token: rs.sign(t, claims(nil))},
+		{name: "ES256", token: es.sign(t, claims(nil))},
+		{name: "EdDSA", token: ed.sign(t, claims(nil))},
+		{name: "single audience", token: rs.sign(t, claims(map[string]any{"aud": testAudience}))},
+		{name: "wrong issuer", token: rs.sign(t, claims(map[string]any{"iss": "https://evil.example.com"})), wantErr: "issuer"},
+		{name: "wrong audience", token: rs.sign(t, claims(map[string]any{"aud": "other"})), wantErr: "audience"},
+		{name: "expired", token: rs.sign(t, claims(map[string]any{"exp": now.Add(-time.Minute).Unix()})), wantErr: "expired"},
+		{name: "missing exp", token: rs.sign(t, claims(map[string]any{"exp": nil})), wantErr: "exp"},
+		{name: "not valid yet", token: rs.sign(t, claims(map[string]any{"nbf": now.Add(time.Hour).Unix()})), wantErr: "not valid yet"},
+		{
+			name:    "tampered payload",
+			token:   tamper(rs.sign(t, claims(nil)), claims(map[string]any{"sub": "admin"})),
+			wantErr: "verification",
+		},
+		{
+			name:    "unsigned",
+			token:   testSigner{kid: "rsa-1", alg: "none"}.unsigned(claims(nil)),
+			wantErr: "unsupported algorithm",
+		},
+	}
+
+	v := newJWKSVerifier(srv.URL, testIssuer, testAudience, srv.Client())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Verify(context.Background(), tt.token)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Subject != "user-1" || got.Name != "Jane Doe" || got.Email != "jane@example.com" {
+				t.Errorf("unexpected claims: %+v", got)
+			}
+		})
+	}
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected keys to be fetched once, got %d requests", n)
+	}
+}
+
+func TestJWKSVerifierKeyRotation(t *testing.T) {
+	rs, es, _ := newTestSigners(t)
+	signers := &atomic.Pointer[[]testSigner]{}
+	signers.Store(&[]testSigner{rs})
+	var requests atomic.Int32
+	srv := serveJWKS(t, signers, &requests)
+
+	now := time.Now()
+	v := newJWKSVerifier(srv.URL, testIssuer, "", srv.Client())
+	v.now = func() time.Time { return now }
+	claims := map[string]any{"iss": testIssuer, "sub": "user-1", "exp": now.Add(24 * time.Hour).Unix()}
+
+	if _, err := v.Verify(context.Background(), rs.sign(t, claims)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The provider rotates to a new key. Unknown key IDs are refetched, but not more than once a minute.
+	signers.Store(&[]testSigner{rs, es})
+	if _, err := v.Verify(context.Background(), es.sign(t, claims)); err == nil {
+		t.Fatal("expected unknown key to be rejected within the refresh interval")
+	}
+	now = now.Add(2 * jwksMinRefreshInterval)
+	if _, err := v.Verify(context.Background(), es.sign(t, claims)); err != nil {
+		t.Fatalf("expected rotated key to be picked up, got %v", err)
+	}
+
+	// Cached keys keep working while the provider is down.
+	srv.Close()
+	now = now.Add(2 * jwksRefreshInterval)
+	if _, err := v.Verify(context.Background(), rs.sign(t, claims)); err != nil {
+		t.Fatalf("expected cached key to be used, got %v", err)
+	}
+}
+
+func TestJWKSVerifierDoesNotWaitForStaleRefresh(t *testing.T) {
+	rs, _, _ := newTestSigners(t)
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{rs.jwk()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) > 1 {
+			<-release // The provider hangs on every refetch
+		}
+		w.Write(jwks)
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	start := time.Now()
+	var now atomic.Pointer[time.Time]
+	now.Store(&start)
+	v := newJWKSVerifier(srv.URL, testIssuer, "", srv.Client())
+	v.now = func() time.Time { return *now.Load() }
+	token := rs.sign(t, map[string]any{"iss": testIssuer, "sub": "user-1", "exp": start.Add(24 * time.Hour).Unix()})
+	if _, err := v.Verify(context.Background(), token); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stale := start.Add(2 * jwksRefreshInterval)
+	now.Store(&stale)
+	done := make(chan error)
+	for range 5 {
+		go func() {
+			_, err := v.Verify(context.Background(), token)
+			done <- err
+		}()
+	}
+	for range 5 {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("expected the cached key to be used, got %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("verification waited for the hanging provider")
+		}
+	}
+	if n := requests.Load(); n > 2 {
+		t.Errorf("expected a single refetch, got %d requests", n)
+	}
+}
+
+func (s testSigner) unsigned(claims map[string]any) string {
+	header, _ := json.Marshal(map[string]string{"alg": s.alg, "kid": s.kid})
+	payload, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+}
+
+func tamper(token string, claims map[string]any) string {
+	parts := strings.Split(token, ".")
+	payload, _ := json.Marshal(claims)
+	parts[1] = base64.RawURLEncoding.EncodeToString(payload)
+	return strings.Join(parts, ".")
+}
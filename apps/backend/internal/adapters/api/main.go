@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
@@ -25,41 +26,66 @@ type dobbyHandler struct {
 // Compile-time check for Handler.
 var _ oas.Handler = (*dobbyHandler)(nil)
 
-func RunServer(cfg config.Config) {
-	authority := cfg.OIDCAuthority
-
-	security := &dobbySecurity{
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+// newSecurity discovers the OIDC endpoints and sets up token verification according to cfg.
+func newSecurity(cfg config.Config) (*dobbySecurity, error) {
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
 	}
 
-	clientID := cfg.OIDCBackendClientID
-	clientSecret := cfg.OIDCBackendClientSecret
-
 	// OIDC Discovery
-	discoveryURL := strings.TrimSuffix(authority, "/") + "/.well-known/openid-configuration"
-	resp, err := http.Get(discoveryURL)
+	discoveryURL := strings.TrimSuffix(cfg.OIDCAuthority, "/") + "/.well-known/openid-configuration"
+	resp, err := httpClient.Get(discoveryURL)
 	if err != nil {
-		log.Fatalf("failed to fetch OIDC discovery: %v", err)
+		return nil, fmt.Errorf("failed to fetch OIDC discovery: %w", err)
 	}
 	defer resp.Body.Close()
 
 	var disco struct {
+		Issuer                string `json:"issuer"`
+		JWKSURI               string `json:"jwks_uri"`
 		IntrospectionEndpoint string `json:"introspection_endpoint"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&disco); err != nil {
-		log.Fatalf("failed to decode OIDC discovery: %v", err)
+		return nil, fmt.Errorf("failed to decode OIDC discovery: %w", err)
 	}
 
-	if disco.IntrospectionEndpoint == "" {
-		log.Fatal("OIDC discovery response missing introspection_endpoint")
+	security := &dobbySecurity{}
+	if disco.IntrospectionEndpoint != "" {
+		security.introspector = newIntrospector(disco.IntrospectionEndpoint,
+			cfg.OIDCBackendClientID, cfg.OIDCBackendClientSecret, cfg.OIDCIntrospectionCacheTTL, httpClient)
 	}
 
-	security.introspectionURL = disco.IntrospectionEndpoint
-	security.clientID = clientID
-	security.clientSecret = clientSecret
-	slog.Info("OIDC security initialized", "introspection_url", security.introspectionURL)
+	switch cfg.OIDCTokenVerification {
+	case "introspection":
+		if security.introspector == nil {
+			return nil, fmt.Errorf("OIDC discovery response missing introspection_endpoint")
+		}
+		slog.Info("OIDC security initialized", "introspection_url", disco.IntrospectionEndpoint)
+	case "jwt":
+		if disco.JWKSURI == "" || disco.Issuer == "" {
+			return nil, fmt.Errorf("OIDC discovery response missing jwks_uri or issuer")
+		}
+		audience := cfg.OIDCAudience
+		if audience == "" {
+			audience = cfg.OIDCBackendClientID
+		}
+		security.jwt = newJWKSVerifier(disco.JWKSURI, disco.Issuer, audience, httpClient)
+		if security.introspector == nil {
+			slog.Warn("OIDC discovery response missing introspection_endpoint, opaque tokens will be rejected")
+		}
+		slog.Info("OIDC security initialized", "jwks_uri", disco.JWKSURI, "introspection_url", disco.IntrospectionEndpoint)
+	default:
+		return nil, fmt.Errorf("unknown OIDC token verification %q, expected introspection or jwt", cfg.OIDCTokenVerification)
+	}
+
+	return security, nil
+}
+
+func RunServer(cfg config.Config) {
+	security, err := newSecurity(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	ctx := context.Background()
 	db, err := pgxpool.New(ctx, cfg.DatabaseURL)
@@ -75,7 +101,16 @@ func RunServer(cfg config.Config) {
 
 	repo := persistence.NewPostgresRepository(db)
 	txManager := persistence.NewPostgresTransactionManager(db)
-	svc := service.NewDobbyFinancier(repo, txManager)
+	periodGaps := service.PeriodGapPolicy(cfg.PeriodGaps)
+	if !periodGaps.Valid() {
+		log.Fatalf("unknown period gap policy %q, expected allow or reject", cfg.PeriodGaps)
+	}
+	svc := service.NewDobbyFinancier(repo, txManager, periodGaps)
+	security.users = svc
+
+	if cfg.TrashRetention > 0 {
+		go runTrashRetention(ctx, svc, cfg.TrashRetention)
+	}
 
 	srv, err := oas.NewServer(&dobbyHandler{financeService: svc}, security)
 	if err != nil {
@@ -95,6 +130,7 @@ func RunServer(cfg config.Config) {
 		AllowedOrigins:   cfg.AllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"*"},
+		ExposedHeaders:   []string{"X-Next-Cursor", "ETag"},
 		AllowCredentials: true,
 	})
 
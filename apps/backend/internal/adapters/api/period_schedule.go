@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+func (h *dobbyHandler) GetPeriodSchedule(ctx context.Context) (*oas.PeriodScheduleHeaders, error) {
+	log.Println("Got a request GET /household/period-schedule")
+
+	sch, err := h.financeService.GetPeriodSchedule(ctx)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return &oas.PeriodScheduleHeaders{ETag: etag(sch.Version), Response: *mapPeriodScheduleToOAS(sch)}, nil
+}
+
+func (h *dobbyHandler) UpdatePeriodSchedule(ctx context.Context, req *oas.PeriodSchedule, params oas.UpdatePeriodScheduleParams) (*oas.PeriodScheduleHeaders, error) {
+	log.Println("Got a request PUT /household/period-schedule")
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	sch := req.ToLogicModel()
+	sch.Version = version
+	updated, err := h.financeService.UpdatePeriodSchedule(ctx, sch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return &oas.PeriodScheduleHeaders{ETag: etag(updated.Version), Response: *mapPeriodScheduleToOAS(updated)}, nil
+}
+
+func (h *dobbyHandler) ListHolidays(ctx context.Context, params oas.ListHolidaysParams) ([]oas.Holiday, error) {
+	log.Println("Got a request GET /household/holidays")
+
+	holidays, err := h.financeService.ListHolidays(ctx, params.Year.Or(time.Now().Year()))
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return mapHolidaysToOAS(holidays), nil
+}
+
+func (h *dobbyHandler) ImportHolidays(ctx context.Context, req *oas.ImportHolidays) ([]oas.Holiday, error) {
+	log.Println("Got a request PUT /household/holidays")
+
+	holidays, err := h.financeService.ImportHolidays(ctx, []byte(req.Content))
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return mapHolidaysToOAS(holidays), nil
+}
+
+func mapHolidaysToOAS(holidays []service.Holiday) []oas.Holiday {
+	res := make([]oas.Holiday, len(holidays))
+	for i, h := range holidays {
+		res[i] = oas.Holiday{Date: h.Date, Name: h.Name, Yearly: h.Yearly}
+	}
+	return res
+}
+
+func mapPeriodScheduleToOAS(sch *service.PeriodSchedule) *oas.PeriodSchedule {
+	res := &oas.PeriodSchedule{
+		Kind:       oas.PeriodScheduleKind(sch.Kind),
+		Adjustment: oas.NewOptPeriodScheduleAdjustment(oas.PeriodScheduleAdjustment(sch.Adjustment)),
+		TimeZone:   sch.TimeZone,
+	}
+	if sch.HolidayCountry != "" {
+		res.HolidayCountry = oas.NewOptPeriodScheduleHolidayCountry(oas.PeriodScheduleHolidayCountry(sch.HolidayCountry))
+	}
+	switch sch.Kind {
+	case service.PeriodMonthly:
+		res.DayOfMonth = oas.NewOptInt(sch.DayOfMonth)
+	case service.PeriodSemiMonthly:
+		res.DayOfMonth = oas.NewOptInt(sch.DayOfMonth)
+		res.SecondDayOfMonth = oas.NewOptInt(sch.SecondDayOfMonth)
+	case service.PeriodWeekly:
+		res.IntervalWeeks = oas.NewOptInt(sch.IntervalWeeks)
+		res.AnchorDate = oas.NewOptDate(sch.AnchorDate)
+	}
+	return res
+}
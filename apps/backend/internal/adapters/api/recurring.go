@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+func (h *dobbyHandler) ListRecurringTransactions(ctx context.Context) ([]oas.RecurringTransaction, error) {
+	log.Println("Got a request GET /recurring-transactions")
+
+	templates, err := h.financeService.ListRecurringTransactions(ctx)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	res := make([]oas.RecurringTransaction, len(templates))
+	for i, rt := range templates {
+		res[i] = *mapRecurringTransactionToOAS(&rt)
+	}
+	return res, nil
+}
+
+func (h *dobbyHandler) CreateRecurringTransaction(ctx context.Context, req *oas.CreateRecurringTransaction) (*oas.RecurringTransaction, error) {
+	log.Println("Got a request POST /recurring-transactions")
+
+	rt, err := h.financeService.CreateRecurringTransaction(ctx, req.ToLogicModel())
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return mapRecurringTransactionToOAS(rt), nil
+}
+
+func (h *dobbyHandler) GetRecurringTransaction(ctx context.Context, params oas.GetRecurringTransactionParams) (oas.GetRecurringTransactionRes, error) {
+	log.Printf("Got a request GET /recurring-transactions/%s\n", params.RecurringTransactionId)
+
+	rt, err := h.financeService.GetRecurringTransaction(ctx, params.RecurringTransactionId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.GetRecurringTransactionNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+	return &oas.RecurringTransactionHeaders{ETag: etag(rt.Version), Response: *mapRecurringTransactionToOAS(rt)}, nil
+}
+
+func (h *dobbyHandler) UpdateRecurringTransaction(ctx context.Context, req *oas.UpdateRecurringTransaction, params oas.UpdateRecurringTransactionParams) (oas.UpdateRecurringTransactionRes, error) {
+	log.Printf("Got a request PATCH /recurring-transactions/%s\n", params.RecurringTransactionId)
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	existing, err := h.financeService.GetRecurringTransaction(ctx, params.RecurringTransactionId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.UpdateRecurringTransactionNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	req.ApplyToModel(existing)
+	existing.Version = version
+
+	updated, err := h.financeService.UpdateRecurringTransaction(ctx, *existing)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return &oas.RecurringTransactionHeaders{ETag: etag(updated.Version), Response: *mapRecurringTransactionToOAS(updated)}, nil
+}
+
+func (h *dobbyHandler) DeleteRecurringTransaction(ctx context.Context, params oas.DeleteRecurringTransactionParams) (oas.DeleteRecurringTransactionRes, error) {
+	log.Printf("Got a request DELETE /recurring-transactions/%s\n", params.RecurringTransactionId)
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	err = h.financeService.DeleteRecurringTransaction(ctx, params.RecurringTransactionId, version)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.DeleteRecurringTransactionNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+	return &oas.DeleteRecurringTransactionNoContent{}, nil
+}
+
+func mapRecurringTransactionToOAS(rt *service.RecurringTransaction) *oas.RecurringTransaction {
+	sch := oas.RecurrenceSchedule{
+		Kind: oas.RecurrenceScheduleKind(rt.Schedule.Kind),
+	}
+	switch rt.Schedule.Kind {
+	case service.RecurrenceMonthly:
+		sch.DayOfMonth = oas.NewOptInt(rt.Schedule.DayOfMonth)
+	case service.RecurrenceWeekly:
+		sch.IntervalWeeks = oas.NewOptInt(rt.Schedule.IntervalWeeks)
+		sch.AnchorDate = oas.NewOptDate(rt.Schedule.AnchorDate)
+	}
+
+	return &oas.RecurringTransaction{
+		ID:          rt.ID,
+		EnvelopeId:  rt.EnvelopeID,
+		Amount:      rt.Amount,
+		Description: rt.Description,
+		Category:    rt.Category,
+		Schedule:    sch,
+	}
+}
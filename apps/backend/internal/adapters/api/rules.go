@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+func (h *dobbyHandler) ListRules(ctx context.Context) ([]oas.Rule, error) {
+	log.Println("Got a request GET /rules")
+
+	rules, err := h.financeService.ListRules(ctx)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	res := make([]oas.Rule, len(rules))
+	for i, r := range rules {
+		res[i] = *mapRuleToOAS(&r)
+	}
+	return res, nil
+}
+
+func (h *dobbyHandler) CreateRule(ctx context.Context, req *oas.CreateRule) (*oas.Rule, error) {
+	log.Println("Got a request POST /rules")
+
+	r, err := h.financeService.CreateRule(ctx, req.ToLogicModel())
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return mapRuleToOAS(r), nil
+}
+
+func (h *dobbyHandler) GetRule(ctx context.Context, params oas.GetRuleParams) (oas.GetRuleRes, error) {
+	log.Printf("Got a request GET /rules/%s\n", params.RuleId)
+
+	r, err := h.financeService.GetRule(ctx, params.RuleId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.GetRuleNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+	return &oas.RuleHeaders{ETag: etag(r.Version), Response: *mapRuleToOAS(r)}, nil
+}
+
+func (h *dobbyHandler) UpdateRule(ctx context.Context, req *oas.UpdateRule, params oas.UpdateRuleParams) (oas.UpdateRuleRes, error) {
+	log.Printf("Got a request PATCH /rules/%s\n", params.RuleId)
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	existing, err := h.financeService.GetRule(ctx, params.RuleId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.UpdateRuleNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	req.ApplyToModel(existing)
+	existing.Version = version
+
+	updated, err := h.financeService.UpdateRule(ctx, *existing)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+	return &oas.RuleHeaders{ETag: etag(updated.Version), Response: *mapRuleToOAS(updated)}, nil
+}
+
+func (h *dobbyHandler) DeleteRule(ctx context.Context, params oas.DeleteRuleParams) (oas.DeleteRuleRes, error) {
+	log.Printf("Got a request DELETE /rules/%s\n", params.RuleId)
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	err = h.financeService.DeleteRule(ctx, params.RuleId, version)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.DeleteRuleNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+	return &oas.DeleteRuleNoContent{}, nil
+}
+
+func (h *dobbyHandler) ApplyRules(ctx context.Context, req *oas.ApplyRules, params oas.ApplyRulesParams) (oas.ApplyRulesRes, error) {
+	log.Printf("Got a request POST /periods/%s/apply-rules\n", params.PeriodId)
+
+	res, err := h.financeService.ApplyRules(ctx, params.PeriodId, req.Commit.Or(false))
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.ApplyRulesNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	changes := make([]oas.RuleChange, len(res.Changes))
+	for i, c := range res.Changes {
+		changes[i] = oas.RuleChange{
+			Before: *mapTransactionToOAS(&c.Before),
+			After:  *mapTransactionToOAS(&c.After),
+		}
+	}
+	return &oas.RuleApplication{
+		Committed: res.Committed,
+		Changes:   changes,
+	}, nil
+}
+
+func mapRuleToOAS(r *service.Rule) *oas.Rule {
+	weekdays := make([]int, len(r.Weekdays))
+	for i, wd := range r.Weekdays {
+		weekdays[i] = int(wd)
+	}
+
+	res := &oas.Rule{
+		ID:          r.ID,
+		Name:        r.Name,
+		Priority:    r.Priority,
+		PatternType: oas.PatternType(r.PatternType),
+		Weekdays:    weekdays,
+		EnvelopeId:  optUUIDFromPtr(r.EnvelopeID),
+	}
+	if r.DescriptionPattern != "" {
+		res.DescriptionPattern = oas.NewOptString(r.DescriptionPattern)
+	}
+	if r.Category != "" {
+		res.Category = oas.NewOptString(r.Category)
+	}
+	if r.MinAmount != nil {
+		res.MinAmount = oas.NewOptInt64(*r.MinAmount)
+	}
+	if r.MaxAmount != nil {
+		res.MaxAmount = oas.NewOptInt64(*r.MaxAmount)
+	}
+	return res
+}
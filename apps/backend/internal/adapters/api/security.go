@@ -2,76 +2,102 @@ package api
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
-	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
 )
 
-type contextKey string
-
-const (
-	userIDKey contextKey = "userID"
-)
+// userProvisioner maps token subjects and personal access tokens to persisted users and their households.
+type userProvisioner interface {
+	ProvisionUser(ctx context.Context, u service.User) (*service.User, error)
+	AuthenticateAccessToken(ctx context.Context, token string) (*service.AccessToken, error)
+	GetUserHouseholdID(ctx context.Context, userID uuid.UUID) (uuid.UUID, error)
+}
 
-type introspectionResponse struct {
-	Active bool   `json:"active"`
-	Sub    string `json:"sub"`
+// tokenClaims is the identity extracted from a verified access token.
+type tokenClaims struct {
+	Subject   string
+	Name      string
+	Email     string
+	ExpiresAt time.Time // Zero if the token does not expire
 }
 
 type dobbySecurity struct {
-	introspectionURL string
-	clientID         string
-	clientSecret     string
-	httpClient       *http.Client
+	// jwt validates JWT access tokens locally. Nil when local validation is disabled.
+	jwt *jwksVerifier
+	// introspector validates opaque tokens, and every token when local validation is disabled.
+	introspector *introspector
+	users        userProvisioner
+
+	// provisioned remembers, per token subject, the user ID and the profile claims last synced to the database.
+	provisioned sync.Map // Subject -> provisionedUser
+}
+
+type provisionedUser struct {
+	id          uuid.UUID
+	name, email string
 }
 
 func (s *dobbySecurity) HandleBearerAuth(ctx context.Context, operationName oas.OperationName, t oas.BearerAuth) (context.Context, error) {
-	if s.introspectionURL == "" {
-		return ctx, fmt.Errorf("authorization not configured: introspection URL missing")
+	var userID uuid.UUID
+	if strings.HasPrefix(t.Token, service.AccessTokenPrefix) {
+		pat, err := s.users.AuthenticateAccessToken(ctx, t.Token)
+		if err != nil {
+			return nil, err
+		}
+		userID = pat.UserID
+		ctx = service.WithTokenScope(ctx, pat.Scope)
+	} else {
+		claims, err := s.verify(ctx, t.Token)
+		if err != nil {
+			return nil, err
+		}
+		userID, err = s.provision(ctx, claims)
+		if err != nil {
+			return nil, fmt.Errorf("failed to provision user: %w", err)
+		}
 	}
-
-	data := url.Values{}
-	data.Set("token", t.Token)
-
-	req, err := http.NewRequestWithContext(ctx, "POST", s.introspectionURL, strings.NewReader(data.Encode()))
+	// Looked up on every request, as joining another household moves the user at any time.
+	householdID, err := s.users.GetUserHouseholdID(ctx, userID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create introspection request: %w", err)
+		return nil, fmt.Errorf("failed to resolve household: %w", err)
 	}
+	ctx = service.WithUserID(ctx, userID)
+	return service.WithHouseholdID(ctx, householdID), nil
+}
 
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.SetBasicAuth(s.clientID, s.clientSecret)
-
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("introspection request failed: %w", err)
+// provision upserts the user behind the token the first time their identity is seen, and again whenever their profile claims change.
+func (s *dobbySecurity) provision(ctx context.Context, claims *tokenClaims) (uuid.UUID, error) {
+	if v, ok := s.provisioned.Load(claims.Subject); ok {
+		if p := v.(provisionedUser); p.name == claims.Name && p.email == claims.Email {
+			return p.id, nil
+		}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("introspection returned status %d: %s", resp.StatusCode, string(body))
+	u, err := s.users.ProvisionUser(ctx, service.User{Subject: claims.Subject, Name: claims.Name, Email: claims.Email})
+	if err != nil {
+		return uuid.Nil, err
 	}
+	s.provisioned.Store(claims.Subject, provisionedUser{id: u.ID, name: claims.Name, email: claims.Email})
+	return u.ID, nil
+}
 
-	var ir introspectionResponse
-	if err := json.NewDecoder(resp.Body).Decode(&ir); err != nil {
-		return nil, fmt.Errorf("failed to decode introspection response: %w", err)
+func (s *dobbySecurity) verify(ctx context.Context, token string) (*tokenClaims, error) {
+	if s.jwt != nil && looksLikeJWT(token) {
+		return s.jwt.Verify(ctx, token)
 	}
-
-	if !ir.Active {
-		return nil, fmt.Errorf("invalid token")
+	if s.introspector == nil {
+		return nil, fmt.Errorf("authorization not configured: introspection URL missing")
 	}
-
-	// Extract the subject (user ID) from the authorized context
-	return context.WithValue(ctx, userIDKey, ir.Sub), nil
+	return s.introspector.Introspect(ctx, token)
 }
 
-// GetUserID retrieves the user ID from the context if it exists.
-func GetUserID(ctx context.Context) (string, bool) {
-	id, ok := ctx.Value(userIDKey).(string)
-	return id, ok
+// looksLikeJWT reports whether the token has the compact JWS shape. Anything else is treated as opaque.
+func looksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
 }
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+)
+
+type fakeProvisioner struct {
+	calls []service.User
+	ids   map[string]uuid.UUID
+	pats  map[string]*service.AccessToken
+}
+
+func (f *fakeProvisioner) ProvisionUser(ctx context.Context, u service.User) (*service.User, error) {
+	f.calls = append(f.calls, u)
+	if _, ok := f.ids[u.Subject]; !ok {
+		f.ids[u.Subject] = uuid.New()
+	}
+	u.ID = f.ids[u.Subject]
+	return &u, nil
+}
+
+func (f *fakeProvisioner) AuthenticateAccessToken(ctx context.Context, token string) (*service.AccessToken, error) {
+	if pat, ok := f.pats[token]; ok {
+		return pat, nil
+	}
+	return nil, service.ErrForbidden
+}
+
+func (f *fakeProvisioner) GetUserHouseholdID(ctx context.Context, userID uuid.UUID) (uuid.UUID, error) {
+	return userID, nil
+}
+
+func TestSecurityProvision(t *testing.T) {
+	users := &fakeProvisioner{ids: map[string]uuid.UUID{}}
+	s := &dobbySecurity{users: users}
+	ctx := context.Background()
+
+	first, err := s.provision(ctx, &tokenClaims{Subject: "sub-1", Name: "Jane"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	again, _ := s.provision(ctx, &tokenClaims{Subject: "sub-1", Name: "Jane"})
+	if again != first || len(users.calls) != 1 {
+		t.Fatalf("expected known identity to be served from memory, got %d provision calls", len(users.calls))
+	}
+
+	renamed, _ := s.provision(ctx, &tokenClaims{Subject: "sub-1", Name: "Jane Doe"})
+	if renamed != first || len(users.calls) != 2 {
+		t.Errorf("expected changed profile to be synced to the same user, got %d provision calls", len(users.calls))
+	}
+	reverted, _ := s.provision(ctx, &tokenClaims{Subject: "sub-1", Name: "Jane"})
+	if reverted != first || len(users.calls) != 3 {
+		t.Errorf("expected reverted profile to be synced again, got %d provision calls", len(users.calls))
+	}
+
+	other, _ := s.provision(ctx, &tokenClaims{Subject: "sub-2"})
+	if other == first {
+		t.Error("expected different subjects to map to different users")
+	}
+
+	entries := 0
+	s.provisioned.Range(func(_, _ any) bool { entries++; return true })
+	if entries != 2 {
+		t.Errorf("expected one cached entry per subject, got %d", entries)
+	}
+}
+
+func TestSecurityAccessToken(t *testing.T) {
+	pat := &service.AccessToken{UserID: uuid.New(), Scope: service.ScopeRead}
+	users := &fakeProvisioner{pats: map[string]*service.AccessToken{"dobby_pat_known": pat}}
+	s := &dobbySecurity{users: users}
+
+	ctx, err := s.HandleBearerAuth(context.Background(), "", oas.BearerAuth{Token: "dobby_pat_known"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id, _ := service.UserIDFromContext(ctx); id != pat.UserID {
+		t.Errorf("expected user %v, got %v", pat.UserID, id)
+	}
+	if scope, ok := service.TokenScopeFromContext(ctx); !ok || scope != service.ScopeRead {
+		t.Errorf("expected read scope, got %q", scope)
+	}
+	if _, ok := service.HouseholdIDFromContext(ctx); !ok {
+		t.Error("expected household to be resolved")
+	}
+
+	// Access tokens are never handed to the identity provider.
+	if _, err := s.HandleBearerAuth(context.Background(), "", oas.BearerAuth{Token: "dobby_pat_unknown"}); err == nil {
+		t.Error("expected unknown access token to be rejected")
+	}
+	if len(users.calls) != 0 {
+		t.Errorf("expected no provisioning for access tokens, got %d calls", len(users.calls))
+	}
+}
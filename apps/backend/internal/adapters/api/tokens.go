@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+func (h *dobbyHandler) ListAccessTokens(ctx context.Context) ([]oas.AccessToken, error) {
+	log.Println("Got a request GET /me/tokens")
+
+	tokens, err := h.financeService.ListAccessTokens(ctx)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	res := make([]oas.AccessToken, len(tokens))
+	for i, t := range tokens {
+		res[i] = *mapAccessTokenToOAS(&t)
+	}
+	return res, nil
+}
+
+func (h *dobbyHandler) CreateAccessToken(ctx context.Context, req *oas.CreateAccessToken) (*oas.CreatedAccessToken, error) {
+	log.Println("Got a request POST /me/tokens")
+
+	var expiresAt *time.Time
+	if v, ok := req.ExpiresAt.Get(); ok {
+		expiresAt = &v
+	}
+	t, err := h.financeService.CreateAccessToken(ctx, req.Name, service.TokenScope(req.Scope), expiresAt)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	res := mapAccessTokenToOAS(t)
+	return &oas.CreatedAccessToken{
+		ID:         res.ID,
+		Name:       res.Name,
+		Scope:      res.Scope,
+		CreatedAt:  res.CreatedAt,
+		ExpiresAt:  res.ExpiresAt,
+		LastUsedAt: res.LastUsedAt,
+		Token:      t.Token,
+	}, nil
+}
+
+func (h *dobbyHandler) RevokeAccessToken(ctx context.Context, params oas.RevokeAccessTokenParams) (oas.RevokeAccessTokenRes, error) {
+	log.Printf("Got a request DELETE /me/tokens/%s\n", params.TokenId)
+
+	err := h.financeService.RevokeAccessToken(ctx, params.TokenId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.RevokeAccessTokenNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+	return &oas.RevokeAccessTokenNoContent{}, nil
+}
+
+func mapAccessTokenToOAS(t *service.AccessToken) *oas.AccessToken {
+	return &oas.AccessToken{
+		ID:         t.ID,
+		Name:       t.Name,
+		Scope:      oas.AccessTokenScope(t.Scope),
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: optDateTimeFromPtr(t.LastUsedAt),
+	}
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"log/slog"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+// trashPurgeInterval is how often the retention job looks for expired items in the trash.
+const trashPurgeInterval = time.Hour
+
+func (h *dobbyHandler) ListTrash(ctx context.Context) (*oas.Trash, error) {
+	log.Println("Got a request GET /trash")
+
+	trash, err := h.financeService.ListTrash(ctx)
+	if err != nil {
+		return nil, h.NewError(ctx, err)
+	}
+
+	res := &oas.Trash{
+		Transactions: make([]oas.Transaction, len(trash.Transactions)),
+		Envelopes:    make([]oas.Envelope, len(trash.Envelopes)),
+	}
+	for i, t := range trash.Transactions {
+		res.Transactions[i] = *mapTransactionToOAS(&t)
+	}
+	for i, e := range trash.Envelopes {
+		res.Envelopes[i] = *mapEnvelopeToOAS(&e)
+	}
+	return res, nil
+}
+
+func (h *dobbyHandler) RestoreTransaction(ctx context.Context, params oas.RestoreTransactionParams) (oas.RestoreTransactionRes, error) {
+	log.Printf("Got a request POST /transactions/%s/restore\n", params.TransactionId)
+
+	t, err := h.financeService.RestoreTransaction(ctx, params.TransactionId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.RestoreTransactionNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+	return mapTransactionToOAS(t), nil
+}
+
+func (h *dobbyHandler) RestoreEnvelope(ctx context.Context, params oas.RestoreEnvelopeParams) (oas.RestoreEnvelopeRes, error) {
+	log.Printf("Got a request POST /envelopes/%s/restore\n", params.EnvelopeId)
+
+	e, err := h.financeService.RestoreEnvelope(ctx, params.EnvelopeId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return &oas.RestoreEnvelopeNotFound{}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+	return mapEnvelopeToOAS(e), nil
+}
+
+// runTrashRetention permanently deletes items that have been in the trash for longer than retention,
+// once at startup and then every trashPurgeInterval, until ctx is done.
+func runTrashRetention(ctx context.Context, svc service.FinanceService, retention time.Duration) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+	for {
+		purged, err := svc.PurgeTrash(ctx, retention)
+		if err != nil {
+			slog.Error("Failed to purge the trash", "error", err)
+		} else if purged > 0 {
+			slog.Info("Purged the trash", "items", purged, "retention", retention)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+// etag formats the version of an entity as a strong entity tag.
+func etag(version int64) oas.OptString {
+	return oas.NewOptString(`"` + strconv.FormatInt(version, 10) + `"`)
+}
+
+// ifMatchVersion reads the version a change is based on from an If-Match header.
+// Without the header, or with "*", it is zero, which skips the version check.
+// Only a single entity tag as issued by etag can ever match, anything else fails the precondition.
+func ifMatchVersion(header oas.OptString) (int64, error) {
+	v, ok := header.Get()
+	v = strings.TrimSpace(v)
+	if !ok || v == "*" {
+		return 0, nil
+	}
+	unquoted, found := strings.CutPrefix(v, `"`)
+	unquoted, closed := strings.CutSuffix(unquoted, `"`)
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if !found || !closed || err != nil || version <= 0 {
+		return 0, fmt.Errorf("%w: If-Match %s does not name a version", service.ErrPreconditionFailed, v)
+	}
+	return version, nil
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/adapters/oas"
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	tests := []struct {
+		header  oas.OptString
+		want    int64
+		wantErr error
+	}{
+		{oas.OptString{}, 0, nil},
+		{oas.NewOptString("*"), 0, nil},
+		{etag(7), 7, nil},
+		{oas.NewOptString(` "12" `), 12, nil},
+		{oas.NewOptString(""), 0, service.ErrPreconditionFailed},
+		{oas.NewOptString("7"), 0, service.ErrPreconditionFailed},
+		{oas.NewOptString(`W/"7"`), 0, service.ErrPreconditionFailed},
+		{oas.NewOptString(`"7", "8"`), 0, service.ErrPreconditionFailed},
+		{oas.NewOptString(`"0"`), 0, service.ErrPreconditionFailed},
+		{oas.NewOptString(`"abc"`), 0, service.ErrPreconditionFailed},
+	}
+	for _, tt := range tests {
+		got, err := ifMatchVersion(tt.header)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%q: expected %v, got %v", tt.header.Value, tt.wantErr, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%q: expected %d, got %d, %v", tt.header.Value, tt.want, got, err)
+		}
+	}
+}
@@ -1,20 +1,58 @@
 package oas
 
 import (
+	"time"
+
 	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
 )
 
-// ToLogicModel converts CreateEnvelope DTO to logic parameters.
-// We only return name because ID is handled by service/handler.
-func (req *CreateEnvelope) ToLogicModel() string {
-	return req.Name
+// ApplyToModel applies UpdatePeriod DTO to an existing logic model.
+// The total budget is derived from the envelopes of the period, so it is not applied.
+func (req *UpdatePeriod) ApplyToModel(p *service.Period) {
+	if v, ok := req.StartDate.Get(); ok {
+		p.StartDate = v
+	}
+	if v, ok := req.EndDate.Get(); ok {
+		p.EndDate = v
+	}
+	if req.DefaultEnvelopeId.IsSet() {
+		p.DefaultEnvelopeID = nil
+		if v, ok := req.DefaultEnvelopeId.Get(); ok {
+			p.DefaultEnvelopeID = &v
+		}
+	}
+}
+
+// ToLogicModel converts CreateEnvelope DTO to logic model.
+// ID is left empty because it is handled by service.
+func (req *CreateEnvelope) ToLogicModel() service.Envelope {
+	e := service.Envelope{
+		Name: req.Name,
+	}
+	if v, ok := req.RolloverPolicy.Get(); ok {
+		e.RolloverPolicy = service.RolloverPolicy(v)
+	}
+	return e
+}
+
+// ApplyToModel applies UpdateEnvelope DTO to an existing logic model.
+func (req *UpdateEnvelope) ApplyToModel(e *service.Envelope) {
+	if v, ok := req.Name.Get(); ok {
+		e.Name = v
+	}
+	if v, ok := req.RolloverPolicy.Get(); ok {
+		e.RolloverPolicy = service.RolloverPolicy(v)
+	}
 }
 
 // ToLogicModel converts CreateTransaction DTO to logic model.
 func (req *CreateTransaction) ToLogicModel() service.Transaction {
 	t := service.Transaction{
-		EnvelopeID: req.EnvelopeId,
-		Amount:     req.Amount,
+		Amount: req.Amount,
+	}
+
+	if v, ok := req.EnvelopeId.Get(); ok {
+		t.EnvelopeID = v
 	}
 
 	if v, ok := req.Description.Get(); ok {
@@ -29,6 +67,8 @@ func (req *CreateTransaction) ToLogicModel() service.Transaction {
 		t.Category = v
 	}
 
+	t.Splits = splitsToLogicModel(req.Splits)
+
 	return t
 }
 
@@ -49,4 +89,288 @@ func (req *UpdateTransaction) ApplyToModel(t *service.Transaction) {
 	if v, ok := req.Category.Get(); ok {
 		t.Category = v
 	}
+	if req.Splits != nil {
+		t.Splits = splitsToLogicModel(req.Splits)
+	}
+}
+
+// ToLogicModel converts listTransactions query parameters to a transaction filter.
+func (p *ListTransactionsParams) ToLogicModel() service.TransactionFilter {
+	var f service.TransactionFilter
+	if v, ok := p.PeriodId.Get(); ok {
+		f.PeriodID = &v
+	}
+	if v, ok := p.EnvelopeId.Get(); ok {
+		f.EnvelopeID = &v
+	}
+	if v, ok := p.CreatedBy.Get(); ok {
+		f.CreatedBy = &v
+	}
+	if v, ok := p.DateFrom.Get(); ok {
+		f.DateFrom = &v
+	}
+	if v, ok := p.DateTo.Get(); ok {
+		f.DateTo = &v
+	}
+	if v, ok := p.MinAmount.Get(); ok {
+		f.MinAmount = &v
+	}
+	if v, ok := p.MaxAmount.Get(); ok {
+		f.MaxAmount = &v
+	}
+	if v, ok := p.Category.Get(); ok {
+		f.Category = &v
+	}
+	if v, ok := p.Description.Get(); ok {
+		f.Description = &v
+	}
+	if v, ok := p.Sign.Get(); ok {
+		sign := service.TransactionSign(v)
+		f.Sign = &sign
+	}
+	if v, ok := p.Sort.Get(); ok {
+		f.Sort = service.TransactionSort(v)
+	}
+	if v, ok := p.Limit.Get(); ok {
+		f.Limit = v
+	}
+	return f
+}
+
+// ToLogicModel converts listAuditEntries query parameters to an audit filter.
+func (p *ListAuditEntriesParams) ToLogicModel() service.AuditFilter {
+	var f service.AuditFilter
+	if v, ok := p.Entity.Get(); ok {
+		entity := service.AuditEntity(v)
+		f.Entity = &entity
+	}
+	if v, ok := p.EntityId.Get(); ok {
+		f.EntityID = &v
+	}
+	if v, ok := p.ActorId.Get(); ok {
+		f.ActorID = &v
+	}
+	if v, ok := p.From.Get(); ok {
+		f.From = &v
+	}
+	if v, ok := p.To.Get(); ok {
+		f.To = &v
+	}
+	if v, ok := p.Limit.Get(); ok {
+		f.Limit = v
+	}
+	return f
+}
+
+func splitsToLogicModel(splits []TransactionSplit) []service.TransactionSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+	res := make([]service.TransactionSplit, len(splits))
+	for i, line := range splits {
+		res[i] = service.TransactionSplit{
+			EnvelopeID: line.EnvelopeId,
+			Amount:     line.Amount,
+			Category:   line.Category.Or(""),
+		}
+	}
+	return res
+}
+
+// ToLogicModel converts RecurrenceSchedule DTO to logic model.
+func (req *RecurrenceSchedule) ToLogicModel() service.RecurrenceSchedule {
+	sch := service.RecurrenceSchedule{
+		Kind: service.RecurrenceKind(req.Kind),
+	}
+	if v, ok := req.DayOfMonth.Get(); ok {
+		sch.DayOfMonth = v
+	}
+	if v, ok := req.IntervalWeeks.Get(); ok {
+		sch.IntervalWeeks = v
+	}
+	if v, ok := req.AnchorDate.Get(); ok {
+		sch.AnchorDate = v
+	}
+	return sch
+}
+
+// ToLogicModel converts PeriodSchedule DTO to logic model.
+func (req *PeriodSchedule) ToLogicModel() service.PeriodSchedule {
+	sch := service.PeriodSchedule{
+		Kind:       service.PeriodScheduleKind(req.Kind),
+		Adjustment: service.AdjustNone,
+		TimeZone:   req.TimeZone,
+	}
+	if v, ok := req.DayOfMonth.Get(); ok {
+		sch.DayOfMonth = v
+	}
+	if v, ok := req.SecondDayOfMonth.Get(); ok {
+		sch.SecondDayOfMonth = v
+	}
+	if v, ok := req.IntervalWeeks.Get(); ok {
+		sch.IntervalWeeks = v
+	}
+	if v, ok := req.AnchorDate.Get(); ok {
+		sch.AnchorDate = v
+	}
+	if v, ok := req.Adjustment.Get(); ok {
+		sch.Adjustment = service.BusinessDayAdjustment(v)
+	}
+	if v, ok := req.HolidayCountry.Get(); ok {
+		sch.HolidayCountry = string(v)
+	}
+	return sch
+}
+
+// ToLogicModel converts CreateRecurringTransaction DTO to logic model.
+func (req *CreateRecurringTransaction) ToLogicModel() service.RecurringTransaction {
+	rt := service.RecurringTransaction{
+		EnvelopeID: req.EnvelopeId,
+		Amount:     req.Amount,
+		Schedule:   req.Schedule.ToLogicModel(),
+	}
+	if v, ok := req.Description.Get(); ok {
+		rt.Description = v
+	}
+	if v, ok := req.Category.Get(); ok {
+		rt.Category = v
+	}
+	return rt
+}
+
+// ApplyToModel applies UpdateRecurringTransaction DTO to an existing logic model.
+func (req *UpdateRecurringTransaction) ApplyToModel(rt *service.RecurringTransaction) {
+	if v, ok := req.EnvelopeId.Get(); ok {
+		rt.EnvelopeID = v
+	}
+	if v, ok := req.Amount.Get(); ok {
+		rt.Amount = v
+	}
+	if v, ok := req.Description.Get(); ok {
+		rt.Description = v
+	}
+	if v, ok := req.Category.Get(); ok {
+		rt.Category = v
+	}
+	if v, ok := req.Schedule.Get(); ok {
+		rt.Schedule = v.ToLogicModel()
+	}
+}
+
+// ToLogicModel converts ImportRequest DTO to logic model.
+func (req *ImportRequest) ToLogicModel() service.ImportRequest {
+	r := service.ImportRequest{
+		Content: []byte(req.Content),
+		Commit:  req.Commit.Or(false),
+	}
+	if v, ok := req.EnvelopeId.Get(); ok {
+		r.EnvelopeID = v
+	}
+	switch format := req.Format.Or(ImportRequestFormatCsv); format {
+	case ImportRequestFormatQfx:
+		r.Format = service.ImportFormatOFX
+	default:
+		r.Format = service.ImportFormat(format)
+	}
+	if v, ok := req.Category.Get(); ok {
+		r.Category = v
+	}
+	if v, ok := req.Csv.Get(); ok {
+		r.CSV = v.ToLogicModel()
+	}
+	return r
+}
+
+// ToLogicModel converts CsvMapping DTO to logic model.
+// Unset fields are left empty so the service applies its defaults.
+func (req *CsvMapping) ToLogicModel() service.CSVMapping {
+	m := service.CSVMapping{
+		DateColumn:        req.DateColumn,
+		SkipRows:          req.SkipRows.Or(0),
+		DateFormat:        req.DateFormat.Or(""),
+		AmountColumn:      req.AmountColumn.Or(""),
+		DebitColumn:       req.DebitColumn.Or(""),
+		CreditColumn:      req.CreditColumn.Or(""),
+		DescriptionColumn: req.DescriptionColumn.Or(""),
+		SignConvention:    service.SignConvention(req.SignConvention.Or("")),
+	}
+	if v, ok := req.Delimiter.Get(); ok {
+		m.Delimiter = []rune(v)[0]
+	}
+	if v, ok := req.DecimalSeparator.Get(); ok {
+		m.DecimalSeparator = []rune(string(v))[0]
+	}
+	return m
+}
+
+// ToLogicModel converts CreateRule DTO to logic model.
+// ID is left empty because it is handled by service.
+func (req *CreateRule) ToLogicModel() service.Rule {
+	r := service.Rule{
+		Name:               req.Name,
+		Priority:           req.Priority.Or(0),
+		DescriptionPattern: req.DescriptionPattern.Or(""),
+		PatternType:        service.PatternType(req.PatternType.Or(PatternTypeSubstring)),
+		Weekdays:           weekdaysToLogicModel(req.Weekdays),
+		Category:           req.Category.Or(""),
+	}
+	if v, ok := req.MinAmount.Get(); ok {
+		r.MinAmount = &v
+	}
+	if v, ok := req.MaxAmount.Get(); ok {
+		r.MaxAmount = &v
+	}
+	if v, ok := req.EnvelopeId.Get(); ok {
+		r.EnvelopeID = &v
+	}
+	return r
+}
+
+// ApplyToModel applies UpdateRule DTO to an existing logic model.
+// Nullable fields explicitly set to null are cleared.
+func (req *UpdateRule) ApplyToModel(r *service.Rule) {
+	if v, ok := req.Name.Get(); ok {
+		r.Name = v
+	}
+	if v, ok := req.Priority.Get(); ok {
+		r.Priority = v
+	}
+	if v, ok := req.DescriptionPattern.Get(); ok {
+		r.DescriptionPattern = v
+	}
+	if v, ok := req.PatternType.Get(); ok {
+		r.PatternType = service.PatternType(v)
+	}
+	if req.MinAmount.IsSet() {
+		r.MinAmount = nil
+		if v, ok := req.MinAmount.Get(); ok {
+			r.MinAmount = &v
+		}
+	}
+	if req.MaxAmount.IsSet() {
+		r.MaxAmount = nil
+		if v, ok := req.MaxAmount.Get(); ok {
+			r.MaxAmount = &v
+		}
+	}
+	if req.Weekdays != nil {
+		r.Weekdays = weekdaysToLogicModel(req.Weekdays)
+	}
+	if v, ok := req.Category.Get(); ok {
+		r.Category = v
+	}
+	if req.EnvelopeId.IsSet() {
+		r.EnvelopeID = nil
+		if v, ok := req.EnvelopeId.Get(); ok {
+			r.EnvelopeID = &v
+		}
+	}
+}
+
+func weekdaysToLogicModel(days []int) []time.Weekday {
+	res := make([]time.Weekday, len(days))
+	for i, d := range days {
+		res[i] = time.Weekday(d)
+	}
+	return res
 }
@@ -28,27 +28,73 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// ApplyRules invokes applyRules operation.
+	//
+	// Re-evaluates all rules against the transactions of the period, overwriting the category
+	// and envelope of matching transactions. Transfers are left alone. With `commit: false` (the default)
+	// nothing is saved and the result only reports what would change.
+	//
+	// POST /periods/{periodId}/apply-rules
+	ApplyRules(ctx context.Context, request *ApplyRules, params ApplyRulesParams) (ApplyRulesRes, error)
+	// CreateAccessToken invokes createAccessToken operation.
+	//
+	// The token authenticates scripts as the current user, sent as a bearer token like any other.
+	// It is shown only once. Tokens cannot be managed with an access token.
+	//
+	// POST /me/tokens
+	CreateAccessToken(ctx context.Context, request *CreateAccessToken) (*CreatedAccessToken, error)
 	// CreateEnvelope invokes createEnvelope operation.
 	//
 	// Create a new envelope.
 	//
 	// POST /envelopes
-	CreateEnvelope(ctx context.Context, request *CreateEnvelope) (*Envelope, error)
+	CreateEnvelope(ctx context.Context, request *CreateEnvelope, params CreateEnvelopeParams) (*Envelope, error)
+	// CreateInvitation invokes createInvitation operation.
+	//
+	// Returns a one-time code that expires after seven days. The code is shown only once.
+	//
+	// POST /household/invitations
+	CreateInvitation(ctx context.Context, request OptCreateInvitation) (*Invitation, error)
 	// CreatePeriod invokes createPeriod operation.
 	//
-	// Create a new financial period.
+	// Periods run from their start date up to, but not including, their end date, so the next
+	// period may start on the day this one ends. Omitted dates follow the period schedule of the
+	// household: without dates, the scheduled period around today is created. A period overlapping
+	// another one is rejected
+	// with 409. When the server rejects period gaps, a period leaving days uncovered between it
+	// and its neighbours is rejected with 400.
 	//
 	// POST /periods
-	CreatePeriod(ctx context.Context, request *CreatePeriod) (*PeriodSummary, error)
+	CreatePeriod(ctx context.Context, request *CreatePeriod, params CreatePeriodParams) (*PeriodSummary, error)
+	// CreateRecurringTransaction invokes createRecurringTransaction operation.
+	//
+	// Create a recurring transaction template.
+	//
+	// POST /recurring-transactions
+	CreateRecurringTransaction(ctx context.Context, request *CreateRecurringTransaction) (*RecurringTransaction, error)
+	// CreateRule invokes createRule operation.
+	//
+	// Create a categorisation rule.
+	//
+	// POST /rules
+	CreateRule(ctx context.Context, request *CreateRule) (*Rule, error)
 	// CreateTransaction invokes createTransaction operation.
 	//
 	// Create a new transaction.
 	//
 	// POST /transactions
-	CreateTransaction(ctx context.Context, request *CreateTransaction) (CreateTransactionRes, error)
+	CreateTransaction(ctx context.Context, request *CreateTransaction, params CreateTransactionParams) (CreateTransactionRes, error)
+	// CreateTransfer invokes createTransfer operation.
+	//
+	// Transfer funds between envelopes.
+	//
+	// POST /transfers
+	CreateTransfer(ctx context.Context, request *CreateTransfer) (*Transfer, error)
 	// DeleteEnvelope invokes deleteEnvelope operation.
 	//
-	// Delete an envelope.
+	// Moves the envelope to the trash, from where it can be restored until the retention period ends.
+	// Fails with 409 while transactions outside the trash, recurring transactions or rules use the
+	// envelope.
 	//
 	// DELETE /envelopes/{envelopeId}
 	DeleteEnvelope(ctx context.Context, params DeleteEnvelopeParams) (DeleteEnvelopeRes, error)
@@ -58,9 +104,22 @@ type Invoker interface {
 	//
 	// DELETE /periods/{periodId}
 	DeletePeriod(ctx context.Context, params DeletePeriodParams) (DeletePeriodRes, error)
+	// DeleteRecurringTransaction invokes deleteRecurringTransaction operation.
+	//
+	// Transactions already generated from the template are kept.
+	//
+	// DELETE /recurring-transactions/{recurringTransactionId}
+	DeleteRecurringTransaction(ctx context.Context, params DeleteRecurringTransactionParams) (DeleteRecurringTransactionRes, error)
+	// DeleteRule invokes deleteRule operation.
+	//
+	// Delete a categorisation rule.
+	//
+	// DELETE /rules/{ruleId}
+	DeleteRule(ctx context.Context, params DeleteRuleParams) (DeleteRuleRes, error)
 	// DeleteTransaction invokes deleteTransaction operation.
 	//
-	// Delete a transaction.
+	// Moves the transaction to the trash, from where it can be restored until the retention period ends.
+	// Deleting either leg of a transfer deletes the whole transfer.
 	//
 	// DELETE /transactions/{transactionId}
 	DeleteTransaction(ctx context.Context, params DeleteTransactionParams) (DeleteTransactionRes, error)
@@ -82,54 +141,207 @@ type Invoker interface {
 	//
 	// GET /envelopes/{envelopeId}
 	GetEnvelope(ctx context.Context, params GetEnvelopeParams) (GetEnvelopeRes, error)
+	// GetHousehold invokes getHousehold operation.
+	//
+	// Get the household of the current user.
+	//
+	// GET /household
+	GetHousehold(ctx context.Context) (*Household, error)
+	// GetMemberSpending invokes getMemberSpending operation.
+	//
+	// Totals of the transactions each member recorded in the period. Transfers are not counted.
+	//
+	// GET /periods/{periodId}/member-spending
+	GetMemberSpending(ctx context.Context, params GetMemberSpendingParams) (GetMemberSpendingRes, error)
 	// GetPeriod invokes getPeriod operation.
 	//
 	// Get period by ID.
 	//
 	// GET /periods/{periodId}
 	GetPeriod(ctx context.Context, params GetPeriodParams) (GetPeriodRes, error)
+	// GetPeriodSchedule invokes getPeriodSchedule operation.
+	//
+	// Households that have not configured a schedule get the default one, starting periods on the 5th of
+	// the month or the business day before it.
+	//
+	// GET /household/period-schedule
+	GetPeriodSchedule(ctx context.Context) (*PeriodScheduleHeaders, error)
+	// GetRecurringTransaction invokes getRecurringTransaction operation.
+	//
+	// Get recurring transaction template by ID.
+	//
+	// GET /recurring-transactions/{recurringTransactionId}
+	GetRecurringTransaction(ctx context.Context, params GetRecurringTransactionParams) (GetRecurringTransactionRes, error)
+	// GetRule invokes getRule operation.
+	//
+	// Get categorisation rule by ID.
+	//
+	// GET /rules/{ruleId}
+	GetRule(ctx context.Context, params GetRuleParams) (GetRuleRes, error)
 	// GetTransaction invokes getTransaction operation.
 	//
 	// Get transaction by ID.
 	//
 	// GET /transactions/{transactionId}
 	GetTransaction(ctx context.Context, params GetTransactionParams) (GetTransactionRes, error)
+	// ImportHolidays invokes importHolidays operation.
+	//
+	// Replaces the imported holidays of the household. Every day an all-day event covers is a holiday,
+	// events with a time are holidays on their start date. Events may recur yearly (`RRULE:FREQ=YEARLY`),
+	// other recurrence rules are rejected.
+	//
+	// PUT /household/holidays
+	ImportHolidays(ctx context.Context, request *ImportHolidays) ([]Holiday, error)
+	// ImportTransactions invokes importTransactions operation.
+	//
+	// Parses a bank statement, assigns each row to the period containing its date and
+	// detects rows that were already recorded. With `commit: false` (the default) nothing is saved,
+	// so the result can be reviewed first; re-send the same request with `commit: true` to record the
+	// new rows.
+	//
+	// POST /imports
+	ImportTransactions(ctx context.Context, request *ImportRequest) (*ImportResult, error)
+	// JoinHousehold invokes joinHousehold operation.
+	//
+	// The current user leaves their household. Its data stays with the remaining members. Households
+	// cannot be joined with an access token.
+	//
+	// POST /household/join
+	JoinHousehold(ctx context.Context, request *JoinHousehold) (*Household, error)
+	// ListAccessTokens invokes listAccessTokens operation.
+	//
+	// Revoked tokens are not listed.
+	//
+	// GET /me/tokens
+	ListAccessTokens(ctx context.Context) ([]AccessToken, error)
+	// ListAuditEntries invokes listAuditEntries operation.
+	//
+	// Every change to household data is recorded with who made it, when, and the entity
+	// before and after the change. Entries are listed newest first.
+	//
+	// GET /audit
+	ListAuditEntries(ctx context.Context, params ListAuditEntriesParams) ([]AuditEntry, error)
 	// ListEnvelopes invokes listEnvelopes operation.
 	//
 	// List all envelopes.
 	//
 	// GET /envelopes
 	ListEnvelopes(ctx context.Context) ([]Envelope, error)
+	// ListHolidays invokes listHolidays operation.
+	//
+	// Holidays of the built-in calendar chosen in the period schedule and imported ones, which period
+	// starts are moved off.
+	//
+	// GET /household/holidays
+	ListHolidays(ctx context.Context, params ListHolidaysParams) ([]Holiday, error)
+	// ListPeriodGaps invokes listPeriodGaps operation.
+	//
+	// Date ranges between consecutive periods that no period covers, earliest first.
+	//
+	// GET /periods/gaps
+	ListPeriodGaps(ctx context.Context) ([]PeriodGap, error)
 	// ListPeriods invokes listPeriods operation.
 	//
 	// List all financial periods.
 	//
 	// GET /periods
 	ListPeriods(ctx context.Context) ([]PeriodListItem, error)
+	// ListRecurringTransactions invokes listRecurringTransactions operation.
+	//
+	// List recurring transaction templates.
+	//
+	// GET /recurring-transactions
+	ListRecurringTransactions(ctx context.Context) ([]RecurringTransaction, error)
+	// ListRules invokes listRules operation.
+	//
+	// List categorisation rules.
+	//
+	// GET /rules
+	ListRules(ctx context.Context) ([]Rule, error)
 	// ListTransactions invokes listTransactions operation.
 	//
 	// List transactions.
 	//
 	// GET /transactions
-	ListTransactions(ctx context.Context, params ListTransactionsParams) ([]Transaction, error)
+	ListTransactions(ctx context.Context, params ListTransactionsParams) (*ListTransactionsOKHeaders, error)
+	// ListTrash invokes listTrash operation.
+	//
+	// Deleted items stay in the trash until they are restored or the retention period ends,
+	// after which they are deleted permanently. Items are listed most recently deleted first.
+	//
+	// GET /trash
+	ListTrash(ctx context.Context) (*Trash, error)
 	// ListUsers invokes listUsers operation.
 	//
 	// List all household users.
 	//
 	// GET /users
 	ListUsers(ctx context.Context) ([]User, error)
+	// RestoreEnvelope invokes restoreEnvelope operation.
+	//
+	// Restore a deleted envelope from the trash.
+	//
+	// POST /envelopes/{envelopeId}/restore
+	RestoreEnvelope(ctx context.Context, params RestoreEnvelopeParams) (RestoreEnvelopeRes, error)
+	// RestoreTransaction invokes restoreTransaction operation.
+	//
+	// Restoring either leg of a transfer restores the whole transfer.
+	// Fails with 409 while an envelope of the transaction is in the trash itself.
+	//
+	// POST /transactions/{transactionId}/restore
+	RestoreTransaction(ctx context.Context, params RestoreTransactionParams) (RestoreTransactionRes, error)
+	// RevokeAccessToken invokes revokeAccessToken operation.
+	//
+	// Revoke a personal access token.
+	//
+	// DELETE /me/tokens/{tokenId}
+	RevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) (RevokeAccessTokenRes, error)
+	// SearchTransactions invokes searchTransactions operation.
+	//
+	// Full-text search over transaction descriptions across all periods. Matching is case, diacritic and
+	// script insensitive (Serbian Cyrillic and Latin), and partial words are matched by similarity.
+	// Results are ordered by relevance.
+	//
+	// GET /transactions/search
+	SearchTransactions(ctx context.Context, params SearchTransactionsParams) ([]TransactionSearchResult, error)
 	// UpdateEnvelope invokes updateEnvelope operation.
 	//
 	// Update an envelope.
 	//
 	// PATCH /envelopes/{envelopeId}
 	UpdateEnvelope(ctx context.Context, request *UpdateEnvelope, params UpdateEnvelopeParams) (UpdateEnvelopeRes, error)
+	// UpdateMember invokes updateMember operation.
+	//
+	// Only owners may manage members. A household always keeps at least one owner.
+	//
+	// PUT /household/members/{userId}
+	UpdateMember(ctx context.Context, request *UpdateMember, params UpdateMemberParams) (UpdateMemberRes, error)
 	// UpdatePeriod invokes updatePeriod operation.
 	//
-	// Update a period.
+	// Changed dates are subject to the same checks as on creation: the period must not overlap
+	// another one (409), nor, when the server rejects period gaps, leave days uncovered (400).
+	// They must also keep every transaction of the period, including those in the trash (409).
 	//
 	// PATCH /periods/{periodId}
 	UpdatePeriod(ctx context.Context, request *UpdatePeriod, params UpdatePeriodParams) (UpdatePeriodRes, error)
+	// UpdatePeriodSchedule invokes updatePeriodSchedule operation.
+	//
+	// Existing periods keep their dates, the schedule applies to periods created afterwards.
+	//
+	// PUT /household/period-schedule
+	UpdatePeriodSchedule(ctx context.Context, request *PeriodSchedule, params UpdatePeriodScheduleParams) (*PeriodScheduleHeaders, error)
+	// UpdateRecurringTransaction invokes updateRecurringTransaction operation.
+	//
+	// Update a recurring transaction template.
+	//
+	// PATCH /recurring-transactions/{recurringTransactionId}
+	UpdateRecurringTransaction(ctx context.Context, request *UpdateRecurringTransaction, params UpdateRecurringTransactionParams) (UpdateRecurringTransactionRes, error)
+	// UpdateRule invokes updateRule operation.
+	//
+	// Update a categorisation rule.
+	//
+	// PATCH /rules/{ruleId}
+	UpdateRule(ctx context.Context, request *UpdateRule, params UpdateRuleParams) (UpdateRuleRes, error)
 	// UpdateTransaction invokes updateTransaction operation.
 	//
 	// Update a transaction.
@@ -187,21 +399,23 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// CreateEnvelope invokes createEnvelope operation.
+// ApplyRules invokes applyRules operation.
 //
-// Create a new envelope.
+// Re-evaluates all rules against the transactions of the period, overwriting the category
+// and envelope of matching transactions. Transfers are left alone. With `commit: false` (the default)
+// nothing is saved and the result only reports what would change.
 //
-// POST /envelopes
-func (c *Client) CreateEnvelope(ctx context.Context, request *CreateEnvelope) (*Envelope, error) {
-	res, err := c.sendCreateEnvelope(ctx, request)
+// POST /periods/{periodId}/apply-rules
+func (c *Client) ApplyRules(ctx context.Context, request *ApplyRules, params ApplyRulesParams) (ApplyRulesRes, error) {
+	res, err := c.sendApplyRules(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateEnvelope(ctx context.Context, request *CreateEnvelope) (res *Envelope, err error) {
+func (c *Client) sendApplyRules(ctx context.Context, request *ApplyRules, params ApplyRulesParams) (res ApplyRulesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createEnvelope"),
+		otelogen.OperationID("applyRules"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/envelopes"),
+		semconv.URLTemplateKey.String("/periods/{periodId}/apply-rules"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -217,7 +431,7 @@ func (c *Client) sendCreateEnvelope(ctx context.Context, request *CreateEnvelope
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateEnvelopeOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ApplyRulesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -234,8 +448,27 @@ func (c *Client) sendCreateEnvelope(ctx context.Context, request *CreateEnvelope
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/envelopes"
+	var pathParts [3]string
+	pathParts[0] = "/periods/"
+	{
+		// Encode "periodId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "periodId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PeriodId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/apply-rules"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -243,7 +476,7 @@ func (c *Client) sendCreateEnvelope(ctx context.Context, request *CreateEnvelope
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateEnvelopeRequest(request, r); err != nil {
+	if err := encodeApplyRulesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -252,7 +485,7 @@ func (c *Client) sendCreateEnvelope(ctx context.Context, request *CreateEnvelope
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateEnvelopeOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ApplyRulesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -288,7 +521,7 @@ func (c *Client) sendCreateEnvelope(ctx context.Context, request *CreateEnvelope
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateEnvelopeResponse(resp)
+	result, err := decodeApplyRulesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -296,21 +529,22 @@ func (c *Client) sendCreateEnvelope(ctx context.Context, request *CreateEnvelope
 	return result, nil
 }
 
-// CreatePeriod invokes createPeriod operation.
+// CreateAccessToken invokes createAccessToken operation.
 //
-// Create a new financial period.
+// The token authenticates scripts as the current user, sent as a bearer token like any other.
+// It is shown only once. Tokens cannot be managed with an access token.
 //
-// POST /periods
-func (c *Client) CreatePeriod(ctx context.Context, request *CreatePeriod) (*PeriodSummary, error) {
-	res, err := c.sendCreatePeriod(ctx, request)
+// POST /me/tokens
+func (c *Client) CreateAccessToken(ctx context.Context, request *CreateAccessToken) (*CreatedAccessToken, error) {
+	res, err := c.sendCreateAccessToken(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreatePeriod(ctx context.Context, request *CreatePeriod) (res *PeriodSummary, err error) {
+func (c *Client) sendCreateAccessToken(ctx context.Context, request *CreateAccessToken) (res *CreatedAccessToken, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createPeriod"),
+		otelogen.OperationID("createAccessToken"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/periods"),
+		semconv.URLTemplateKey.String("/me/tokens"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -326,7 +560,7 @@ func (c *Client) sendCreatePeriod(ctx context.Context, request *CreatePeriod) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreatePeriodOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateAccessTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -344,7 +578,7 @@ func (c *Client) sendCreatePeriod(ctx context.Context, request *CreatePeriod) (r
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/periods"
+	pathParts[0] = "/me/tokens"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -352,7 +586,7 @@ func (c *Client) sendCreatePeriod(ctx context.Context, request *CreatePeriod) (r
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreatePeriodRequest(request, r); err != nil {
+	if err := encodeCreateAccessTokenRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -361,7 +595,7 @@ func (c *Client) sendCreatePeriod(ctx context.Context, request *CreatePeriod) (r
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreatePeriodOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateAccessTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -397,7 +631,7 @@ func (c *Client) sendCreatePeriod(ctx context.Context, request *CreatePeriod) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreatePeriodResponse(resp)
+	result, err := decodeCreateAccessTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -405,21 +639,21 @@ func (c *Client) sendCreatePeriod(ctx context.Context, request *CreatePeriod) (r
 	return result, nil
 }
 
-// CreateTransaction invokes createTransaction operation.
+// CreateEnvelope invokes createEnvelope operation.
 //
-// Create a new transaction.
+// Create a new envelope.
 //
-// POST /transactions
-func (c *Client) CreateTransaction(ctx context.Context, request *CreateTransaction) (CreateTransactionRes, error) {
-	res, err := c.sendCreateTransaction(ctx, request)
+// POST /envelopes
+func (c *Client) CreateEnvelope(ctx context.Context, request *CreateEnvelope, params CreateEnvelopeParams) (*Envelope, error) {
+	res, err := c.sendCreateEnvelope(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateTransaction(ctx context.Context, request *CreateTransaction) (res CreateTransactionRes, err error) {
+func (c *Client) sendCreateEnvelope(ctx context.Context, request *CreateEnvelope, params CreateEnvelopeParams) (res *Envelope, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createTransaction"),
+		otelogen.OperationID("createEnvelope"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/transactions"),
+		semconv.URLTemplateKey.String("/envelopes"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -435,7 +669,7 @@ func (c *Client) sendCreateTransaction(ctx context.Context, request *CreateTrans
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateTransactionOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateEnvelopeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -453,7 +687,7 @@ func (c *Client) sendCreateTransaction(ctx context.Context, request *CreateTrans
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/transactions"
+	pathParts[0] = "/envelopes"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -461,16 +695,33 @@ func (c *Client) sendCreateTransaction(ctx context.Context, request *CreateTrans
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateTransactionRequest(request, r); err != nil {
+	if err := encodeCreateEnvelopeRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Idempotency-Key",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IdempotencyKey.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateTransactionOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateEnvelopeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -506,7 +757,7 @@ func (c *Client) sendCreateTransaction(ctx context.Context, request *CreateTrans
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateTransactionResponse(resp)
+	result, err := decodeCreateEnvelopeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -514,21 +765,21 @@ func (c *Client) sendCreateTransaction(ctx context.Context, request *CreateTrans
 	return result, nil
 }
 
-// DeleteEnvelope invokes deleteEnvelope operation.
+// CreateInvitation invokes createInvitation operation.
 //
-// Delete an envelope.
+// Returns a one-time code that expires after seven days. The code is shown only once.
 //
-// DELETE /envelopes/{envelopeId}
-func (c *Client) DeleteEnvelope(ctx context.Context, params DeleteEnvelopeParams) (DeleteEnvelopeRes, error) {
-	res, err := c.sendDeleteEnvelope(ctx, params)
+// POST /household/invitations
+func (c *Client) CreateInvitation(ctx context.Context, request OptCreateInvitation) (*Invitation, error) {
+	res, err := c.sendCreateInvitation(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteEnvelope(ctx context.Context, params DeleteEnvelopeParams) (res DeleteEnvelopeRes, err error) {
+func (c *Client) sendCreateInvitation(ctx context.Context, request OptCreateInvitation) (res *Invitation, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteEnvelope"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/envelopes/{envelopeId}"),
+		otelogen.OperationID("createInvitation"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/household/invitations"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -544,7 +795,7 @@ func (c *Client) sendDeleteEnvelope(ctx context.Context, params DeleteEnvelopePa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteEnvelopeOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateInvitationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -561,40 +812,25 @@ func (c *Client) sendDeleteEnvelope(ctx context.Context, params DeleteEnvelopePa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/envelopes/"
-	{
-		// Encode "envelopeId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "envelopeId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.EnvelopeId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/household/invitations"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateInvitationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteEnvelopeOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateInvitationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -630,7 +866,7 @@ func (c *Client) sendDeleteEnvelope(ctx context.Context, params DeleteEnvelopePa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteEnvelopeResponse(resp)
+	result, err := decodeCreateInvitationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -638,21 +874,26 @@ func (c *Client) sendDeleteEnvelope(ctx context.Context, params DeleteEnvelopePa
 	return result, nil
 }
 
-// DeletePeriod invokes deletePeriod operation.
+// CreatePeriod invokes createPeriod operation.
 //
-// Delete a period.
+// Periods run from their start date up to, but not including, their end date, so the next
+// period may start on the day this one ends. Omitted dates follow the period schedule of the
+// household: without dates, the scheduled period around today is created. A period overlapping
+// another one is rejected
+// with 409. When the server rejects period gaps, a period leaving days uncovered between it
+// and its neighbours is rejected with 400.
 //
-// DELETE /periods/{periodId}
-func (c *Client) DeletePeriod(ctx context.Context, params DeletePeriodParams) (DeletePeriodRes, error) {
-	res, err := c.sendDeletePeriod(ctx, params)
+// POST /periods
+func (c *Client) CreatePeriod(ctx context.Context, request *CreatePeriod, params CreatePeriodParams) (*PeriodSummary, error) {
+	res, err := c.sendCreatePeriod(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDeletePeriod(ctx context.Context, params DeletePeriodParams) (res DeletePeriodRes, err error) {
+func (c *Client) sendCreatePeriod(ctx context.Context, request *CreatePeriod, params CreatePeriodParams) (res *PeriodSummary, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deletePeriod"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/periods/{periodId}"),
+		otelogen.OperationID("createPeriod"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/periods"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -668,7 +909,7 @@ func (c *Client) sendDeletePeriod(ctx context.Context, params DeletePeriodParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeletePeriodOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreatePeriodOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -685,40 +926,42 @@ func (c *Client) sendDeletePeriod(ctx context.Context, params DeletePeriodParams
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/periods/"
-	{
-		// Encode "periodId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "periodId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PeriodId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/periods"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreatePeriodRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Idempotency-Key",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IdempotencyKey.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeletePeriodOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreatePeriodOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -754,7 +997,7 @@ func (c *Client) sendDeletePeriod(ctx context.Context, params DeletePeriodParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeletePeriodResponse(resp)
+	result, err := decodeCreatePeriodResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -762,21 +1005,21 @@ func (c *Client) sendDeletePeriod(ctx context.Context, params DeletePeriodParams
 	return result, nil
 }
 
-// DeleteTransaction invokes deleteTransaction operation.
+// CreateRecurringTransaction invokes createRecurringTransaction operation.
 //
-// Delete a transaction.
+// Create a recurring transaction template.
 //
-// DELETE /transactions/{transactionId}
-func (c *Client) DeleteTransaction(ctx context.Context, params DeleteTransactionParams) (DeleteTransactionRes, error) {
-	res, err := c.sendDeleteTransaction(ctx, params)
+// POST /recurring-transactions
+func (c *Client) CreateRecurringTransaction(ctx context.Context, request *CreateRecurringTransaction) (*RecurringTransaction, error) {
+	res, err := c.sendCreateRecurringTransaction(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteTransaction(ctx context.Context, params DeleteTransactionParams) (res DeleteTransactionRes, err error) {
+func (c *Client) sendCreateRecurringTransaction(ctx context.Context, request *CreateRecurringTransaction) (res *RecurringTransaction, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteTransaction"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/transactions/{transactionId}"),
+		otelogen.OperationID("createRecurringTransaction"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/recurring-transactions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -792,7 +1035,7 @@ func (c *Client) sendDeleteTransaction(ctx context.Context, params DeleteTransac
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteTransactionOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateRecurringTransactionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -809,40 +1052,25 @@ func (c *Client) sendDeleteTransaction(ctx context.Context, params DeleteTransac
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/transactions/"
-	{
-		// Encode "transactionId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "transactionId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.TransactionId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/recurring-transactions"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateRecurringTransactionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteTransactionOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateRecurringTransactionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -878,7 +1106,7 @@ func (c *Client) sendDeleteTransaction(ctx context.Context, params DeleteTransac
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteTransactionResponse(resp)
+	result, err := decodeCreateRecurringTransactionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -886,21 +1114,21 @@ func (c *Client) sendDeleteTransaction(ctx context.Context, params DeleteTransac
 	return result, nil
 }
 
-// GetCurrentPeriod invokes getCurrentPeriod operation.
+// CreateRule invokes createRule operation.
 //
-// Get current active period.
+// Create a categorisation rule.
 //
-// GET /periods/current
-func (c *Client) GetCurrentPeriod(ctx context.Context) (*PeriodSummary, error) {
-	res, err := c.sendGetCurrentPeriod(ctx)
+// POST /rules
+func (c *Client) CreateRule(ctx context.Context, request *CreateRule) (*Rule, error) {
+	res, err := c.sendCreateRule(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetCurrentPeriod(ctx context.Context) (res *PeriodSummary, err error) {
+func (c *Client) sendCreateRule(ctx context.Context, request *CreateRule) (res *Rule, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentPeriod"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/periods/current"),
+		otelogen.OperationID("createRule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/rules"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -916,7 +1144,7 @@ func (c *Client) sendGetCurrentPeriod(ctx context.Context) (res *PeriodSummary,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentPeriodOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -934,21 +1162,24 @@ func (c *Client) sendGetCurrentPeriod(ctx context.Context) (res *PeriodSummary,
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/periods/current"
+	pathParts[0] = "/rules"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateRuleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetCurrentPeriodOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -984,7 +1215,7 @@ func (c *Client) sendGetCurrentPeriod(ctx context.Context) (res *PeriodSummary,
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCurrentPeriodResponse(resp)
+	result, err := decodeCreateRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -992,21 +1223,21 @@ func (c *Client) sendGetCurrentPeriod(ctx context.Context) (res *PeriodSummary,
 	return result, nil
 }
 
-// GetCurrentUser invokes getCurrentUser operation.
+// CreateTransaction invokes createTransaction operation.
 //
-// Get current authenticated user.
+// Create a new transaction.
 //
-// GET /me
-func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
-	res, err := c.sendGetCurrentUser(ctx)
+// POST /transactions
+func (c *Client) CreateTransaction(ctx context.Context, request *CreateTransaction, params CreateTransactionParams) (CreateTransactionRes, error) {
+	res, err := c.sendCreateTransaction(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error) {
+func (c *Client) sendCreateTransaction(ctx context.Context, request *CreateTransaction, params CreateTransactionParams) (res CreateTransactionRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentUser"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/me"),
+		otelogen.OperationID("createTransaction"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/transactions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1022,7 +1253,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateTransactionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1040,21 +1271,41 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/me"
+	pathParts[0] = "/transactions"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateTransactionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Idempotency-Key",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IdempotencyKey.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetCurrentUserOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateTransactionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1090,7 +1341,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCurrentUserResponse(resp)
+	result, err := decodeCreateTransactionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1098,20 +1349,131 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error)
 	return result, nil
 }
 
-// GetEnvelope invokes getEnvelope operation.
+// CreateTransfer invokes createTransfer operation.
 //
-// Get envelope by ID.
+// Transfer funds between envelopes.
 //
-// GET /envelopes/{envelopeId}
-func (c *Client) GetEnvelope(ctx context.Context, params GetEnvelopeParams) (GetEnvelopeRes, error) {
-	res, err := c.sendGetEnvelope(ctx, params)
+// POST /transfers
+func (c *Client) CreateTransfer(ctx context.Context, request *CreateTransfer) (*Transfer, error) {
+	res, err := c.sendCreateTransfer(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetEnvelope(ctx context.Context, params GetEnvelopeParams) (res GetEnvelopeRes, err error) {
+func (c *Client) sendCreateTransfer(ctx context.Context, request *CreateTransfer) (res *Transfer, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getEnvelope"),
-		semconv.HTTPRequestMethodKey.String("GET"),
+		otelogen.OperationID("createTransfer"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/transfers"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateTransferOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/transfers"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreateTransferRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CreateTransferOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateTransferResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteEnvelope invokes deleteEnvelope operation.
+//
+// Moves the envelope to the trash, from where it can be restored until the retention period ends.
+// Fails with 409 while transactions outside the trash, recurring transactions or rules use the
+// envelope.
+//
+// DELETE /envelopes/{envelopeId}
+func (c *Client) DeleteEnvelope(ctx context.Context, params DeleteEnvelopeParams) (DeleteEnvelopeRes, error) {
+	res, err := c.sendDeleteEnvelope(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDeleteEnvelope(ctx context.Context, params DeleteEnvelopeParams) (res DeleteEnvelopeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteEnvelope"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
 		semconv.URLTemplateKey.String("/envelopes/{envelopeId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
@@ -1128,7 +1490,7 @@ func (c *Client) sendGetEnvelope(ctx context.Context, params GetEnvelopeParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetEnvelopeOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteEnvelopeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1168,17 +1530,34 @@ func (c *Client) sendGetEnvelope(ctx context.Context, params GetEnvelopeParams)
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetEnvelopeOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteEnvelopeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1214,7 +1593,7 @@ func (c *Client) sendGetEnvelope(ctx context.Context, params GetEnvelopeParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetEnvelopeResponse(resp)
+	result, err := decodeDeleteEnvelopeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1222,20 +1601,20 @@ func (c *Client) sendGetEnvelope(ctx context.Context, params GetEnvelopeParams)
 	return result, nil
 }
 
-// GetPeriod invokes getPeriod operation.
+// DeletePeriod invokes deletePeriod operation.
 //
-// Get period by ID.
+// Delete a period.
 //
-// GET /periods/{periodId}
-func (c *Client) GetPeriod(ctx context.Context, params GetPeriodParams) (GetPeriodRes, error) {
-	res, err := c.sendGetPeriod(ctx, params)
+// DELETE /periods/{periodId}
+func (c *Client) DeletePeriod(ctx context.Context, params DeletePeriodParams) (DeletePeriodRes, error) {
+	res, err := c.sendDeletePeriod(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetPeriod(ctx context.Context, params GetPeriodParams) (res GetPeriodRes, err error) {
+func (c *Client) sendDeletePeriod(ctx context.Context, params DeletePeriodParams) (res DeletePeriodRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPeriod"),
-		semconv.HTTPRequestMethodKey.String("GET"),
+		otelogen.OperationID("deletePeriod"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
 		semconv.URLTemplateKey.String("/periods/{periodId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
@@ -1252,7 +1631,7 @@ func (c *Client) sendGetPeriod(ctx context.Context, params GetPeriodParams) (res
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPeriodOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeletePeriodOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1292,17 +1671,34 @@ func (c *Client) sendGetPeriod(ctx context.Context, params GetPeriodParams) (res
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetPeriodOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeletePeriodOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1338,7 +1734,7 @@ func (c *Client) sendGetPeriod(ctx context.Context, params GetPeriodParams) (res
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPeriodResponse(resp)
+	result, err := decodeDeletePeriodResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1346,21 +1742,21 @@ func (c *Client) sendGetPeriod(ctx context.Context, params GetPeriodParams) (res
 	return result, nil
 }
 
-// GetTransaction invokes getTransaction operation.
+// DeleteRecurringTransaction invokes deleteRecurringTransaction operation.
 //
-// Get transaction by ID.
+// Transactions already generated from the template are kept.
 //
-// GET /transactions/{transactionId}
-func (c *Client) GetTransaction(ctx context.Context, params GetTransactionParams) (GetTransactionRes, error) {
-	res, err := c.sendGetTransaction(ctx, params)
+// DELETE /recurring-transactions/{recurringTransactionId}
+func (c *Client) DeleteRecurringTransaction(ctx context.Context, params DeleteRecurringTransactionParams) (DeleteRecurringTransactionRes, error) {
+	res, err := c.sendDeleteRecurringTransaction(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetTransaction(ctx context.Context, params GetTransactionParams) (res GetTransactionRes, err error) {
+func (c *Client) sendDeleteRecurringTransaction(ctx context.Context, params DeleteRecurringTransactionParams) (res DeleteRecurringTransactionRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTransaction"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/transactions/{transactionId}"),
+		otelogen.OperationID("deleteRecurringTransaction"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/recurring-transactions/{recurringTransactionId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1376,7 +1772,7 @@ func (c *Client) sendGetTransaction(ctx context.Context, params GetTransactionPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetTransactionOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteRecurringTransactionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1394,16 +1790,16 @@ func (c *Client) sendGetTransaction(ctx context.Context, params GetTransactionPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/transactions/"
+	pathParts[0] = "/recurring-transactions/"
 	{
-		// Encode "transactionId" parameter.
+		// Encode "recurringTransactionId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "transactionId",
+			Param:   "recurringTransactionId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.TransactionId))
+			return e.EncodeValue(conv.UUIDToString(params.RecurringTransactionId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1411,9 +1807,3851 @@ func (c *Client) sendGetTransaction(ctx context.Context, params GetTransactionPa
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[1] = encoded
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteRecurringTransactionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteRecurringTransactionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteRule invokes deleteRule operation.
+//
+// Delete a categorisation rule.
+//
+// DELETE /rules/{ruleId}
+func (c *Client) DeleteRule(ctx context.Context, params DeleteRuleParams) (DeleteRuleRes, error) {
+	res, err := c.sendDeleteRule(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDeleteRule(ctx context.Context, params DeleteRuleParams) (res DeleteRuleRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteRule"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/rules/{ruleId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteRuleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/rules/"
+	{
+		// Encode "ruleId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "ruleId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.RuleId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteRuleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteRuleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteTransaction invokes deleteTransaction operation.
+//
+// Moves the transaction to the trash, from where it can be restored until the retention period ends.
+// Deleting either leg of a transfer deletes the whole transfer.
+//
+// DELETE /transactions/{transactionId}
+func (c *Client) DeleteTransaction(ctx context.Context, params DeleteTransactionParams) (DeleteTransactionRes, error) {
+	res, err := c.sendDeleteTransaction(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDeleteTransaction(ctx context.Context, params DeleteTransactionParams) (res DeleteTransactionRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteTransaction"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/transactions/{transactionId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteTransactionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/transactions/"
+	{
+		// Encode "transactionId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "transactionId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TransactionId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteTransactionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteTransactionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetCurrentPeriod invokes getCurrentPeriod operation.
+//
+// Get current active period.
+//
+// GET /periods/current
+func (c *Client) GetCurrentPeriod(ctx context.Context) (*PeriodSummary, error) {
+	res, err := c.sendGetCurrentPeriod(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetCurrentPeriod(ctx context.Context) (res *PeriodSummary, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getCurrentPeriod"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/periods/current"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentPeriodOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/periods/current"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetCurrentPeriodOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetCurrentPeriodResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetCurrentUser invokes getCurrentUser operation.
+//
+// Get current authenticated user.
+//
+// GET /me
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	res, err := c.sendGetCurrentUser(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getCurrentUser"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/me"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/me"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetCurrentUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetCurrentUserResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetEnvelope invokes getEnvelope operation.
+//
+// Get envelope by ID.
+//
+// GET /envelopes/{envelopeId}
+func (c *Client) GetEnvelope(ctx context.Context, params GetEnvelopeParams) (GetEnvelopeRes, error) {
+	res, err := c.sendGetEnvelope(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetEnvelope(ctx context.Context, params GetEnvelopeParams) (res GetEnvelopeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getEnvelope"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/envelopes/{envelopeId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetEnvelopeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/envelopes/"
+	{
+		// Encode "envelopeId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "envelopeId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.EnvelopeId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetEnvelopeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetEnvelopeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetHousehold invokes getHousehold operation.
+//
+// Get the household of the current user.
+//
+// GET /household
+func (c *Client) GetHousehold(ctx context.Context) (*Household, error) {
+	res, err := c.sendGetHousehold(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetHousehold(ctx context.Context) (res *Household, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getHousehold"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/household"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetHouseholdOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/household"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetHouseholdOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetHouseholdResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetMemberSpending invokes getMemberSpending operation.
+//
+// Totals of the transactions each member recorded in the period. Transfers are not counted.
+//
+// GET /periods/{periodId}/member-spending
+func (c *Client) GetMemberSpending(ctx context.Context, params GetMemberSpendingParams) (GetMemberSpendingRes, error) {
+	res, err := c.sendGetMemberSpending(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetMemberSpending(ctx context.Context, params GetMemberSpendingParams) (res GetMemberSpendingRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMemberSpending"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/periods/{periodId}/member-spending"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMemberSpendingOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/periods/"
+	{
+		// Encode "periodId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "periodId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PeriodId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/member-spending"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetMemberSpendingOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetMemberSpendingResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPeriod invokes getPeriod operation.
+//
+// Get period by ID.
+//
+// GET /periods/{periodId}
+func (c *Client) GetPeriod(ctx context.Context, params GetPeriodParams) (GetPeriodRes, error) {
+	res, err := c.sendGetPeriod(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetPeriod(ctx context.Context, params GetPeriodParams) (res GetPeriodRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPeriod"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/periods/{periodId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPeriodOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/periods/"
+	{
+		// Encode "periodId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "periodId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PeriodId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetPeriodOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPeriodResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPeriodSchedule invokes getPeriodSchedule operation.
+//
+// Households that have not configured a schedule get the default one, starting periods on the 5th of
+// the month or the business day before it.
+//
+// GET /household/period-schedule
+func (c *Client) GetPeriodSchedule(ctx context.Context) (*PeriodScheduleHeaders, error) {
+	res, err := c.sendGetPeriodSchedule(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetPeriodSchedule(ctx context.Context) (res *PeriodScheduleHeaders, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPeriodSchedule"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/household/period-schedule"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPeriodScheduleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/household/period-schedule"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetPeriodScheduleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPeriodScheduleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetRecurringTransaction invokes getRecurringTransaction operation.
+//
+// Get recurring transaction template by ID.
+//
+// GET /recurring-transactions/{recurringTransactionId}
+func (c *Client) GetRecurringTransaction(ctx context.Context, params GetRecurringTransactionParams) (GetRecurringTransactionRes, error) {
+	res, err := c.sendGetRecurringTransaction(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetRecurringTransaction(ctx context.Context, params GetRecurringTransactionParams) (res GetRecurringTransactionRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getRecurringTransaction"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/recurring-transactions/{recurringTransactionId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRecurringTransactionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/recurring-transactions/"
+	{
+		// Encode "recurringTransactionId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "recurringTransactionId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.RecurringTransactionId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetRecurringTransactionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetRecurringTransactionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetRule invokes getRule operation.
+//
+// Get categorisation rule by ID.
+//
+// GET /rules/{ruleId}
+func (c *Client) GetRule(ctx context.Context, params GetRuleParams) (GetRuleRes, error) {
+	res, err := c.sendGetRule(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetRule(ctx context.Context, params GetRuleParams) (res GetRuleRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getRule"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/rules/{ruleId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRuleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/rules/"
+	{
+		// Encode "ruleId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "ruleId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.RuleId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetRuleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetRuleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTransaction invokes getTransaction operation.
+//
+// Get transaction by ID.
+//
+// GET /transactions/{transactionId}
+func (c *Client) GetTransaction(ctx context.Context, params GetTransactionParams) (GetTransactionRes, error) {
+	res, err := c.sendGetTransaction(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTransaction(ctx context.Context, params GetTransactionParams) (res GetTransactionRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTransaction"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/transactions/{transactionId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetTransactionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/transactions/"
+	{
+		// Encode "transactionId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "transactionId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TransactionId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetTransactionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetTransactionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ImportHolidays invokes importHolidays operation.
+//
+// Replaces the imported holidays of the household. Every day an all-day event covers is a holiday,
+// events with a time are holidays on their start date. Events may recur yearly (`RRULE:FREQ=YEARLY`),
+// other recurrence rules are rejected.
+//
+// PUT /household/holidays
+func (c *Client) ImportHolidays(ctx context.Context, request *ImportHolidays) ([]Holiday, error) {
+	res, err := c.sendImportHolidays(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendImportHolidays(ctx context.Context, request *ImportHolidays) (res []Holiday, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("importHolidays"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/household/holidays"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ImportHolidaysOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/household/holidays"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeImportHolidaysRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ImportHolidaysOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeImportHolidaysResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ImportTransactions invokes importTransactions operation.
+//
+// Parses a bank statement, assigns each row to the period containing its date and
+// detects rows that were already recorded. With `commit: false` (the default) nothing is saved,
+// so the result can be reviewed first; re-send the same request with `commit: true` to record the
+// new rows.
+//
+// POST /imports
+func (c *Client) ImportTransactions(ctx context.Context, request *ImportRequest) (*ImportResult, error) {
+	res, err := c.sendImportTransactions(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendImportTransactions(ctx context.Context, request *ImportRequest) (res *ImportResult, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("importTransactions"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/imports"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ImportTransactionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/imports"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeImportTransactionsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ImportTransactionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeImportTransactionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// JoinHousehold invokes joinHousehold operation.
+//
+// The current user leaves their household. Its data stays with the remaining members. Households
+// cannot be joined with an access token.
+//
+// POST /household/join
+func (c *Client) JoinHousehold(ctx context.Context, request *JoinHousehold) (*Household, error) {
+	res, err := c.sendJoinHousehold(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendJoinHousehold(ctx context.Context, request *JoinHousehold) (res *Household, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("joinHousehold"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/household/join"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, JoinHouseholdOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/household/join"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeJoinHouseholdRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, JoinHouseholdOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeJoinHouseholdResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListAccessTokens invokes listAccessTokens operation.
+//
+// Revoked tokens are not listed.
+//
+// GET /me/tokens
+func (c *Client) ListAccessTokens(ctx context.Context) ([]AccessToken, error) {
+	res, err := c.sendListAccessTokens(ctx)
+	return res, err
+}
+
+func (c *Client) sendListAccessTokens(ctx context.Context) (res []AccessToken, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAccessTokens"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/me/tokens"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListAccessTokensOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/me/tokens"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListAccessTokensOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListAccessTokensResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListAuditEntries invokes listAuditEntries operation.
+//
+// Every change to household data is recorded with who made it, when, and the entity
+// before and after the change. Entries are listed newest first.
+//
+// GET /audit
+func (c *Client) ListAuditEntries(ctx context.Context, params ListAuditEntriesParams) ([]AuditEntry, error) {
+	res, err := c.sendListAuditEntries(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListAuditEntries(ctx context.Context, params ListAuditEntriesParams) (res []AuditEntry, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAuditEntries"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/audit"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListAuditEntriesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/audit"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "entity" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "entity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Entity.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "entityId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "entityId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.EntityId.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "actorId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "actorId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ActorId.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListAuditEntriesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListAuditEntriesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListEnvelopes invokes listEnvelopes operation.
+//
+// List all envelopes.
+//
+// GET /envelopes
+func (c *Client) ListEnvelopes(ctx context.Context) ([]Envelope, error) {
+	res, err := c.sendListEnvelopes(ctx)
+	return res, err
+}
+
+func (c *Client) sendListEnvelopes(ctx context.Context) (res []Envelope, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listEnvelopes"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/envelopes"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListEnvelopesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/envelopes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListEnvelopesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListEnvelopesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListHolidays invokes listHolidays operation.
+//
+// Holidays of the built-in calendar chosen in the period schedule and imported ones, which period
+// starts are moved off.
+//
+// GET /household/holidays
+func (c *Client) ListHolidays(ctx context.Context, params ListHolidaysParams) ([]Holiday, error) {
+	res, err := c.sendListHolidays(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListHolidays(ctx context.Context, params ListHolidaysParams) (res []Holiday, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listHolidays"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/household/holidays"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListHolidaysOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/household/holidays"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "year" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "year",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Year.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListHolidaysOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListHolidaysResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListPeriodGaps invokes listPeriodGaps operation.
+//
+// Date ranges between consecutive periods that no period covers, earliest first.
+//
+// GET /periods/gaps
+func (c *Client) ListPeriodGaps(ctx context.Context) ([]PeriodGap, error) {
+	res, err := c.sendListPeriodGaps(ctx)
+	return res, err
+}
+
+func (c *Client) sendListPeriodGaps(ctx context.Context) (res []PeriodGap, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listPeriodGaps"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/periods/gaps"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListPeriodGapsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/periods/gaps"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListPeriodGapsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListPeriodGapsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListPeriods invokes listPeriods operation.
+//
+// List all financial periods.
+//
+// GET /periods
+func (c *Client) ListPeriods(ctx context.Context) ([]PeriodListItem, error) {
+	res, err := c.sendListPeriods(ctx)
+	return res, err
+}
+
+func (c *Client) sendListPeriods(ctx context.Context) (res []PeriodListItem, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listPeriods"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/periods"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListPeriodsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/periods"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListPeriodsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListPeriodsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRecurringTransactions invokes listRecurringTransactions operation.
+//
+// List recurring transaction templates.
+//
+// GET /recurring-transactions
+func (c *Client) ListRecurringTransactions(ctx context.Context) ([]RecurringTransaction, error) {
+	res, err := c.sendListRecurringTransactions(ctx)
+	return res, err
+}
+
+func (c *Client) sendListRecurringTransactions(ctx context.Context) (res []RecurringTransaction, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRecurringTransactions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/recurring-transactions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListRecurringTransactionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/recurring-transactions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListRecurringTransactionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListRecurringTransactionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRules invokes listRules operation.
+//
+// List categorisation rules.
+//
+// GET /rules
+func (c *Client) ListRules(ctx context.Context) ([]Rule, error) {
+	res, err := c.sendListRules(ctx)
+	return res, err
+}
+
+func (c *Client) sendListRules(ctx context.Context) (res []Rule, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRules"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/rules"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListRulesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/rules"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListRulesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListRulesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTransactions invokes listTransactions operation.
+//
+// List transactions.
+//
+// GET /transactions
+func (c *Client) ListTransactions(ctx context.Context, params ListTransactionsParams) (*ListTransactionsOKHeaders, error) {
+	res, err := c.sendListTransactions(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListTransactions(ctx context.Context, params ListTransactionsParams) (res *ListTransactionsOKHeaders, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTransactions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/transactions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTransactionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/transactions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "periodId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "periodId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PeriodId.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "envelopeId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "envelopeId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.EnvelopeId.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dateFrom" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dateFrom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DateFrom.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dateTo" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dateTo",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DateTo.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "minAmount" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "minAmount",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MinAmount.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "maxAmount" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "maxAmount",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MaxAmount.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "category" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "category",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Category.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "description" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "description",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Description.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sign" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sign",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sign.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "createdBy" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "createdBy",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CreatedBy.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListTransactionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListTransactionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTrash invokes listTrash operation.
+//
+// Deleted items stay in the trash until they are restored or the retention period ends,
+// after which they are deleted permanently. Items are listed most recently deleted first.
+//
+// GET /trash
+func (c *Client) ListTrash(ctx context.Context) (*Trash, error) {
+	res, err := c.sendListTrash(ctx)
+	return res, err
+}
+
+func (c *Client) sendListTrash(ctx context.Context) (res *Trash, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTrash"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/trash"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTrashOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/trash"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListTrashOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListTrashResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListUsers invokes listUsers operation.
+//
+// List all household users.
+//
+// GET /users
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	res, err := c.sendListUsers(ctx)
+	return res, err
+}
+
+func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/users"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListUsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListUsersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RestoreEnvelope invokes restoreEnvelope operation.
+//
+// Restore a deleted envelope from the trash.
+//
+// POST /envelopes/{envelopeId}/restore
+func (c *Client) RestoreEnvelope(ctx context.Context, params RestoreEnvelopeParams) (RestoreEnvelopeRes, error) {
+	res, err := c.sendRestoreEnvelope(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRestoreEnvelope(ctx context.Context, params RestoreEnvelopeParams) (res RestoreEnvelopeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("restoreEnvelope"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/envelopes/{envelopeId}/restore"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RestoreEnvelopeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/envelopes/"
+	{
+		// Encode "envelopeId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "envelopeId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.EnvelopeId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/restore"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RestoreEnvelopeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRestoreEnvelopeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RestoreTransaction invokes restoreTransaction operation.
+//
+// Restoring either leg of a transfer restores the whole transfer.
+// Fails with 409 while an envelope of the transaction is in the trash itself.
+//
+// POST /transactions/{transactionId}/restore
+func (c *Client) RestoreTransaction(ctx context.Context, params RestoreTransactionParams) (RestoreTransactionRes, error) {
+	res, err := c.sendRestoreTransaction(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRestoreTransaction(ctx context.Context, params RestoreTransactionParams) (res RestoreTransactionRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("restoreTransaction"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/transactions/{transactionId}/restore"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RestoreTransactionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/transactions/"
+	{
+		// Encode "transactionId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "transactionId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TransactionId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/restore"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RestoreTransactionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRestoreTransactionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RevokeAccessToken invokes revokeAccessToken operation.
+//
+// Revoke a personal access token.
+//
+// DELETE /me/tokens/{tokenId}
+func (c *Client) RevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) (RevokeAccessTokenRes, error) {
+	res, err := c.sendRevokeAccessToken(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) (res RevokeAccessTokenRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("revokeAccessToken"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/me/tokens/{tokenId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RevokeAccessTokenOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/me/tokens/"
+	{
+		// Encode "tokenId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "tokenId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TokenId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RevokeAccessTokenOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRevokeAccessTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SearchTransactions invokes searchTransactions operation.
+//
+// Full-text search over transaction descriptions across all periods. Matching is case, diacritic and
+// script insensitive (Serbian Cyrillic and Latin), and partial words are matched by similarity.
+// Results are ordered by relevance.
+//
+// GET /transactions/search
+func (c *Client) SearchTransactions(ctx context.Context, params SearchTransactionsParams) ([]TransactionSearchResult, error) {
+	res, err := c.sendSearchTransactions(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendSearchTransactions(ctx context.Context, params SearchTransactionsParams) (res []TransactionSearchResult, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("searchTransactions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/transactions/search"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchTransactionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/transactions/search"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Q))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1426,7 +5664,7 @@ func (c *Client) sendGetTransaction(ctx context.Context, params GetTransactionPa
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetTransactionOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SearchTransactionsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1462,7 +5700,7 @@ func (c *Client) sendGetTransaction(ctx context.Context, params GetTransactionPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetTransactionResponse(resp)
+	result, err := decodeSearchTransactionsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1470,21 +5708,21 @@ func (c *Client) sendGetTransaction(ctx context.Context, params GetTransactionPa
 	return result, nil
 }
 
-// ListEnvelopes invokes listEnvelopes operation.
+// UpdateEnvelope invokes updateEnvelope operation.
 //
-// List all envelopes.
+// Update an envelope.
 //
-// GET /envelopes
-func (c *Client) ListEnvelopes(ctx context.Context) ([]Envelope, error) {
-	res, err := c.sendListEnvelopes(ctx)
+// PATCH /envelopes/{envelopeId}
+func (c *Client) UpdateEnvelope(ctx context.Context, request *UpdateEnvelope, params UpdateEnvelopeParams) (UpdateEnvelopeRes, error) {
+	res, err := c.sendUpdateEnvelope(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListEnvelopes(ctx context.Context) (res []Envelope, err error) {
+func (c *Client) sendUpdateEnvelope(ctx context.Context, request *UpdateEnvelope, params UpdateEnvelopeParams) (res UpdateEnvelopeRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listEnvelopes"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/envelopes"),
+		otelogen.OperationID("updateEnvelope"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/envelopes/{envelopeId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1500,7 +5738,7 @@ func (c *Client) sendListEnvelopes(ctx context.Context) (res []Envelope, err err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListEnvelopesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateEnvelopeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1517,22 +5755,60 @@ func (c *Client) sendListEnvelopes(ctx context.Context) (res []Envelope, err err
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/envelopes"
+	var pathParts [2]string
+	pathParts[0] = "/envelopes/"
+	{
+		// Encode "envelopeId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "envelopeId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.EnvelopeId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateEnvelopeRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListEnvelopesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateEnvelopeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1568,7 +5844,7 @@ func (c *Client) sendListEnvelopes(ctx context.Context) (res []Envelope, err err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListEnvelopesResponse(resp)
+	result, err := decodeUpdateEnvelopeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1576,21 +5852,21 @@ func (c *Client) sendListEnvelopes(ctx context.Context) (res []Envelope, err err
 	return result, nil
 }
 
-// ListPeriods invokes listPeriods operation.
+// UpdateMember invokes updateMember operation.
 //
-// List all financial periods.
+// Only owners may manage members. A household always keeps at least one owner.
 //
-// GET /periods
-func (c *Client) ListPeriods(ctx context.Context) ([]PeriodListItem, error) {
-	res, err := c.sendListPeriods(ctx)
+// PUT /household/members/{userId}
+func (c *Client) UpdateMember(ctx context.Context, request *UpdateMember, params UpdateMemberParams) (UpdateMemberRes, error) {
+	res, err := c.sendUpdateMember(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListPeriods(ctx context.Context) (res []PeriodListItem, err error) {
+func (c *Client) sendUpdateMember(ctx context.Context, request *UpdateMember, params UpdateMemberParams) (res UpdateMemberRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listPeriods"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/periods"),
+		otelogen.OperationID("updateMember"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/household/members/{userId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1606,7 +5882,7 @@ func (c *Client) sendListPeriods(ctx context.Context) (res []PeriodListItem, err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListPeriodsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateMemberOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1623,22 +5899,43 @@ func (c *Client) sendListPeriods(ctx context.Context) (res []PeriodListItem, err
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/periods"
+	var pathParts [2]string
+	pathParts[0] = "/household/members/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateMemberRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListPeriodsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateMemberOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1674,7 +5971,7 @@ func (c *Client) sendListPeriods(ctx context.Context) (res []PeriodListItem, err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListPeriodsResponse(resp)
+	result, err := decodeUpdateMemberResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1682,21 +5979,23 @@ func (c *Client) sendListPeriods(ctx context.Context) (res []PeriodListItem, err
 	return result, nil
 }
 
-// ListTransactions invokes listTransactions operation.
+// UpdatePeriod invokes updatePeriod operation.
 //
-// List transactions.
+// Changed dates are subject to the same checks as on creation: the period must not overlap
+// another one (409), nor, when the server rejects period gaps, leave days uncovered (400).
+// They must also keep every transaction of the period, including those in the trash (409).
 //
-// GET /transactions
-func (c *Client) ListTransactions(ctx context.Context, params ListTransactionsParams) ([]Transaction, error) {
-	res, err := c.sendListTransactions(ctx, params)
+// PATCH /periods/{periodId}
+func (c *Client) UpdatePeriod(ctx context.Context, request *UpdatePeriod, params UpdatePeriodParams) (UpdatePeriodRes, error) {
+	res, err := c.sendUpdatePeriod(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListTransactions(ctx context.Context, params ListTransactionsParams) (res []Transaction, err error) {
+func (c *Client) sendUpdatePeriod(ctx context.Context, request *UpdatePeriod, params UpdatePeriodParams) (res UpdatePeriodRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTransactions"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/transactions"),
+		otelogen.OperationID("updatePeriod"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/periods/{periodId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1712,7 +6011,7 @@ func (c *Client) sendListTransactions(ctx context.Context, params ListTransactio
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTransactionsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdatePeriodOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1729,43 +6028,60 @@ func (c *Client) sendListTransactions(ctx context.Context, params ListTransactio
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/transactions"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [2]string
+	pathParts[0] = "/periods/"
 	{
 		// Encode "periodId" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "periodId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "periodId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PeriodId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.PeriodId.Get(); ok {
-				return e.EncodeValue(conv.UUIDToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdatePeriodRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTransactionsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdatePeriodOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1801,7 +6117,7 @@ func (c *Client) sendListTransactions(ctx context.Context, params ListTransactio
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTransactionsResponse(resp)
+	result, err := decodeUpdatePeriodResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1809,21 +6125,21 @@ func (c *Client) sendListTransactions(ctx context.Context, params ListTransactio
 	return result, nil
 }
 
-// ListUsers invokes listUsers operation.
+// UpdatePeriodSchedule invokes updatePeriodSchedule operation.
 //
-// List all household users.
+// Existing periods keep their dates, the schedule applies to periods created afterwards.
 //
-// GET /users
-func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
-	res, err := c.sendListUsers(ctx)
+// PUT /household/period-schedule
+func (c *Client) UpdatePeriodSchedule(ctx context.Context, request *PeriodSchedule, params UpdatePeriodScheduleParams) (*PeriodScheduleHeaders, error) {
+	res, err := c.sendUpdatePeriodSchedule(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
+func (c *Client) sendUpdatePeriodSchedule(ctx context.Context, request *PeriodSchedule, params UpdatePeriodScheduleParams) (res *PeriodScheduleHeaders, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listUsers"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/users"),
+		otelogen.OperationID("updatePeriodSchedule"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/household/period-schedule"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1839,7 +6155,7 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListUsersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdatePeriodScheduleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1857,21 +6173,41 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/users"
+	pathParts[0] = "/household/period-schedule"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdatePeriodScheduleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListUsersOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdatePeriodScheduleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1907,7 +6243,7 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListUsersResponse(resp)
+	result, err := decodeUpdatePeriodScheduleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1915,21 +6251,21 @@ func (c *Client) sendListUsers(ctx context.Context) (res []User, err error) {
 	return result, nil
 }
 
-// UpdateEnvelope invokes updateEnvelope operation.
+// UpdateRecurringTransaction invokes updateRecurringTransaction operation.
 //
-// Update an envelope.
+// Update a recurring transaction template.
 //
-// PATCH /envelopes/{envelopeId}
-func (c *Client) UpdateEnvelope(ctx context.Context, request *UpdateEnvelope, params UpdateEnvelopeParams) (UpdateEnvelopeRes, error) {
-	res, err := c.sendUpdateEnvelope(ctx, request, params)
+// PATCH /recurring-transactions/{recurringTransactionId}
+func (c *Client) UpdateRecurringTransaction(ctx context.Context, request *UpdateRecurringTransaction, params UpdateRecurringTransactionParams) (UpdateRecurringTransactionRes, error) {
+	res, err := c.sendUpdateRecurringTransaction(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateEnvelope(ctx context.Context, request *UpdateEnvelope, params UpdateEnvelopeParams) (res UpdateEnvelopeRes, err error) {
+func (c *Client) sendUpdateRecurringTransaction(ctx context.Context, request *UpdateRecurringTransaction, params UpdateRecurringTransactionParams) (res UpdateRecurringTransactionRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateEnvelope"),
+		otelogen.OperationID("updateRecurringTransaction"),
 		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.URLTemplateKey.String("/envelopes/{envelopeId}"),
+		semconv.URLTemplateKey.String("/recurring-transactions/{recurringTransactionId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1945,7 +6281,7 @@ func (c *Client) sendUpdateEnvelope(ctx context.Context, request *UpdateEnvelope
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateEnvelopeOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateRecurringTransactionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1963,16 +6299,16 @@ func (c *Client) sendUpdateEnvelope(ctx context.Context, request *UpdateEnvelope
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/envelopes/"
+	pathParts[0] = "/recurring-transactions/"
 	{
-		// Encode "envelopeId" parameter.
+		// Encode "recurringTransactionId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "envelopeId",
+			Param:   "recurringTransactionId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.EnvelopeId))
+			return e.EncodeValue(conv.UUIDToString(params.RecurringTransactionId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1989,16 +6325,33 @@ func (c *Client) sendUpdateEnvelope(ctx context.Context, request *UpdateEnvelope
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateEnvelopeRequest(request, r); err != nil {
+	if err := encodeUpdateRecurringTransactionRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateEnvelopeOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateRecurringTransactionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2034,7 +6387,7 @@ func (c *Client) sendUpdateEnvelope(ctx context.Context, request *UpdateEnvelope
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateEnvelopeResponse(resp)
+	result, err := decodeUpdateRecurringTransactionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2042,21 +6395,21 @@ func (c *Client) sendUpdateEnvelope(ctx context.Context, request *UpdateEnvelope
 	return result, nil
 }
 
-// UpdatePeriod invokes updatePeriod operation.
+// UpdateRule invokes updateRule operation.
 //
-// Update a period.
+// Update a categorisation rule.
 //
-// PATCH /periods/{periodId}
-func (c *Client) UpdatePeriod(ctx context.Context, request *UpdatePeriod, params UpdatePeriodParams) (UpdatePeriodRes, error) {
-	res, err := c.sendUpdatePeriod(ctx, request, params)
+// PATCH /rules/{ruleId}
+func (c *Client) UpdateRule(ctx context.Context, request *UpdateRule, params UpdateRuleParams) (UpdateRuleRes, error) {
+	res, err := c.sendUpdateRule(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdatePeriod(ctx context.Context, request *UpdatePeriod, params UpdatePeriodParams) (res UpdatePeriodRes, err error) {
+func (c *Client) sendUpdateRule(ctx context.Context, request *UpdateRule, params UpdateRuleParams) (res UpdateRuleRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updatePeriod"),
+		otelogen.OperationID("updateRule"),
 		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.URLTemplateKey.String("/periods/{periodId}"),
+		semconv.URLTemplateKey.String("/rules/{ruleId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2072,7 +6425,7 @@ func (c *Client) sendUpdatePeriod(ctx context.Context, request *UpdatePeriod, pa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdatePeriodOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2090,16 +6443,16 @@ func (c *Client) sendUpdatePeriod(ctx context.Context, request *UpdatePeriod, pa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/periods/"
+	pathParts[0] = "/rules/"
 	{
-		// Encode "periodId" parameter.
+		// Encode "ruleId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "periodId",
+			Param:   "ruleId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PeriodId))
+			return e.EncodeValue(conv.UUIDToString(params.RuleId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2116,16 +6469,33 @@ func (c *Client) sendUpdatePeriod(ctx context.Context, request *UpdatePeriod, pa
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdatePeriodRequest(request, r); err != nil {
+	if err := encodeUpdateRuleRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdatePeriodOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2161,7 +6531,7 @@ func (c *Client) sendUpdatePeriod(ctx context.Context, request *UpdatePeriod, pa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdatePeriodResponse(resp)
+	result, err := decodeUpdateRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2247,6 +6617,23 @@ func (c *Client) sendUpdateTransaction(ctx context.Context, request *UpdateTrans
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
@@ -0,0 +1,107 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package oas
+
+// setDefaults set default value of fields.
+func (s *ApplyRules) setDefaults() {
+	{
+		val := bool(false)
+		s.Commit.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CreateEnvelope) setDefaults() {
+	{
+		val := RolloverPolicy("reset")
+		s.RolloverPolicy.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CreateRule) setDefaults() {
+	{
+		val := int(0)
+		s.Priority.SetTo(val)
+	}
+	{
+		val := PatternType("substring")
+		s.PatternType.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CsvMapping) setDefaults() {
+	{
+		val := string(",")
+		s.Delimiter.SetTo(val)
+	}
+	{
+		val := int(0)
+		s.SkipRows.SetTo(val)
+	}
+	{
+		val := string("YYYY-MM-DD")
+		s.DateFormat.SetTo(val)
+	}
+	{
+		val := CsvMappingDecimalSeparator(".")
+		s.DecimalSeparator.SetTo(val)
+	}
+	{
+		val := CsvMappingSignConvention("signed")
+		s.SignConvention.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *Envelope) setDefaults() {
+	{
+		val := RolloverPolicy("reset")
+		s.RolloverPolicy = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *ImportRequest) setDefaults() {
+	{
+		val := ImportRequestFormat("csv")
+		s.Format.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.Commit.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *PeriodSchedule) setDefaults() {
+	{
+		val := PeriodScheduleAdjustment("none")
+		s.Adjustment.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *Rule) setDefaults() {
+	{
+		val := PatternType("substring")
+		s.PatternType = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *UpdateEnvelope) setDefaults() {
+	{
+		val := RolloverPolicy("reset")
+		s.RolloverPolicy.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *UpdateRule) setDefaults() {
+	{
+		val := PatternType("substring")
+		s.PatternType.SetTo(val)
+	}
+}
@@ -1,6 +1,10 @@
 // Code generated by ogen, DO NOT EDIT.
 package oas
 
+type ApplyRulesRes interface {
+	applyRulesRes()
+}
+
 type CreateTransactionRes interface {
 	createTransactionRes()
 }
@@ -13,6 +17,14 @@ type DeletePeriodRes interface {
 	deletePeriodRes()
 }
 
+type DeleteRecurringTransactionRes interface {
+	deleteRecurringTransactionRes()
+}
+
+type DeleteRuleRes interface {
+	deleteRuleRes()
+}
+
 type DeleteTransactionRes interface {
 	deleteTransactionRes()
 }
@@ -21,22 +33,58 @@ type GetEnvelopeRes interface {
 	getEnvelopeRes()
 }
 
+type GetMemberSpendingRes interface {
+	getMemberSpendingRes()
+}
+
 type GetPeriodRes interface {
 	getPeriodRes()
 }
 
+type GetRecurringTransactionRes interface {
+	getRecurringTransactionRes()
+}
+
+type GetRuleRes interface {
+	getRuleRes()
+}
+
 type GetTransactionRes interface {
 	getTransactionRes()
 }
 
+type RestoreEnvelopeRes interface {
+	restoreEnvelopeRes()
+}
+
+type RestoreTransactionRes interface {
+	restoreTransactionRes()
+}
+
+type RevokeAccessTokenRes interface {
+	revokeAccessTokenRes()
+}
+
 type UpdateEnvelopeRes interface {
 	updateEnvelopeRes()
 }
 
+type UpdateMemberRes interface {
+	updateMemberRes()
+}
+
 type UpdatePeriodRes interface {
 	updatePeriodRes()
 }
 
+type UpdateRecurringTransactionRes interface {
+	updateRecurringTransactionRes()
+}
+
+type UpdateRuleRes interface {
+	updateRuleRes()
+}
+
 type UpdateTransactionRes interface {
 	updateTransactionRes()
 }
@@ -6,22 +6,53 @@ package oas
 type OperationName = string
 
 const (
-	CreateEnvelopeOperation    OperationName = "CreateEnvelope"
-	CreatePeriodOperation      OperationName = "CreatePeriod"
-	CreateTransactionOperation OperationName = "CreateTransaction"
-	DeleteEnvelopeOperation    OperationName = "DeleteEnvelope"
-	DeletePeriodOperation      OperationName = "DeletePeriod"
-	DeleteTransactionOperation OperationName = "DeleteTransaction"
-	GetCurrentPeriodOperation  OperationName = "GetCurrentPeriod"
-	GetCurrentUserOperation    OperationName = "GetCurrentUser"
-	GetEnvelopeOperation       OperationName = "GetEnvelope"
-	GetPeriodOperation         OperationName = "GetPeriod"
-	GetTransactionOperation    OperationName = "GetTransaction"
-	ListEnvelopesOperation     OperationName = "ListEnvelopes"
-	ListPeriodsOperation       OperationName = "ListPeriods"
-	ListTransactionsOperation  OperationName = "ListTransactions"
-	ListUsersOperation         OperationName = "ListUsers"
-	UpdateEnvelopeOperation    OperationName = "UpdateEnvelope"
-	UpdatePeriodOperation      OperationName = "UpdatePeriod"
-	UpdateTransactionOperation OperationName = "UpdateTransaction"
+	ApplyRulesOperation                 OperationName = "ApplyRules"
+	CreateAccessTokenOperation          OperationName = "CreateAccessToken"
+	CreateEnvelopeOperation             OperationName = "CreateEnvelope"
+	CreateInvitationOperation           OperationName = "CreateInvitation"
+	CreatePeriodOperation               OperationName = "CreatePeriod"
+	CreateRecurringTransactionOperation OperationName = "CreateRecurringTransaction"
+	CreateRuleOperation                 OperationName = "CreateRule"
+	CreateTransactionOperation          OperationName = "CreateTransaction"
+	CreateTransferOperation             OperationName = "CreateTransfer"
+	DeleteEnvelopeOperation             OperationName = "DeleteEnvelope"
+	DeletePeriodOperation               OperationName = "DeletePeriod"
+	DeleteRecurringTransactionOperation OperationName = "DeleteRecurringTransaction"
+	DeleteRuleOperation                 OperationName = "DeleteRule"
+	DeleteTransactionOperation          OperationName = "DeleteTransaction"
+	GetCurrentPeriodOperation           OperationName = "GetCurrentPeriod"
+	GetCurrentUserOperation             OperationName = "GetCurrentUser"
+	GetEnvelopeOperation                OperationName = "GetEnvelope"
+	GetHouseholdOperation               OperationName = "GetHousehold"
+	GetMemberSpendingOperation          OperationName = "GetMemberSpending"
+	GetPeriodOperation                  OperationName = "GetPeriod"
+	GetPeriodScheduleOperation          OperationName = "GetPeriodSchedule"
+	GetRecurringTransactionOperation    OperationName = "GetRecurringTransaction"
+	GetRuleOperation                    OperationName = "GetRule"
+	GetTransactionOperation             OperationName = "GetTransaction"
+	ImportHolidaysOperation             OperationName = "ImportHolidays"
+	ImportTransactionsOperation         OperationName = "ImportTransactions"
+	JoinHouseholdOperation              OperationName = "JoinHousehold"
+	ListAccessTokensOperation           OperationName = "ListAccessTokens"
+	ListAuditEntriesOperation           OperationName = "ListAuditEntries"
+	ListEnvelopesOperation              OperationName = "ListEnvelopes"
+	ListHolidaysOperation               OperationName = "ListHolidays"
+	ListPeriodGapsOperation             OperationName = "ListPeriodGaps"
+	ListPeriodsOperation                OperationName = "ListPeriods"
+	ListRecurringTransactionsOperation  OperationName = "ListRecurringTransactions"
+	ListRulesOperation                  OperationName = "ListRules"
+	ListTransactionsOperation           OperationName = "ListTransactions"
+	ListTrashOperation                  OperationName = "ListTrash"
+	ListUsersOperation                  OperationName = "ListUsers"
+	RestoreEnvelopeOperation            OperationName = "RestoreEnvelope"
+	RestoreTransactionOperation         OperationName = "RestoreTransaction"
+	RevokeAccessTokenOperation          OperationName = "RevokeAccessToken"
+	SearchTransactionsOperation         OperationName = "SearchTransactions"
+	UpdateEnvelopeOperation             OperationName = "UpdateEnvelope"
+	UpdateMemberOperation               OperationName = "UpdateMember"
+	UpdatePeriodOperation               OperationName = "UpdatePeriod"
+	UpdatePeriodScheduleOperation       OperationName = "UpdatePeriodSchedule"
+	UpdateRecurringTransactionOperation OperationName = "UpdateRecurringTransaction"
+	UpdateRuleOperation                 OperationName = "UpdateRule"
+	UpdateTransactionOperation          OperationName = "UpdateTransaction"
 )
@@ -5,6 +5,7 @@ package oas
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -15,12 +16,2544 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// ApplyRulesParams is parameters of applyRules operation.
+type ApplyRulesParams struct {
+	PeriodId uuid.UUID
+}
+
+func unpackApplyRulesParams(packed middleware.Parameters) (params ApplyRulesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "periodId",
+			In:   "path",
+		}
+		params.PeriodId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeApplyRulesParams(args [1]string, argsEscaped bool, r *http.Request) (params ApplyRulesParams, _ error) {
+	// Decode path: periodId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "periodId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PeriodId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "periodId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// CreateEnvelopeParams is parameters of createEnvelope operation.
+type CreateEnvelopeParams struct {
+	// Unique key making retries of the request safe. A retry with the same key within 24 hours returns
+	// the result of the first request instead of creating another resource. Reusing the key for a
+	// different request fails with 422. A retry arriving while the first request is still running waits
+	// for its result; if the first request failed, the retry runs anew.
+	IdempotencyKey OptString `json:",omitempty,omitzero"`
+}
+
+func unpackCreateEnvelopeParams(packed middleware.Parameters) (params CreateEnvelopeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "Idempotency-Key",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IdempotencyKey = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeCreateEnvelopeParams(args [0]string, argsEscaped bool, r *http.Request) (params CreateEnvelopeParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: Idempotency-Key.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Idempotency-Key",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIdempotencyKeyVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIdempotencyKeyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IdempotencyKey.SetTo(paramsDotIdempotencyKeyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.IdempotencyKey.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:     1,
+							MinLengthSet:  true,
+							MaxLength:     255,
+							MaxLengthSet:  true,
+							Email:         false,
+							Hostname:      false,
+							Regex:         nil,
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Idempotency-Key",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// CreatePeriodParams is parameters of createPeriod operation.
+type CreatePeriodParams struct {
+	// Unique key making retries of the request safe. A retry with the same key within 24 hours returns
+	// the result of the first request instead of creating another resource. Reusing the key for a
+	// different request fails with 422. A retry arriving while the first request is still running waits
+	// for its result; if the first request failed, the retry runs anew.
+	IdempotencyKey OptString `json:",omitempty,omitzero"`
+}
+
+func unpackCreatePeriodParams(packed middleware.Parameters) (params CreatePeriodParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "Idempotency-Key",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IdempotencyKey = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeCreatePeriodParams(args [0]string, argsEscaped bool, r *http.Request) (params CreatePeriodParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: Idempotency-Key.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Idempotency-Key",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIdempotencyKeyVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIdempotencyKeyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IdempotencyKey.SetTo(paramsDotIdempotencyKeyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.IdempotencyKey.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:     1,
+							MinLengthSet:  true,
+							MaxLength:     255,
+							MaxLengthSet:  true,
+							Email:         false,
+							Hostname:      false,
+							Regex:         nil,
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Idempotency-Key",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// CreateTransactionParams is parameters of createTransaction operation.
+type CreateTransactionParams struct {
+	// Unique key making retries of the request safe. A retry with the same key within 24 hours returns
+	// the result of the first request instead of creating another resource. Reusing the key for a
+	// different request fails with 422. A retry arriving while the first request is still running waits
+	// for its result; if the first request failed, the retry runs anew.
+	IdempotencyKey OptString `json:",omitempty,omitzero"`
+}
+
+func unpackCreateTransactionParams(packed middleware.Parameters) (params CreateTransactionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "Idempotency-Key",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IdempotencyKey = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeCreateTransactionParams(args [0]string, argsEscaped bool, r *http.Request) (params CreateTransactionParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: Idempotency-Key.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Idempotency-Key",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIdempotencyKeyVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIdempotencyKeyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IdempotencyKey.SetTo(paramsDotIdempotencyKeyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.IdempotencyKey.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:     1,
+							MinLengthSet:  true,
+							MaxLength:     255,
+							MaxLengthSet:  true,
+							Email:         false,
+							Hostname:      false,
+							Regex:         nil,
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Idempotency-Key",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // DeleteEnvelopeParams is parameters of deleteEnvelope operation.
 type DeleteEnvelopeParams struct {
 	EnvelopeId uuid.UUID
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
+}
+
+func unpackDeleteEnvelopeParams(packed middleware.Parameters) (params DeleteEnvelopeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "envelopeId",
+			In:   "path",
+		}
+		params.EnvelopeId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeDeleteEnvelopeParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteEnvelopeParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: envelopeId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "envelopeId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.EnvelopeId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "envelopeId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeletePeriodParams is parameters of deletePeriod operation.
+type DeletePeriodParams struct {
+	PeriodId uuid.UUID
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
+}
+
+func unpackDeletePeriodParams(packed middleware.Parameters) (params DeletePeriodParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "periodId",
+			In:   "path",
+		}
+		params.PeriodId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeDeletePeriodParams(args [1]string, argsEscaped bool, r *http.Request) (params DeletePeriodParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: periodId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "periodId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PeriodId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "periodId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteRecurringTransactionParams is parameters of deleteRecurringTransaction operation.
+type DeleteRecurringTransactionParams struct {
+	RecurringTransactionId uuid.UUID
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
+}
+
+func unpackDeleteRecurringTransactionParams(packed middleware.Parameters) (params DeleteRecurringTransactionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "recurringTransactionId",
+			In:   "path",
+		}
+		params.RecurringTransactionId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeDeleteRecurringTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteRecurringTransactionParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: recurringTransactionId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "recurringTransactionId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.RecurringTransactionId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "recurringTransactionId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteRuleParams is parameters of deleteRule operation.
+type DeleteRuleParams struct {
+	RuleId uuid.UUID
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
+}
+
+func unpackDeleteRuleParams(packed middleware.Parameters) (params DeleteRuleParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "ruleId",
+			In:   "path",
+		}
+		params.RuleId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeDeleteRuleParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteRuleParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: ruleId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "ruleId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.RuleId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "ruleId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteTransactionParams is parameters of deleteTransaction operation.
+type DeleteTransactionParams struct {
+	TransactionId uuid.UUID
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
+}
+
+func unpackDeleteTransactionParams(packed middleware.Parameters) (params DeleteTransactionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "transactionId",
+			In:   "path",
+		}
+		params.TransactionId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeDeleteTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTransactionParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: transactionId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "transactionId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TransactionId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "transactionId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetEnvelopeParams is parameters of getEnvelope operation.
+type GetEnvelopeParams struct {
+	EnvelopeId uuid.UUID
+}
+
+func unpackGetEnvelopeParams(packed middleware.Parameters) (params GetEnvelopeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "envelopeId",
+			In:   "path",
+		}
+		params.EnvelopeId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetEnvelopeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetEnvelopeParams, _ error) {
+	// Decode path: envelopeId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "envelopeId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.EnvelopeId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "envelopeId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMemberSpendingParams is parameters of getMemberSpending operation.
+type GetMemberSpendingParams struct {
+	PeriodId uuid.UUID
+}
+
+func unpackGetMemberSpendingParams(packed middleware.Parameters) (params GetMemberSpendingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "periodId",
+			In:   "path",
+		}
+		params.PeriodId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetMemberSpendingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMemberSpendingParams, _ error) {
+	// Decode path: periodId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "periodId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PeriodId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "periodId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetPeriodParams is parameters of getPeriod operation.
+type GetPeriodParams struct {
+	PeriodId uuid.UUID
+}
+
+func unpackGetPeriodParams(packed middleware.Parameters) (params GetPeriodParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "periodId",
+			In:   "path",
+		}
+		params.PeriodId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetPeriodParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPeriodParams, _ error) {
+	// Decode path: periodId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "periodId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PeriodId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "periodId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRecurringTransactionParams is parameters of getRecurringTransaction operation.
+type GetRecurringTransactionParams struct {
+	RecurringTransactionId uuid.UUID
+}
+
+func unpackGetRecurringTransactionParams(packed middleware.Parameters) (params GetRecurringTransactionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "recurringTransactionId",
+			In:   "path",
+		}
+		params.RecurringTransactionId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetRecurringTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRecurringTransactionParams, _ error) {
+	// Decode path: recurringTransactionId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "recurringTransactionId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.RecurringTransactionId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "recurringTransactionId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRuleParams is parameters of getRule operation.
+type GetRuleParams struct {
+	RuleId uuid.UUID
+}
+
+func unpackGetRuleParams(packed middleware.Parameters) (params GetRuleParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "ruleId",
+			In:   "path",
+		}
+		params.RuleId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetRuleParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRuleParams, _ error) {
+	// Decode path: ruleId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "ruleId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.RuleId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "ruleId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTransactionParams is parameters of getTransaction operation.
+type GetTransactionParams struct {
+	TransactionId uuid.UUID
+}
+
+func unpackGetTransactionParams(packed middleware.Parameters) (params GetTransactionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "transactionId",
+			In:   "path",
+		}
+		params.TransactionId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTransactionParams, _ error) {
+	// Decode path: transactionId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "transactionId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TransactionId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "transactionId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListAuditEntriesParams is parameters of listAuditEntries operation.
+type ListAuditEntriesParams struct {
+	// Filter by kind of entity.
+	Entity OptAuditEntity `json:",omitempty,omitzero"`
+	// Filter by entity.
+	EntityId OptUUID `json:",omitempty,omitzero"`
+	// Filter by the user who made the change.
+	ActorId OptUUID `json:",omitempty,omitzero"`
+	// Only changes made at or after this time.
+	From OptDateTime `json:",omitempty,omitzero"`
+	// Only changes made at or before this time.
+	To OptDateTime `json:",omitempty,omitzero"`
+	// Maximum number of entries to return.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListAuditEntriesParams(packed middleware.Parameters) (params ListAuditEntriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "entity",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Entity = v.(OptAuditEntity)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "entityId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EntityId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "actorId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ActorId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListAuditEntriesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListAuditEntriesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: entity.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "entity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEntityVal AuditEntity
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEntityVal = AuditEntity(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Entity.SetTo(paramsDotEntityVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Entity.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "entity",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: entityId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "entityId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEntityIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEntityIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EntityId.SetTo(paramsDotEntityIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "entityId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: actorId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "actorId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotActorIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotActorIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ActorId.SetTo(paramsDotActorIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "actorId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListHolidaysParams is parameters of listHolidays operation.
+type ListHolidaysParams struct {
+	// Defaults to the current year.
+	Year OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListHolidaysParams(packed middleware.Parameters) (params ListHolidaysParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "year",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Year = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListHolidaysParams(args [0]string, argsEscaped bool, r *http.Request) (params ListHolidaysParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: year.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "year",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotYearVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotYearVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Year.SetTo(paramsDotYearVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Year.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1900,
+							MaxSet:        true,
+							Max:           2999,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "year",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListTransactionsParams is parameters of listTransactions operation.
+type ListTransactionsParams struct {
+	// Filter by period.
+	PeriodId OptUUID `json:",omitempty,omitzero"`
+	// Filter by envelope, including split lines.
+	EnvelopeId OptUUID `json:",omitempty,omitzero"`
+	// Only transactions on or after this date.
+	DateFrom OptDateTime `json:",omitempty,omitzero"`
+	// Only transactions on or before this date.
+	DateTo OptDateTime `json:",omitempty,omitzero"`
+	// Minimum amount in currency cents (inclusive).
+	MinAmount OptInt64 `json:",omitempty,omitzero"`
+	// Maximum amount in currency cents (inclusive).
+	MaxAmount OptInt64 `json:",omitempty,omitzero"`
+	// Filter by category, including split lines.
+	Category OptString `json:",omitempty,omitzero"`
+	// Case-insensitive substring of the description.
+	Description OptString `json:",omitempty,omitzero"`
+	// Only income (positive) or expense (negative) transactions.
+	Sign OptListTransactionsSign `json:",omitempty,omitzero"`
+	// Filter by the user who recorded the transaction.
+	CreatedBy OptUUID                 `json:",omitempty,omitzero"`
+	Sort      OptListTransactionsSort `json:",omitempty,omitzero"`
+	// Page size. When omitted, all matching transactions are returned.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Opaque cursor from the X-Next-Cursor header of the previous page.
+	Cursor OptString `json:",omitempty,omitzero"`
+}
+
+func unpackListTransactionsParams(packed middleware.Parameters) (params ListTransactionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "periodId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PeriodId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "envelopeId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EnvelopeId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "dateFrom",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.DateFrom = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "dateTo",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.DateTo = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "minAmount",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MinAmount = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "maxAmount",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MaxAmount = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "category",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Category = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "description",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Description = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sign",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sign = v.(OptListTransactionsSign)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "createdBy",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreatedBy = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptListTransactionsSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeListTransactionsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListTransactionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: periodId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "periodId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPeriodIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPeriodIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PeriodId.SetTo(paramsDotPeriodIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "periodId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: envelopeId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "envelopeId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEnvelopeIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEnvelopeIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EnvelopeId.SetTo(paramsDotEnvelopeIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "envelopeId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: dateFrom.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "dateFrom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDateFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDateFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.DateFrom.SetTo(paramsDotDateFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "dateFrom",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: dateTo.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "dateTo",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDateToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDateToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.DateTo.SetTo(paramsDotDateToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "dateTo",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: minAmount.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "minAmount",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMinAmountVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMinAmountVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MinAmount.SetTo(paramsDotMinAmountVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "minAmount",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: maxAmount.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "maxAmount",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMaxAmountVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMaxAmountVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MaxAmount.SetTo(paramsDotMaxAmountVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "maxAmount",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: category.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "category",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCategoryVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCategoryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Category.SetTo(paramsDotCategoryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "category",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: description.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "description",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDescriptionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDescriptionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Description.SetTo(paramsDotDescriptionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "description",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sign.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sign",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSignVal ListTransactionsSign
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSignVal = ListTransactionsSign(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sign.SetTo(paramsDotSignVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sign.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sign",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: createdBy.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "createdBy",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreatedByVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreatedByVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreatedBy.SetTo(paramsDotCreatedByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "createdBy",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort.
+	{
+		val := ListTransactionsSort("date_desc")
+		params.Sort.SetTo(val)
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal ListTransactionsSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = ListTransactionsSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RestoreEnvelopeParams is parameters of restoreEnvelope operation.
+type RestoreEnvelopeParams struct {
+	EnvelopeId uuid.UUID
 }
 
-func unpackDeleteEnvelopeParams(packed middleware.Parameters) (params DeleteEnvelopeParams) {
+func unpackRestoreEnvelopeParams(packed middleware.Parameters) (params RestoreEnvelopeParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "envelopeId",
@@ -31,7 +2564,7 @@ func unpackDeleteEnvelopeParams(packed middleware.Parameters) (params DeleteEnve
 	return params
 }
 
-func decodeDeleteEnvelopeParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteEnvelopeParams, _ error) {
+func decodeRestoreEnvelopeParams(args [1]string, argsEscaped bool, r *http.Request) (params RestoreEnvelopeParams, _ error) {
 	// Decode path: envelopeId.
 	if err := func() error {
 		param := args[0]
@@ -80,24 +2613,24 @@ func decodeDeleteEnvelopeParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// DeletePeriodParams is parameters of deletePeriod operation.
-type DeletePeriodParams struct {
-	PeriodId uuid.UUID
+// RestoreTransactionParams is parameters of restoreTransaction operation.
+type RestoreTransactionParams struct {
+	TransactionId uuid.UUID
 }
 
-func unpackDeletePeriodParams(packed middleware.Parameters) (params DeletePeriodParams) {
+func unpackRestoreTransactionParams(packed middleware.Parameters) (params RestoreTransactionParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "periodId",
+			Name: "transactionId",
 			In:   "path",
 		}
-		params.PeriodId = packed[key].(uuid.UUID)
+		params.TransactionId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeletePeriodParams(args [1]string, argsEscaped bool, r *http.Request) (params DeletePeriodParams, _ error) {
-	// Decode path: periodId.
+func decodeRestoreTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params RestoreTransactionParams, _ error) {
+	// Decode path: transactionId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -109,7 +2642,7 @@ func decodeDeletePeriodParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "periodId",
+				Param:   "transactionId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -126,7 +2659,7 @@ func decodeDeletePeriodParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.PeriodId = c
+				params.TransactionId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -137,7 +2670,7 @@ func decodeDeletePeriodParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "periodId",
+			Name: "transactionId",
 			In:   "path",
 			Err:  err,
 		}
@@ -145,24 +2678,24 @@ func decodeDeletePeriodParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// DeleteTransactionParams is parameters of deleteTransaction operation.
-type DeleteTransactionParams struct {
-	TransactionId uuid.UUID
+// RevokeAccessTokenParams is parameters of revokeAccessToken operation.
+type RevokeAccessTokenParams struct {
+	TokenId uuid.UUID
 }
 
-func unpackDeleteTransactionParams(packed middleware.Parameters) (params DeleteTransactionParams) {
+func unpackRevokeAccessTokenParams(packed middleware.Parameters) (params RevokeAccessTokenParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "transactionId",
+			Name: "tokenId",
 			In:   "path",
 		}
-		params.TransactionId = packed[key].(uuid.UUID)
+		params.TokenId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTransactionParams, _ error) {
-	// Decode path: transactionId.
+func decodeRevokeAccessTokenParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeAccessTokenParams, _ error) {
+	// Decode path: tokenId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -174,7 +2707,7 @@ func decodeDeleteTransactionParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "transactionId",
+				Param:   "tokenId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -191,7 +2724,7 @@ func decodeDeleteTransactionParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.TransactionId = c
+				params.TokenId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -202,20 +2735,183 @@ func decodeDeleteTransactionParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "transactionId",
-			In:   "path",
+			Name: "tokenId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchTransactionsParams is parameters of searchTransactions operation.
+type SearchTransactionsParams struct {
+	// Search text.
+	Q     string
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackSearchTransactionsParams(packed middleware.Parameters) (params SearchTransactionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeSearchTransactionsParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchTransactionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     0,
+					MaxLengthSet:  false,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetEnvelopeParams is parameters of getEnvelope operation.
-type GetEnvelopeParams struct {
+// UpdateEnvelopeParams is parameters of updateEnvelope operation.
+type UpdateEnvelopeParams struct {
 	EnvelopeId uuid.UUID
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetEnvelopeParams(packed middleware.Parameters) (params GetEnvelopeParams) {
+func unpackUpdateEnvelopeParams(packed middleware.Parameters) (params UpdateEnvelopeParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "envelopeId",
@@ -223,10 +2919,20 @@ func unpackGetEnvelopeParams(packed middleware.Parameters) (params GetEnvelopePa
 		}
 		params.EnvelopeId = packed[key].(uuid.UUID)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
 	return params
 }
 
-func decodeGetEnvelopeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetEnvelopeParams, _ error) {
+func decodeUpdateEnvelopeParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateEnvelopeParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: envelopeId.
 	if err := func() error {
 		param := args[0]
@@ -272,27 +2978,66 @@ func decodeGetEnvelopeParams(args [1]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// GetPeriodParams is parameters of getPeriod operation.
-type GetPeriodParams struct {
-	PeriodId uuid.UUID
+// UpdateMemberParams is parameters of updateMember operation.
+type UpdateMemberParams struct {
+	UserId uuid.UUID
 }
 
-func unpackGetPeriodParams(packed middleware.Parameters) (params GetPeriodParams) {
+func unpackUpdateMemberParams(packed middleware.Parameters) (params UpdateMemberParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "periodId",
+			Name: "userId",
 			In:   "path",
 		}
-		params.PeriodId = packed[key].(uuid.UUID)
+		params.UserId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetPeriodParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPeriodParams, _ error) {
-	// Decode path: periodId.
+func decodeUpdateMemberParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateMemberParams, _ error) {
+	// Decode path: userId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -304,7 +3049,7 @@ func decodeGetPeriodParams(args [1]string, argsEscaped bool, r *http.Request) (p
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "periodId",
+				Param:   "userId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -321,7 +3066,7 @@ func decodeGetPeriodParams(args [1]string, argsEscaped bool, r *http.Request) (p
 					return err
 				}
 
-				params.PeriodId = c
+				params.UserId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -332,7 +3077,7 @@ func decodeGetPeriodParams(args [1]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "periodId",
+			Name: "userId",
 			In:   "path",
 			Err:  err,
 		}
@@ -340,24 +3085,38 @@ func decodeGetPeriodParams(args [1]string, argsEscaped bool, r *http.Request) (p
 	return params, nil
 }
 
-// GetTransactionParams is parameters of getTransaction operation.
-type GetTransactionParams struct {
-	TransactionId uuid.UUID
+// UpdatePeriodParams is parameters of updatePeriod operation.
+type UpdatePeriodParams struct {
+	PeriodId uuid.UUID
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetTransactionParams(packed middleware.Parameters) (params GetTransactionParams) {
+func unpackUpdatePeriodParams(packed middleware.Parameters) (params UpdatePeriodParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "transactionId",
+			Name: "periodId",
 			In:   "path",
 		}
-		params.TransactionId = packed[key].(uuid.UUID)
+		params.PeriodId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
 	}
 	return params
 }
 
-func decodeGetTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTransactionParams, _ error) {
-	// Decode path: transactionId.
+func decodeUpdatePeriodParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdatePeriodParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: periodId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -369,7 +3128,7 @@ func decodeGetTransactionParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "transactionId",
+				Param:   "periodId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -386,7 +3145,7 @@ func decodeGetTransactionParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.TransactionId = c
+				params.PeriodId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -397,63 +3156,102 @@ func decodeGetTransactionParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "transactionId",
+			Name: "periodId",
 			In:   "path",
 			Err:  err,
 		}
 	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// ListTransactionsParams is parameters of listTransactions operation.
-type ListTransactionsParams struct {
-	// Filter by period.
-	PeriodId OptUUID `json:",omitempty,omitzero"`
+// UpdatePeriodScheduleParams is parameters of updatePeriodSchedule operation.
+type UpdatePeriodScheduleParams struct {
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
 }
 
-func unpackListTransactionsParams(packed middleware.Parameters) (params ListTransactionsParams) {
+func unpackUpdatePeriodScheduleParams(packed middleware.Parameters) (params UpdatePeriodScheduleParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "periodId",
-			In:   "query",
+			Name: "If-Match",
+			In:   "header",
 		}
 		if v, ok := packed[key]; ok {
-			params.PeriodId = v.(OptUUID)
+			params.IfMatch = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeListTransactionsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListTransactionsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: periodId.
+func decodeUpdatePeriodScheduleParams(args [0]string, argsEscaped bool, r *http.Request) (params UpdatePeriodScheduleParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-Match.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "periodId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPeriodIdVal uuid.UUID
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPeriodIdVal = c
+					paramsDotIfMatchVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PeriodId.SetTo(paramsDotPeriodIdVal)
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
 				return nil
 			}); err != nil {
 				return err
@@ -462,32 +3260,46 @@ func decodeListTransactionsParams(args [0]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "periodId",
-			In:   "query",
+			Name: "If-Match",
+			In:   "header",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// UpdateEnvelopeParams is parameters of updateEnvelope operation.
-type UpdateEnvelopeParams struct {
-	EnvelopeId uuid.UUID
+// UpdateRecurringTransactionParams is parameters of updateRecurringTransaction operation.
+type UpdateRecurringTransactionParams struct {
+	RecurringTransactionId uuid.UUID
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
 }
 
-func unpackUpdateEnvelopeParams(packed middleware.Parameters) (params UpdateEnvelopeParams) {
+func unpackUpdateRecurringTransactionParams(packed middleware.Parameters) (params UpdateRecurringTransactionParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "envelopeId",
+			Name: "recurringTransactionId",
 			In:   "path",
 		}
-		params.EnvelopeId = packed[key].(uuid.UUID)
+		params.RecurringTransactionId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
 	}
 	return params
 }
 
-func decodeUpdateEnvelopeParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateEnvelopeParams, _ error) {
-	// Decode path: envelopeId.
+func decodeUpdateRecurringTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateRecurringTransactionParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: recurringTransactionId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -499,7 +3311,7 @@ func decodeUpdateEnvelopeParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "envelopeId",
+				Param:   "recurringTransactionId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -516,7 +3328,7 @@ func decodeUpdateEnvelopeParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.EnvelopeId = c
+				params.RecurringTransactionId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -527,32 +3339,85 @@ func decodeUpdateEnvelopeParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "envelopeId",
+			Name: "recurringTransactionId",
 			In:   "path",
 			Err:  err,
 		}
 	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// UpdatePeriodParams is parameters of updatePeriod operation.
-type UpdatePeriodParams struct {
-	PeriodId uuid.UUID
+// UpdateRuleParams is parameters of updateRule operation.
+type UpdateRuleParams struct {
+	RuleId uuid.UUID
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
 }
 
-func unpackUpdatePeriodParams(packed middleware.Parameters) (params UpdatePeriodParams) {
+func unpackUpdateRuleParams(packed middleware.Parameters) (params UpdateRuleParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "periodId",
+			Name: "ruleId",
 			In:   "path",
 		}
-		params.PeriodId = packed[key].(uuid.UUID)
+		params.RuleId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
 	}
 	return params
 }
 
-func decodeUpdatePeriodParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdatePeriodParams, _ error) {
-	// Decode path: periodId.
+func decodeUpdateRuleParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateRuleParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: ruleId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -564,7 +3429,7 @@ func decodeUpdatePeriodParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "periodId",
+				Param:   "ruleId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -581,7 +3446,7 @@ func decodeUpdatePeriodParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.PeriodId = c
+				params.RuleId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -592,17 +3457,60 @@ func decodeUpdatePeriodParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "periodId",
+			Name: "ruleId",
 			In:   "path",
 			Err:  err,
 		}
 	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
 // UpdateTransactionParams is parameters of updateTransaction operation.
 type UpdateTransactionParams struct {
 	TransactionId uuid.UUID
+	// ETag of the version the change is based on. The change fails with 412 when the resource has
+	// changed since, and with 409 when it races another change. Without the header, or with *, the
+	// change applies to whatever version is current.
+	IfMatch OptString `json:",omitempty,omitzero"`
 }
 
 func unpackUpdateTransactionParams(packed middleware.Parameters) (params UpdateTransactionParams) {
@@ -613,10 +3521,20 @@ func unpackUpdateTransactionParams(packed middleware.Parameters) (params UpdateT
 		}
 		params.TransactionId = packed[key].(uuid.UUID)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
 	return params
 }
 
 func decodeUpdateTransactionParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateTransactionParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: transactionId.
 	if err := func() error {
 		param := args[0]
@@ -662,5 +3580,44 @@ func decodeUpdateTransactionParams(args [1]string, argsEscaped bool, r *http.Req
 			Err:  err,
 		}
 	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
@@ -61,6 +61,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
+			case 'a': // Prefix: "audit"
+
+				if l := len("audit"); len(elem) >= l && elem[0:l] == "audit" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleListAuditEntriesRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
 			case 'e': // Prefix: "envelopes"
 
 				if l := len("envelopes"); len(elem) >= l && elem[0:l] == "envelopes" {
@@ -91,16 +111,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					// Param: "envelopeId"
-					// Leaf parameter, slashes are prohibited
+					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
+					if idx < 0 {
+						idx = len(elem)
 					}
-					args[0] = elem
-					elem = ""
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "DELETE":
 							s.handleDeleteEnvelopeRequest([1]string{
@@ -120,32 +139,36 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/restore"
 
-				}
+						if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-			case 'm': // Prefix: "me"
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleRestoreEnvelopeRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
 
-				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+							return
+						}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleGetCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
 					}
 
-					return
 				}
 
-			case 'p': // Prefix: "periods"
+			case 'h': // Prefix: "household"
 
-				if l := len("periods"); len(elem) >= l && elem[0:l] == "periods" {
+				if l := len("household"); len(elem) >= l && elem[0:l] == "household" {
 					elem = elem[l:]
 				} else {
 					break
@@ -154,11 +177,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				if len(elem) == 0 {
 					switch r.Method {
 					case "GET":
-						s.handleListPeriodsRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handleCreatePeriodRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleGetHouseholdRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET,POST")
+						s.notAllowed(w, r, "GET")
 					}
 
 					return
@@ -176,9 +197,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'c': // Prefix: "current"
-						origElem := elem
-						if l := len("current"); len(elem) >= l && elem[0:l] == "current" {
+					case 'h': // Prefix: "holidays"
+
+						if l := len("holidays"); len(elem) >= l && elem[0:l] == "holidays" {
 							elem = elem[l:]
 						} else {
 							break
@@ -188,52 +209,211 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "GET":
-								s.handleGetCurrentPeriodRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleListHolidaysRequest([0]string{}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleImportHolidaysRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, "GET,PUT")
+							}
+
+							return
+						}
+
+					case 'i': // Prefix: "invitations"
+
+						if l := len("invitations"); len(elem) >= l && elem[0:l] == "invitations" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleCreateInvitationRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'j': // Prefix: "join"
+
+						if l := len("join"); len(elem) >= l && elem[0:l] == "join" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleJoinHouseholdRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'm': // Prefix: "members/"
+
+						if l := len("members/"); len(elem) >= l && elem[0:l] == "members/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "userId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "PUT":
+								s.handleUpdateMemberRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "PUT")
+							}
+
+							return
+						}
+
+					case 'p': // Prefix: "period-schedule"
+
+						if l := len("period-schedule"); len(elem) >= l && elem[0:l] == "period-schedule" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetPeriodScheduleRequest([0]string{}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdatePeriodScheduleRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PUT")
 							}
 
 							return
 						}
 
-						elem = origElem
 					}
-					// Param: "periodId"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
+
+				}
+
+			case 'i': // Prefix: "imports"
+
+				if l := len("imports"); len(elem) >= l && elem[0:l] == "imports" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "POST":
+						s.handleImportTransactionsRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
+
+			case 'm': // Prefix: "me"
+
+				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleGetCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/tokens"
+
+					if l := len("/tokens"); len(elem) >= l && elem[0:l] == "/tokens" {
+						elem = elem[l:]
+					} else {
 						break
 					}
-					args[0] = elem
-					elem = ""
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
-						case "DELETE":
-							s.handleDeletePeriodRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
 						case "GET":
-							s.handleGetPeriodRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "PATCH":
-							s.handleUpdatePeriodRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+							s.handleListAccessTokensRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateAccessTokenRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE,GET,PATCH")
+							s.notAllowed(w, r, "GET,POST")
 						}
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "tokenId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleRevokeAccessTokenRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE")
+							}
+
+							return
+						}
+
+					}
 
 				}
 
-			case 't': // Prefix: "transactions"
+			case 'p': // Prefix: "periods"
 
-				if l := len("transactions"); len(elem) >= l && elem[0:l] == "transactions" {
+				if l := len("periods"); len(elem) >= l && elem[0:l] == "periods" {
 					elem = elem[l:]
 				} else {
 					break
@@ -242,9 +422,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				if len(elem) == 0 {
 					switch r.Method {
 					case "GET":
-						s.handleListTransactionsRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleListPeriodsRequest([0]string{}, elemIsEscaped, w, r)
 					case "POST":
-						s.handleCreateTransactionRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleCreatePeriodRequest([0]string{}, elemIsEscaped, w, r)
 					default:
 						s.notAllowed(w, r, "GET,POST")
 					}
@@ -260,28 +440,74 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 
-					// Param: "transactionId"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
+					if len(elem) == 0 {
 						break
 					}
-					args[0] = elem
-					elem = ""
+					switch elem[0] {
+					case 'c': // Prefix: "current"
+						origElem := elem
+						if l := len("current"); len(elem) >= l && elem[0:l] == "current" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetCurrentPeriodRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 'g': // Prefix: "gaps"
+						origElem := elem
+						if l := len("gaps"); len(elem) >= l && elem[0:l] == "gaps" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleListPeriodGapsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+					// Param: "periodId"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "DELETE":
-							s.handleDeleteTransactionRequest([1]string{
+							s.handleDeletePeriodRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						case "GET":
-							s.handleGetTransactionRequest([1]string{
+							s.handleGetPeriodRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						case "PATCH":
-							s.handleUpdateTransactionRequest([1]string{
+							s.handleUpdatePeriodRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
@@ -290,8 +516,385 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-				}
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "apply-rules"
+
+							if l := len("apply-rules"); len(elem) >= l && elem[0:l] == "apply-rules" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleApplyRulesRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'm': // Prefix: "member-spending"
+
+							if l := len("member-spending"); len(elem) >= l && elem[0:l] == "member-spending" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetMemberSpendingRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'r': // Prefix: "r"
+
+				if l := len("r"); len(elem) >= l && elem[0:l] == "r" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "ecurring-transactions"
+
+					if l := len("ecurring-transactions"); len(elem) >= l && elem[0:l] == "ecurring-transactions" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleListRecurringTransactionsRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateRecurringTransactionRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "recurringTransactionId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteRecurringTransactionRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetRecurringTransactionRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUpdateRecurringTransactionRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PATCH")
+							}
+
+							return
+						}
+
+					}
+
+				case 'u': // Prefix: "ules"
+
+					if l := len("ules"); len(elem) >= l && elem[0:l] == "ules" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleListRulesRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateRuleRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "ruleId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteRuleRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetRuleRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUpdateRuleRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PATCH")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 't': // Prefix: "tra"
+
+				if l := len("tra"); len(elem) >= l && elem[0:l] == "tra" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'n': // Prefix: "ns"
+
+					if l := len("ns"); len(elem) >= l && elem[0:l] == "ns" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "actions"
+
+						if l := len("actions"); len(elem) >= l && elem[0:l] == "actions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListTransactionsRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleCreateTransactionRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 's': // Prefix: "search"
+								origElem := elem
+								if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleSearchTransactionsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+							// Param: "transactionId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleDeleteTransactionRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleGetTransactionRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleUpdateTransactionRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PATCH")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/restore"
+
+								if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRestoreTransactionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 'f': // Prefix: "fers"
+
+						if l := len("fers"); len(elem) >= l && elem[0:l] == "fers" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleCreateTransferRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				case 's': // Prefix: "sh"
+
+					if l := len("sh"); len(elem) >= l && elem[0:l] == "sh" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListTrashRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
 
 			case 'u': // Prefix: "users"
 
@@ -413,6 +1016,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
+			case 'a': // Prefix: "audit"
+
+				if l := len("audit"); len(elem) >= l && elem[0:l] == "audit" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = ListAuditEntriesOperation
+						r.summary = "List the audit log of the household"
+						r.operationID = "listAuditEntries"
+						r.operationGroup = ""
+						r.pathPattern = "/audit"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
 			case 'e': // Prefix: "envelopes"
 
 				if l := len("envelopes"); len(elem) >= l && elem[0:l] == "envelopes" {
@@ -455,16 +1083,15 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 
 					// Param: "envelopeId"
-					// Leaf parameter, slashes are prohibited
+					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
+					if idx < 0 {
+						idx = len(elem)
 					}
-					args[0] = elem
-					elem = ""
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
 						case "DELETE":
 							r.name = DeleteEnvelopeOperation
@@ -497,26 +1124,52 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/restore"
+
+						if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = RestoreEnvelopeOperation
+								r.summary = "Restore a deleted envelope from the trash"
+								r.operationID = "restoreEnvelope"
+								r.operationGroup = ""
+								r.pathPattern = "/envelopes/{envelopeId}/restore"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
 
 				}
 
-			case 'm': // Prefix: "me"
+			case 'h': // Prefix: "household"
 
-				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+				if l := len("household"); len(elem) >= l && elem[0:l] == "household" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch method {
 					case "GET":
-						r.name = GetCurrentUserOperation
-						r.summary = "Get current authenticated user"
-						r.operationID = "getCurrentUser"
+						r.name = GetHouseholdOperation
+						r.summary = "Get the household of the current user"
+						r.operationID = "getHousehold"
 						r.operationGroup = ""
-						r.pathPattern = "/me"
+						r.pathPattern = "/household"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -524,16 +1177,304 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
 
-			case 'p': // Prefix: "periods"
-
-				if l := len("periods"); len(elem) >= l && elem[0:l] == "periods" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-				if len(elem) == 0 {
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'h': // Prefix: "holidays"
+
+						if l := len("holidays"); len(elem) >= l && elem[0:l] == "holidays" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ListHolidaysOperation
+								r.summary = "List the holidays of the household"
+								r.operationID = "listHolidays"
+								r.operationGroup = ""
+								r.pathPattern = "/household/holidays"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "PUT":
+								r.name = ImportHolidaysOperation
+								r.summary = "Import holidays from an iCalendar file"
+								r.operationID = "importHolidays"
+								r.operationGroup = ""
+								r.pathPattern = "/household/holidays"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'i': // Prefix: "invitations"
+
+						if l := len("invitations"); len(elem) >= l && elem[0:l] == "invitations" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = CreateInvitationOperation
+								r.summary = "Invite someone to the household of the current user"
+								r.operationID = "createInvitation"
+								r.operationGroup = ""
+								r.pathPattern = "/household/invitations"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'j': // Prefix: "join"
+
+						if l := len("join"); len(elem) >= l && elem[0:l] == "join" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = JoinHouseholdOperation
+								r.summary = "Join a household with an invitation code"
+								r.operationID = "joinHousehold"
+								r.operationGroup = ""
+								r.pathPattern = "/household/join"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'm': // Prefix: "members/"
+
+						if l := len("members/"); len(elem) >= l && elem[0:l] == "members/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "userId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "PUT":
+								r.name = UpdateMemberOperation
+								r.summary = "Change the role and envelope grants of a household member"
+								r.operationID = "updateMember"
+								r.operationGroup = ""
+								r.pathPattern = "/household/members/{userId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'p': // Prefix: "period-schedule"
+
+						if l := len("period-schedule"); len(elem) >= l && elem[0:l] == "period-schedule" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetPeriodScheduleOperation
+								r.summary = "Get the period schedule of the household"
+								r.operationID = "getPeriodSchedule"
+								r.operationGroup = ""
+								r.pathPattern = "/household/period-schedule"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "PUT":
+								r.name = UpdatePeriodScheduleOperation
+								r.summary = "Replace the period schedule of the household"
+								r.operationID = "updatePeriodSchedule"
+								r.operationGroup = ""
+								r.pathPattern = "/household/period-schedule"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 'i': // Prefix: "imports"
+
+				if l := len("imports"); len(elem) >= l && elem[0:l] == "imports" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "POST":
+						r.name = ImportTransactionsOperation
+						r.summary = "Import transactions from a bank statement"
+						r.operationID = "importTransactions"
+						r.operationGroup = ""
+						r.pathPattern = "/imports"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'm': // Prefix: "me"
+
+				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = GetCurrentUserOperation
+						r.summary = "Get current authenticated user"
+						r.operationID = "getCurrentUser"
+						r.operationGroup = ""
+						r.pathPattern = "/me"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/tokens"
+
+					if l := len("/tokens"); len(elem) >= l && elem[0:l] == "/tokens" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListAccessTokensOperation
+							r.summary = "List personal access tokens of the current user"
+							r.operationID = "listAccessTokens"
+							r.operationGroup = ""
+							r.pathPattern = "/me/tokens"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateAccessTokenOperation
+							r.summary = "Create a personal access token"
+							r.operationID = "createAccessToken"
+							r.operationGroup = ""
+							r.pathPattern = "/me/tokens"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "tokenId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = RevokeAccessTokenOperation
+								r.summary = "Revoke a personal access token"
+								r.operationID = "revokeAccessToken"
+								r.operationGroup = ""
+								r.pathPattern = "/me/tokens/{tokenId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 'p': // Prefix: "periods"
+
+				if l := len("periods"); len(elem) >= l && elem[0:l] == "periods" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
 					switch method {
 					case "GET":
 						r.name = ListPeriodsOperation
@@ -595,19 +1536,44 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
+						elem = origElem
+					case 'g': // Prefix: "gaps"
+						origElem := elem
+						if l := len("gaps"); len(elem) >= l && elem[0:l] == "gaps" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ListPeriodGapsOperation
+								r.summary = "List gaps between periods"
+								r.operationID = "listPeriodGaps"
+								r.operationGroup = ""
+								r.pathPattern = "/periods/gaps"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
 						elem = origElem
 					}
 					// Param: "periodId"
-					// Leaf parameter, slashes are prohibited
+					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
+					if idx < 0 {
+						idx = len(elem)
 					}
-					args[0] = elem
-					elem = ""
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
 						case "DELETE":
 							r.name = DeletePeriodOperation
@@ -640,88 +1606,477 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "apply-rules"
+
+							if l := len("apply-rules"); len(elem) >= l && elem[0:l] == "apply-rules" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ApplyRulesOperation
+									r.summary = "Re-apply categorisation rules to a period"
+									r.operationID = "applyRules"
+									r.operationGroup = ""
+									r.pathPattern = "/periods/{periodId}/apply-rules"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'm': // Prefix: "member-spending"
+
+							if l := len("member-spending"); len(elem) >= l && elem[0:l] == "member-spending" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetMemberSpendingOperation
+									r.summary = "Spending breakdown per household member"
+									r.operationID = "getMemberSpending"
+									r.operationGroup = ""
+									r.pathPattern = "/periods/{periodId}/member-spending"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
 
 				}
 
-			case 't': // Prefix: "transactions"
+			case 'r': // Prefix: "r"
 
-				if l := len("transactions"); len(elem) >= l && elem[0:l] == "transactions" {
+				if l := len("r"); len(elem) >= l && elem[0:l] == "r" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = ListTransactionsOperation
-						r.summary = "List transactions"
-						r.operationID = "listTransactions"
-						r.operationGroup = ""
-						r.pathPattern = "/transactions"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "POST":
-						r.name = CreateTransactionOperation
-						r.summary = "Create a new transaction"
-						r.operationID = "createTransaction"
-						r.operationGroup = ""
-						r.pathPattern = "/transactions"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'e': // Prefix: "ecurring-transactions"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("ecurring-transactions"); len(elem) >= l && elem[0:l] == "ecurring-transactions" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "transactionId"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListRecurringTransactionsOperation
+							r.summary = "List recurring transaction templates"
+							r.operationID = "listRecurringTransactions"
+							r.operationGroup = ""
+							r.pathPattern = "/recurring-transactions"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateRecurringTransactionOperation
+							r.summary = "Create a recurring transaction template"
+							r.operationID = "createRecurringTransaction"
+							r.operationGroup = ""
+							r.pathPattern = "/recurring-transactions"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "recurringTransactionId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = DeleteRecurringTransactionOperation
+								r.summary = "Delete a recurring transaction template"
+								r.operationID = "deleteRecurringTransaction"
+								r.operationGroup = ""
+								r.pathPattern = "/recurring-transactions/{recurringTransactionId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetRecurringTransactionOperation
+								r.summary = "Get recurring transaction template by ID"
+								r.operationID = "getRecurringTransaction"
+								r.operationGroup = ""
+								r.pathPattern = "/recurring-transactions/{recurringTransactionId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = UpdateRecurringTransactionOperation
+								r.summary = "Update a recurring transaction template"
+								r.operationID = "updateRecurringTransaction"
+								r.operationGroup = ""
+								r.pathPattern = "/recurring-transactions/{recurringTransactionId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'u': // Prefix: "ules"
+
+					if l := len("ules"); len(elem) >= l && elem[0:l] == "ules" {
+						elem = elem[l:]
+					} else {
 						break
 					}
-					args[0] = elem
-					elem = ""
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
-						case "DELETE":
-							r.name = DeleteTransactionOperation
-							r.summary = "Delete a transaction"
-							r.operationID = "deleteTransaction"
+						case "GET":
+							r.name = ListRulesOperation
+							r.summary = "List categorisation rules"
+							r.operationID = "listRules"
 							r.operationGroup = ""
-							r.pathPattern = "/transactions/{transactionId}"
+							r.pathPattern = "/rules"
 							r.args = args
-							r.count = 1
+							r.count = 0
 							return r, true
-						case "GET":
-							r.name = GetTransactionOperation
-							r.summary = "Get transaction by ID"
-							r.operationID = "getTransaction"
+						case "POST":
+							r.name = CreateRuleOperation
+							r.summary = "Create a categorisation rule"
+							r.operationID = "createRule"
 							r.operationGroup = ""
-							r.pathPattern = "/transactions/{transactionId}"
+							r.pathPattern = "/rules"
 							r.args = args
-							r.count = 1
+							r.count = 0
 							return r, true
-						case "PATCH":
-							r.name = UpdateTransactionOperation
-							r.summary = "Update a transaction"
-							r.operationID = "updateTransaction"
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "ruleId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = DeleteRuleOperation
+								r.summary = "Delete a categorisation rule"
+								r.operationID = "deleteRule"
+								r.operationGroup = ""
+								r.pathPattern = "/rules/{ruleId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetRuleOperation
+								r.summary = "Get categorisation rule by ID"
+								r.operationID = "getRule"
+								r.operationGroup = ""
+								r.pathPattern = "/rules/{ruleId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = UpdateRuleOperation
+								r.summary = "Update a categorisation rule"
+								r.operationID = "updateRule"
+								r.operationGroup = ""
+								r.pathPattern = "/rules/{ruleId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 't': // Prefix: "tra"
+
+				if l := len("tra"); len(elem) >= l && elem[0:l] == "tra" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'n': // Prefix: "ns"
+
+					if l := len("ns"); len(elem) >= l && elem[0:l] == "ns" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "actions"
+
+						if l := len("actions"); len(elem) >= l && elem[0:l] == "actions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListTransactionsOperation
+								r.summary = "List transactions"
+								r.operationID = "listTransactions"
+								r.operationGroup = ""
+								r.pathPattern = "/transactions"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = CreateTransactionOperation
+								r.summary = "Create a new transaction"
+								r.operationID = "createTransaction"
+								r.operationGroup = ""
+								r.pathPattern = "/transactions"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 's': // Prefix: "search"
+								origElem := elem
+								if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = SearchTransactionsOperation
+										r.summary = "Search transactions"
+										r.operationID = "searchTransactions"
+										r.operationGroup = ""
+										r.pathPattern = "/transactions/search"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+							// Param: "transactionId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = DeleteTransactionOperation
+									r.summary = "Delete a transaction"
+									r.operationID = "deleteTransaction"
+									r.operationGroup = ""
+									r.pathPattern = "/transactions/{transactionId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = GetTransactionOperation
+									r.summary = "Get transaction by ID"
+									r.operationID = "getTransaction"
+									r.operationGroup = ""
+									r.pathPattern = "/transactions/{transactionId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PATCH":
+									r.name = UpdateTransactionOperation
+									r.summary = "Update a transaction"
+									r.operationID = "updateTransaction"
+									r.operationGroup = ""
+									r.pathPattern = "/transactions/{transactionId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/restore"
+
+								if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = RestoreTransactionOperation
+										r.summary = "Restore a deleted transaction from the trash"
+										r.operationID = "restoreTransaction"
+										r.operationGroup = ""
+										r.pathPattern = "/transactions/{transactionId}/restore"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 'f': // Prefix: "fers"
+
+						if l := len("fers"); len(elem) >= l && elem[0:l] == "fers" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = CreateTransferOperation
+								r.summary = "Transfer funds between envelopes"
+								r.operationID = "createTransfer"
+								r.operationGroup = ""
+								r.pathPattern = "/transfers"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 's': // Prefix: "sh"
+
+					if l := len("sh"); len(elem) >= l && elem[0:l] == "sh" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ListTrashOperation
+							r.summary = "List deleted transactions and envelopes"
+							r.operationID = "listTrash"
 							r.operationGroup = ""
-							r.pathPattern = "/transactions/{transactionId}"
+							r.pathPattern = "/trash"
 							r.args = args
-							r.count = 1
+							r.count = 0
 							return r, true
 						default:
 							return
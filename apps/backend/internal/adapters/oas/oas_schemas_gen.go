@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 	"github.com/google/uuid"
 )
 
@@ -13,6 +15,406 @@ func (s *ErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+// Ref: #/components/schemas/AccessToken
+type AccessToken struct {
+	ID         uuid.UUID        `json:"id"`
+	Name       string           `json:"name"`
+	Scope      AccessTokenScope `json:"scope"`
+	CreatedAt  time.Time        `json:"createdAt"`
+	ExpiresAt  time.Time        `json:"expiresAt"`
+	LastUsedAt OptDateTime      `json:"lastUsedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *AccessToken) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *AccessToken) GetName() string {
+	return s.Name
+}
+
+// GetScope returns the value of Scope.
+func (s *AccessToken) GetScope() AccessTokenScope {
+	return s.Scope
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *AccessToken) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *AccessToken) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *AccessToken) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// SetID sets the value of ID.
+func (s *AccessToken) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *AccessToken) SetName(val string) {
+	s.Name = val
+}
+
+// SetScope sets the value of Scope.
+func (s *AccessToken) SetScope(val AccessTokenScope) {
+	s.Scope = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *AccessToken) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *AccessToken) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *AccessToken) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// * `read` - only reads household data
+// * `write` - anything the role of the user allows.
+// Ref: #/components/schemas/AccessTokenScope
+type AccessTokenScope string
+
+const (
+	AccessTokenScopeRead  AccessTokenScope = "read"
+	AccessTokenScopeWrite AccessTokenScope = "write"
+)
+
+// AllValues returns all AccessTokenScope values.
+func (AccessTokenScope) AllValues() []AccessTokenScope {
+	return []AccessTokenScope{
+		AccessTokenScopeRead,
+		AccessTokenScopeWrite,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AccessTokenScope) MarshalText() ([]byte, error) {
+	switch s {
+	case AccessTokenScopeRead:
+		return []byte(s), nil
+	case AccessTokenScopeWrite:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AccessTokenScope) UnmarshalText(data []byte) error {
+	switch AccessTokenScope(data) {
+	case AccessTokenScopeRead:
+		*s = AccessTokenScopeRead
+		return nil
+	case AccessTokenScopeWrite:
+		*s = AccessTokenScopeWrite
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ApplyRules
+type ApplyRules struct {
+	// Save the changes instead of only reporting them.
+	Commit OptBool `json:"commit"`
+}
+
+// GetCommit returns the value of Commit.
+func (s *ApplyRules) GetCommit() OptBool {
+	return s.Commit
+}
+
+// SetCommit sets the value of Commit.
+func (s *ApplyRules) SetCommit(val OptBool) {
+	s.Commit = val
+}
+
+// ApplyRulesNotFound is response for ApplyRules operation.
+type ApplyRulesNotFound struct{}
+
+func (*ApplyRulesNotFound) applyRulesRes() {}
+
+// Ref: #/components/schemas/AuditEntity
+type AuditEntity string
+
+const (
+	AuditEntityPeriod               AuditEntity = "period"
+	AuditEntityEnvelope             AuditEntity = "envelope"
+	AuditEntityTransaction          AuditEntity = "transaction"
+	AuditEntityRecurringTransaction AuditEntity = "recurring_transaction"
+	AuditEntityRule                 AuditEntity = "rule"
+	AuditEntityMember               AuditEntity = "member"
+	AuditEntityInvitation           AuditEntity = "invitation"
+	AuditEntityAccessToken          AuditEntity = "access_token"
+	AuditEntityPeriodSchedule       AuditEntity = "period_schedule"
+	AuditEntityHolidays             AuditEntity = "holidays"
+)
+
+// AllValues returns all AuditEntity values.
+func (AuditEntity) AllValues() []AuditEntity {
+	return []AuditEntity{
+		AuditEntityPeriod,
+		AuditEntityEnvelope,
+		AuditEntityTransaction,
+		AuditEntityRecurringTransaction,
+		AuditEntityRule,
+		AuditEntityMember,
+		AuditEntityInvitation,
+		AuditEntityAccessToken,
+		AuditEntityPeriodSchedule,
+		AuditEntityHolidays,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AuditEntity) MarshalText() ([]byte, error) {
+	switch s {
+	case AuditEntityPeriod:
+		return []byte(s), nil
+	case AuditEntityEnvelope:
+		return []byte(s), nil
+	case AuditEntityTransaction:
+		return []byte(s), nil
+	case AuditEntityRecurringTransaction:
+		return []byte(s), nil
+	case AuditEntityRule:
+		return []byte(s), nil
+	case AuditEntityMember:
+		return []byte(s), nil
+	case AuditEntityInvitation:
+		return []byte(s), nil
+	case AuditEntityAccessToken:
+		return []byte(s), nil
+	case AuditEntityPeriodSchedule:
+		return []byte(s), nil
+	case AuditEntityHolidays:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuditEntity) UnmarshalText(data []byte) error {
+	switch AuditEntity(data) {
+	case AuditEntityPeriod:
+		*s = AuditEntityPeriod
+		return nil
+	case AuditEntityEnvelope:
+		*s = AuditEntityEnvelope
+		return nil
+	case AuditEntityTransaction:
+		*s = AuditEntityTransaction
+		return nil
+	case AuditEntityRecurringTransaction:
+		*s = AuditEntityRecurringTransaction
+		return nil
+	case AuditEntityRule:
+		*s = AuditEntityRule
+		return nil
+	case AuditEntityMember:
+		*s = AuditEntityMember
+		return nil
+	case AuditEntityInvitation:
+		*s = AuditEntityInvitation
+		return nil
+	case AuditEntityAccessToken:
+		*s = AuditEntityAccessToken
+		return nil
+	case AuditEntityPeriodSchedule:
+		*s = AuditEntityPeriodSchedule
+		return nil
+	case AuditEntityHolidays:
+		*s = AuditEntityHolidays
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/AuditEntry
+type AuditEntry struct {
+	ID uuid.UUID `json:"id"`
+	// The user who made the change, absent for changes not made by a user.
+	ActorId  OptUUID          `json:"actorId"`
+	At       time.Time        `json:"at"`
+	Entity   AuditEntity      `json:"entity"`
+	EntityId uuid.UUID        `json:"entityId"`
+	Action   AuditEntryAction `json:"action"`
+	// The entity before the change, absent on create.
+	Before OptAuditEntryBefore `json:"before"`
+	// The entity after the change, absent on delete.
+	After OptAuditEntryAfter `json:"after"`
+}
+
+// GetID returns the value of ID.
+func (s *AuditEntry) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetActorId returns the value of ActorId.
+func (s *AuditEntry) GetActorId() OptUUID {
+	return s.ActorId
+}
+
+// GetAt returns the value of At.
+func (s *AuditEntry) GetAt() time.Time {
+	return s.At
+}
+
+// GetEntity returns the value of Entity.
+func (s *AuditEntry) GetEntity() AuditEntity {
+	return s.Entity
+}
+
+// GetEntityId returns the value of EntityId.
+func (s *AuditEntry) GetEntityId() uuid.UUID {
+	return s.EntityId
+}
+
+// GetAction returns the value of Action.
+func (s *AuditEntry) GetAction() AuditEntryAction {
+	return s.Action
+}
+
+// GetBefore returns the value of Before.
+func (s *AuditEntry) GetBefore() OptAuditEntryBefore {
+	return s.Before
+}
+
+// GetAfter returns the value of After.
+func (s *AuditEntry) GetAfter() OptAuditEntryAfter {
+	return s.After
+}
+
+// SetID sets the value of ID.
+func (s *AuditEntry) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetActorId sets the value of ActorId.
+func (s *AuditEntry) SetActorId(val OptUUID) {
+	s.ActorId = val
+}
+
+// SetAt sets the value of At.
+func (s *AuditEntry) SetAt(val time.Time) {
+	s.At = val
+}
+
+// SetEntity sets the value of Entity.
+func (s *AuditEntry) SetEntity(val AuditEntity) {
+	s.Entity = val
+}
+
+// SetEntityId sets the value of EntityId.
+func (s *AuditEntry) SetEntityId(val uuid.UUID) {
+	s.EntityId = val
+}
+
+// SetAction sets the value of Action.
+func (s *AuditEntry) SetAction(val AuditEntryAction) {
+	s.Action = val
+}
+
+// SetBefore sets the value of Before.
+func (s *AuditEntry) SetBefore(val OptAuditEntryBefore) {
+	s.Before = val
+}
+
+// SetAfter sets the value of After.
+func (s *AuditEntry) SetAfter(val OptAuditEntryAfter) {
+	s.After = val
+}
+
+type AuditEntryAction string
+
+const (
+	AuditEntryActionCreate AuditEntryAction = "create"
+	AuditEntryActionUpdate AuditEntryAction = "update"
+	AuditEntryActionDelete AuditEntryAction = "delete"
+)
+
+// AllValues returns all AuditEntryAction values.
+func (AuditEntryAction) AllValues() []AuditEntryAction {
+	return []AuditEntryAction{
+		AuditEntryActionCreate,
+		AuditEntryActionUpdate,
+		AuditEntryActionDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AuditEntryAction) MarshalText() ([]byte, error) {
+	switch s {
+	case AuditEntryActionCreate:
+		return []byte(s), nil
+	case AuditEntryActionUpdate:
+		return []byte(s), nil
+	case AuditEntryActionDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuditEntryAction) UnmarshalText(data []byte) error {
+	switch AuditEntryAction(data) {
+	case AuditEntryActionCreate:
+		*s = AuditEntryActionCreate
+		return nil
+	case AuditEntryActionUpdate:
+		*s = AuditEntryActionUpdate
+		return nil
+	case AuditEntryActionDelete:
+		*s = AuditEntryActionDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// The entity after the change, absent on delete.
+type AuditEntryAfter map[string]jx.Raw
+
+func (s *AuditEntryAfter) init() AuditEntryAfter {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// The entity before the change, absent on create.
+type AuditEntryBefore map[string]jx.Raw
+
+func (s *AuditEntryBefore) init() AuditEntryBefore {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
 type BearerAuth struct {
 	Token string
 	Roles []string
@@ -38,9 +440,48 @@ func (s *BearerAuth) SetRoles(val []string) {
 	s.Roles = val
 }
 
+// Ref: #/components/schemas/CreateAccessToken
+type CreateAccessToken struct {
+	Name  string           `json:"name"`
+	Scope AccessTokenScope `json:"scope"`
+	// Defaults to 90 days from now, at most one year from now.
+	ExpiresAt OptDateTime `json:"expiresAt"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateAccessToken) GetName() string {
+	return s.Name
+}
+
+// GetScope returns the value of Scope.
+func (s *CreateAccessToken) GetScope() AccessTokenScope {
+	return s.Scope
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *CreateAccessToken) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// SetName sets the value of Name.
+func (s *CreateAccessToken) SetName(val string) {
+	s.Name = val
+}
+
+// SetScope sets the value of Scope.
+func (s *CreateAccessToken) SetScope(val AccessTokenScope) {
+	s.Scope = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *CreateAccessToken) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
 // Ref: #/components/schemas/CreateEnvelope
 type CreateEnvelope struct {
-	Name string `json:"name"`
+	Name           string            `json:"name"`
+	RolloverPolicy OptRolloverPolicy `json:"rolloverPolicy"`
 }
 
 // GetName returns the value of Name.
@@ -48,25 +489,50 @@ func (s *CreateEnvelope) GetName() string {
 	return s.Name
 }
 
+// GetRolloverPolicy returns the value of RolloverPolicy.
+func (s *CreateEnvelope) GetRolloverPolicy() OptRolloverPolicy {
+	return s.RolloverPolicy
+}
+
 // SetName sets the value of Name.
 func (s *CreateEnvelope) SetName(val string) {
 	s.Name = val
 }
 
+// SetRolloverPolicy sets the value of RolloverPolicy.
+func (s *CreateEnvelope) SetRolloverPolicy(val OptRolloverPolicy) {
+	s.RolloverPolicy = val
+}
+
+// Ref: #/components/schemas/CreateInvitation
+type CreateInvitation struct {
+	Role OptRole `json:"role"`
+}
+
+// GetRole returns the value of Role.
+func (s *CreateInvitation) GetRole() OptRole {
+	return s.Role
+}
+
+// SetRole sets the value of Role.
+func (s *CreateInvitation) SetRole(val OptRole) {
+	s.Role = val
+}
+
 // Ref: #/components/schemas/CreatePeriod
 type CreatePeriod struct {
-	StartDate   time.Time `json:"startDate"`
-	EndDate     time.Time `json:"endDate"`
-	TotalBudget int64     `json:"totalBudget"`
+	StartDate   OptDate `json:"startDate"`
+	EndDate     OptDate `json:"endDate"`
+	TotalBudget int64   `json:"totalBudget"`
 }
 
 // GetStartDate returns the value of StartDate.
-func (s *CreatePeriod) GetStartDate() time.Time {
+func (s *CreatePeriod) GetStartDate() OptDate {
 	return s.StartDate
 }
 
 // GetEndDate returns the value of EndDate.
-func (s *CreatePeriod) GetEndDate() time.Time {
+func (s *CreatePeriod) GetEndDate() OptDate {
 	return s.EndDate
 }
 
@@ -76,12 +542,12 @@ func (s *CreatePeriod) GetTotalBudget() int64 {
 }
 
 // SetStartDate sets the value of StartDate.
-func (s *CreatePeriod) SetStartDate(val time.Time) {
+func (s *CreatePeriod) SetStartDate(val OptDate) {
 	s.StartDate = val
 }
 
 // SetEndDate sets the value of EndDate.
-func (s *CreatePeriod) SetEndDate(val time.Time) {
+func (s *CreatePeriod) SetEndDate(val OptDate) {
 	s.EndDate = val
 }
 
@@ -90,295 +556,2539 @@ func (s *CreatePeriod) SetTotalBudget(val int64) {
 	s.TotalBudget = val
 }
 
-// Ref: #/components/schemas/CreateTransaction
-type CreateTransaction struct {
-	// The budget bucket this transaction belongs to.
+// Ref: #/components/schemas/CreateRecurringTransaction
+type CreateRecurringTransaction struct {
 	EnvelopeId uuid.UUID `json:"envelopeId"`
 	// Transaction amount in currency cents. Use negative values for expenses.
-	Amount      int64       `json:"amount"`
-	Description OptString   `json:"description"`
-	Date        OptDateTime `json:"date"`
-	// Analytics tag (what was bought).
-	Category OptString `json:"category"`
+	Amount      int64              `json:"amount"`
+	Description OptString          `json:"description"`
+	Category    OptString          `json:"category"`
+	Schedule    RecurrenceSchedule `json:"schedule"`
 }
 
 // GetEnvelopeId returns the value of EnvelopeId.
-func (s *CreateTransaction) GetEnvelopeId() uuid.UUID {
+func (s *CreateRecurringTransaction) GetEnvelopeId() uuid.UUID {
 	return s.EnvelopeId
 }
 
 // GetAmount returns the value of Amount.
-func (s *CreateTransaction) GetAmount() int64 {
+func (s *CreateRecurringTransaction) GetAmount() int64 {
 	return s.Amount
 }
 
 // GetDescription returns the value of Description.
-func (s *CreateTransaction) GetDescription() OptString {
+func (s *CreateRecurringTransaction) GetDescription() OptString {
 	return s.Description
 }
 
-// GetDate returns the value of Date.
-func (s *CreateTransaction) GetDate() OptDateTime {
-	return s.Date
-}
-
 // GetCategory returns the value of Category.
-func (s *CreateTransaction) GetCategory() OptString {
+func (s *CreateRecurringTransaction) GetCategory() OptString {
 	return s.Category
 }
 
+// GetSchedule returns the value of Schedule.
+func (s *CreateRecurringTransaction) GetSchedule() RecurrenceSchedule {
+	return s.Schedule
+}
+
 // SetEnvelopeId sets the value of EnvelopeId.
-func (s *CreateTransaction) SetEnvelopeId(val uuid.UUID) {
+func (s *CreateRecurringTransaction) SetEnvelopeId(val uuid.UUID) {
 	s.EnvelopeId = val
 }
 
 // SetAmount sets the value of Amount.
-func (s *CreateTransaction) SetAmount(val int64) {
+func (s *CreateRecurringTransaction) SetAmount(val int64) {
 	s.Amount = val
 }
 
 // SetDescription sets the value of Description.
-func (s *CreateTransaction) SetDescription(val OptString) {
+func (s *CreateRecurringTransaction) SetDescription(val OptString) {
 	s.Description = val
 }
 
-// SetDate sets the value of Date.
-func (s *CreateTransaction) SetDate(val OptDateTime) {
-	s.Date = val
-}
-
 // SetCategory sets the value of Category.
-func (s *CreateTransaction) SetCategory(val OptString) {
+func (s *CreateRecurringTransaction) SetCategory(val OptString) {
 	s.Category = val
 }
 
-// CreateTransactionBadRequest is response for CreateTransaction operation.
-type CreateTransactionBadRequest struct{}
-
-func (*CreateTransactionBadRequest) createTransactionRes() {}
-
-// DeleteEnvelopeNoContent is response for DeleteEnvelope operation.
-type DeleteEnvelopeNoContent struct{}
-
-func (*DeleteEnvelopeNoContent) deleteEnvelopeRes() {}
-
-// DeleteEnvelopeNotFound is response for DeleteEnvelope operation.
-type DeleteEnvelopeNotFound struct{}
-
-func (*DeleteEnvelopeNotFound) deleteEnvelopeRes() {}
-
-// DeletePeriodNoContent is response for DeletePeriod operation.
-type DeletePeriodNoContent struct{}
-
-func (*DeletePeriodNoContent) deletePeriodRes() {}
+// SetSchedule sets the value of Schedule.
+func (s *CreateRecurringTransaction) SetSchedule(val RecurrenceSchedule) {
+	s.Schedule = val
+}
 
-// DeletePeriodNotFound is response for DeletePeriod operation.
-type DeletePeriodNotFound struct{}
+// Ref: #/components/schemas/CreateRule
+type CreateRule struct {
+	Name               string         `json:"name"`
+	Priority           OptInt         `json:"priority"`
+	DescriptionPattern OptString      `json:"descriptionPattern"`
+	PatternType        OptPatternType `json:"patternType"`
+	MinAmount          OptInt64       `json:"minAmount"`
+	MaxAmount          OptInt64       `json:"maxAmount"`
+	Weekdays           []int          `json:"weekdays"`
+	Category           OptString      `json:"category"`
+	EnvelopeId         OptUUID        `json:"envelopeId"`
+}
 
-func (*DeletePeriodNotFound) deletePeriodRes() {}
+// GetName returns the value of Name.
+func (s *CreateRule) GetName() string {
+	return s.Name
+}
 
-// DeleteTransactionNoContent is response for DeleteTransaction operation.
-type DeleteTransactionNoContent struct{}
+// GetPriority returns the value of Priority.
+func (s *CreateRule) GetPriority() OptInt {
+	return s.Priority
+}
 
-func (*DeleteTransactionNoContent) deleteTransactionRes() {}
+// GetDescriptionPattern returns the value of DescriptionPattern.
+func (s *CreateRule) GetDescriptionPattern() OptString {
+	return s.DescriptionPattern
+}
 
-// DeleteTransactionNotFound is response for DeleteTransaction operation.
-type DeleteTransactionNotFound struct{}
+// GetPatternType returns the value of PatternType.
+func (s *CreateRule) GetPatternType() OptPatternType {
+	return s.PatternType
+}
 
-func (*DeleteTransactionNotFound) deleteTransactionRes() {}
+// GetMinAmount returns the value of MinAmount.
+func (s *CreateRule) GetMinAmount() OptInt64 {
+	return s.MinAmount
+}
 
-// Ref: #/components/schemas/Envelope
-type Envelope struct {
-	ID   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
+// GetMaxAmount returns the value of MaxAmount.
+func (s *CreateRule) GetMaxAmount() OptInt64 {
+	return s.MaxAmount
 }
 
-// GetID returns the value of ID.
-func (s *Envelope) GetID() uuid.UUID {
-	return s.ID
+// GetWeekdays returns the value of Weekdays.
+func (s *CreateRule) GetWeekdays() []int {
+	return s.Weekdays
 }
 
-// GetName returns the value of Name.
-func (s *Envelope) GetName() string {
-	return s.Name
+// GetCategory returns the value of Category.
+func (s *CreateRule) GetCategory() OptString {
+	return s.Category
 }
 
-// SetID sets the value of ID.
-func (s *Envelope) SetID(val uuid.UUID) {
-	s.ID = val
+// GetEnvelopeId returns the value of EnvelopeId.
+func (s *CreateRule) GetEnvelopeId() OptUUID {
+	return s.EnvelopeId
 }
 
 // SetName sets the value of Name.
-func (s *Envelope) SetName(val string) {
+func (s *CreateRule) SetName(val string) {
 	s.Name = val
 }
 
-func (*Envelope) getEnvelopeRes()    {}
-func (*Envelope) updateEnvelopeRes() {}
+// SetPriority sets the value of Priority.
+func (s *CreateRule) SetPriority(val OptInt) {
+	s.Priority = val
+}
 
-// Ref: #/components/schemas/EnvelopeSummary
-type EnvelopeSummary struct {
-	// The ID of the budget bucket.
-	EnvelopeId uuid.UUID `json:"envelopeId"`
-	// The name of the budget bucket (e.g., 'groceries').
-	EnvelopeName string `json:"envelopeName"`
-	// Total funding allocated to this envelope in cents.
-	Amount int64 `json:"amount"`
-	// Total amount spent from this envelope in cents.
-	Spent int64 `json:"spent"`
-	// Current balance of the envelope in cents.
-	Remaining int64 `json:"remaining"`
+// SetDescriptionPattern sets the value of DescriptionPattern.
+func (s *CreateRule) SetDescriptionPattern(val OptString) {
+	s.DescriptionPattern = val
 }
 
-// GetEnvelopeId returns the value of EnvelopeId.
-func (s *EnvelopeSummary) GetEnvelopeId() uuid.UUID {
-	return s.EnvelopeId
+// SetPatternType sets the value of PatternType.
+func (s *CreateRule) SetPatternType(val OptPatternType) {
+	s.PatternType = val
 }
 
-// GetEnvelopeName returns the value of EnvelopeName.
-func (s *EnvelopeSummary) GetEnvelopeName() string {
-	return s.EnvelopeName
+// SetMinAmount sets the value of MinAmount.
+func (s *CreateRule) SetMinAmount(val OptInt64) {
+	s.MinAmount = val
 }
 
-// GetAmount returns the value of Amount.
-func (s *EnvelopeSummary) GetAmount() int64 {
-	return s.Amount
+// SetMaxAmount sets the value of MaxAmount.
+func (s *CreateRule) SetMaxAmount(val OptInt64) {
+	s.MaxAmount = val
 }
 
-// GetSpent returns the value of Spent.
-func (s *EnvelopeSummary) GetSpent() int64 {
-	return s.Spent
+// SetWeekdays sets the value of Weekdays.
+func (s *CreateRule) SetWeekdays(val []int) {
+	s.Weekdays = val
 }
 
-// GetRemaining returns the value of Remaining.
-func (s *EnvelopeSummary) GetRemaining() int64 {
-	return s.Remaining
+// SetCategory sets the value of Category.
+func (s *CreateRule) SetCategory(val OptString) {
+	s.Category = val
 }
 
 // SetEnvelopeId sets the value of EnvelopeId.
-func (s *EnvelopeSummary) SetEnvelopeId(val uuid.UUID) {
+func (s *CreateRule) SetEnvelopeId(val OptUUID) {
 	s.EnvelopeId = val
 }
 
-// SetEnvelopeName sets the value of EnvelopeName.
-func (s *EnvelopeSummary) SetEnvelopeName(val string) {
-	s.EnvelopeName = val
+// Ref: #/components/schemas/CreateTransaction
+type CreateTransaction struct {
+	// The budget bucket this transaction belongs to. When omitted, it is set by rules or the period
+	// default envelope.
+	EnvelopeId OptUUID `json:"envelopeId"`
+	// Transaction amount in currency cents. Use negative values for expenses.
+	Amount      int64       `json:"amount"`
+	Description OptString   `json:"description"`
+	Date        OptDateTime `json:"date"`
+	// Analytics tag (what was bought). When omitted, it is set by rules.
+	Category OptString `json:"category"`
+	// Spreads the transaction across envelopes. Line amounts must sum up to the transaction amount.
+	Splits []TransactionSplit `json:"splits"`
+}
+
+// GetEnvelopeId returns the value of EnvelopeId.
+func (s *CreateTransaction) GetEnvelopeId() OptUUID {
+	return s.EnvelopeId
+}
+
+// GetAmount returns the value of Amount.
+func (s *CreateTransaction) GetAmount() int64 {
+	return s.Amount
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateTransaction) GetDescription() OptString {
+	return s.Description
+}
+
+// GetDate returns the value of Date.
+func (s *CreateTransaction) GetDate() OptDateTime {
+	return s.Date
+}
+
+// GetCategory returns the value of Category.
+func (s *CreateTransaction) GetCategory() OptString {
+	return s.Category
+}
+
+// GetSplits returns the value of Splits.
+func (s *CreateTransaction) GetSplits() []TransactionSplit {
+	return s.Splits
+}
+
+// SetEnvelopeId sets the value of EnvelopeId.
+func (s *CreateTransaction) SetEnvelopeId(val OptUUID) {
+	s.EnvelopeId = val
 }
 
 // SetAmount sets the value of Amount.
-func (s *EnvelopeSummary) SetAmount(val int64) {
+func (s *CreateTransaction) SetAmount(val int64) {
 	s.Amount = val
 }
 
-// SetSpent sets the value of Spent.
-func (s *EnvelopeSummary) SetSpent(val int64) {
-	s.Spent = val
+// SetDescription sets the value of Description.
+func (s *CreateTransaction) SetDescription(val OptString) {
+	s.Description = val
 }
 
-// SetRemaining sets the value of Remaining.
-func (s *EnvelopeSummary) SetRemaining(val int64) {
-	s.Remaining = val
+// SetDate sets the value of Date.
+func (s *CreateTransaction) SetDate(val OptDateTime) {
+	s.Date = val
 }
 
-// Ref: #/components/schemas/Error
-type Error struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+// SetCategory sets the value of Category.
+func (s *CreateTransaction) SetCategory(val OptString) {
+	s.Category = val
 }
 
-// GetCode returns the value of Code.
-func (s *Error) GetCode() int {
-	return s.Code
+// SetSplits sets the value of Splits.
+func (s *CreateTransaction) SetSplits(val []TransactionSplit) {
+	s.Splits = val
 }
 
-// GetMessage returns the value of Message.
-func (s *Error) GetMessage() string {
-	return s.Message
+// CreateTransactionBadRequest is response for CreateTransaction operation.
+type CreateTransactionBadRequest struct{}
+
+func (*CreateTransactionBadRequest) createTransactionRes() {}
+
+// Ref: #/components/schemas/CreateTransfer
+type CreateTransfer struct {
+	PeriodId uuid.UUID `json:"periodId"`
+	// The budget bucket funds are taken from.
+	FromEnvelopeId uuid.UUID `json:"fromEnvelopeId"`
+	// The budget bucket funds are moved to.
+	ToEnvelopeId uuid.UUID `json:"toEnvelopeId"`
+	// Amount to move in currency cents.
+	Amount int64 `json:"amount"`
 }
 
-// SetCode sets the value of Code.
-func (s *Error) SetCode(val int) {
-	s.Code = val
+// GetPeriodId returns the value of PeriodId.
+func (s *CreateTransfer) GetPeriodId() uuid.UUID {
+	return s.PeriodId
 }
 
-// SetMessage sets the value of Message.
-func (s *Error) SetMessage(val string) {
-	s.Message = val
+// GetFromEnvelopeId returns the value of FromEnvelopeId.
+func (s *CreateTransfer) GetFromEnvelopeId() uuid.UUID {
+	return s.FromEnvelopeId
 }
 
-// ErrorStatusCode wraps Error with StatusCode.
-type ErrorStatusCode struct {
-	StatusCode int
-	Response   Error
+// GetToEnvelopeId returns the value of ToEnvelopeId.
+func (s *CreateTransfer) GetToEnvelopeId() uuid.UUID {
+	return s.ToEnvelopeId
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *ErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// GetAmount returns the value of Amount.
+func (s *CreateTransfer) GetAmount() int64 {
+	return s.Amount
 }
 
-// GetResponse returns the value of Response.
-func (s *ErrorStatusCode) GetResponse() Error {
-	return s.Response
+// SetPeriodId sets the value of PeriodId.
+func (s *CreateTransfer) SetPeriodId(val uuid.UUID) {
+	s.PeriodId = val
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *ErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// SetFromEnvelopeId sets the value of FromEnvelopeId.
+func (s *CreateTransfer) SetFromEnvelopeId(val uuid.UUID) {
+	s.FromEnvelopeId = val
+}
+
+// SetToEnvelopeId sets the value of ToEnvelopeId.
+func (s *CreateTransfer) SetToEnvelopeId(val uuid.UUID) {
+	s.ToEnvelopeId = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *CreateTransfer) SetAmount(val int64) {
+	s.Amount = val
+}
+
+// Merged schema.
+// Ref: #/components/schemas/CreatedAccessToken
+type CreatedAccessToken struct {
+	ID         uuid.UUID        `json:"id"`
+	Name       string           `json:"name"`
+	Scope      AccessTokenScope `json:"scope"`
+	CreatedAt  time.Time        `json:"createdAt"`
+	ExpiresAt  time.Time        `json:"expiresAt"`
+	LastUsedAt OptDateTime      `json:"lastUsedAt"`
+	// The secret to send as bearer token. Shown only once.
+	Token string `json:"token"`
+}
+
+// GetID returns the value of ID.
+func (s *CreatedAccessToken) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *CreatedAccessToken) GetName() string {
+	return s.Name
+}
+
+// GetScope returns the value of Scope.
+func (s *CreatedAccessToken) GetScope() AccessTokenScope {
+	return s.Scope
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CreatedAccessToken) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *CreatedAccessToken) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *CreatedAccessToken) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetToken returns the value of Token.
+func (s *CreatedAccessToken) GetToken() string {
+	return s.Token
+}
+
+// SetID sets the value of ID.
+func (s *CreatedAccessToken) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *CreatedAccessToken) SetName(val string) {
+	s.Name = val
+}
+
+// SetScope sets the value of Scope.
+func (s *CreatedAccessToken) SetScope(val AccessTokenScope) {
+	s.Scope = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CreatedAccessToken) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *CreatedAccessToken) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *CreatedAccessToken) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetToken sets the value of Token.
+func (s *CreatedAccessToken) SetToken(val string) {
+	s.Token = val
+}
+
+// How to read a CSV statement. Columns are referenced by their header name.
+// Ref: #/components/schemas/CsvMapping
+type CsvMapping struct {
+	Delimiter OptString `json:"delimiter"`
+	// Number of lines before the header row to skip.
+	SkipRows   OptInt `json:"skipRows"`
+	DateColumn string `json:"dateColumn"`
+	// Date format using YYYY, YY, MM, DD, HH, mm and ss placeholders.
+	DateFormat OptString `json:"dateFormat"`
+	// Required with `signed` and `inverted` sign conventions.
+	AmountColumn OptString `json:"amountColumn"`
+	// Required with the `debit_credit` sign convention.
+	DebitColumn OptString `json:"debitColumn"`
+	// Required with the `debit_credit` sign convention.
+	CreditColumn      OptString                     `json:"creditColumn"`
+	DescriptionColumn OptString                     `json:"descriptionColumn"`
+	DecimalSeparator  OptCsvMappingDecimalSeparator `json:"decimalSeparator"`
+	// * `signed` - amount column is negative for expenses
+	// * `inverted` - amount column is positive for expenses
+	// * `debit_credit` - expenses and income are in separate unsigned columns.
+	SignConvention OptCsvMappingSignConvention `json:"signConvention"`
+}
+
+// GetDelimiter returns the value of Delimiter.
+func (s *CsvMapping) GetDelimiter() OptString {
+	return s.Delimiter
+}
+
+// GetSkipRows returns the value of SkipRows.
+func (s *CsvMapping) GetSkipRows() OptInt {
+	return s.SkipRows
+}
+
+// GetDateColumn returns the value of DateColumn.
+func (s *CsvMapping) GetDateColumn() string {
+	return s.DateColumn
+}
+
+// GetDateFormat returns the value of DateFormat.
+func (s *CsvMapping) GetDateFormat() OptString {
+	return s.DateFormat
+}
+
+// GetAmountColumn returns the value of AmountColumn.
+func (s *CsvMapping) GetAmountColumn() OptString {
+	return s.AmountColumn
+}
+
+// GetDebitColumn returns the value of DebitColumn.
+func (s *CsvMapping) GetDebitColumn() OptString {
+	return s.DebitColumn
+}
+
+// GetCreditColumn returns the value of CreditColumn.
+func (s *CsvMapping) GetCreditColumn() OptString {
+	return s.CreditColumn
+}
+
+// GetDescriptionColumn returns the value of DescriptionColumn.
+func (s *CsvMapping) GetDescriptionColumn() OptString {
+	return s.DescriptionColumn
+}
+
+// GetDecimalSeparator returns the value of DecimalSeparator.
+func (s *CsvMapping) GetDecimalSeparator() OptCsvMappingDecimalSeparator {
+	return s.DecimalSeparator
+}
+
+// GetSignConvention returns the value of SignConvention.
+func (s *CsvMapping) GetSignConvention() OptCsvMappingSignConvention {
+	return s.SignConvention
+}
+
+// SetDelimiter sets the value of Delimiter.
+func (s *CsvMapping) SetDelimiter(val OptString) {
+	s.Delimiter = val
+}
+
+// SetSkipRows sets the value of SkipRows.
+func (s *CsvMapping) SetSkipRows(val OptInt) {
+	s.SkipRows = val
+}
+
+// SetDateColumn sets the value of DateColumn.
+func (s *CsvMapping) SetDateColumn(val string) {
+	s.DateColumn = val
+}
+
+// SetDateFormat sets the value of DateFormat.
+func (s *CsvMapping) SetDateFormat(val OptString) {
+	s.DateFormat = val
+}
+
+// SetAmountColumn sets the value of AmountColumn.
+func (s *CsvMapping) SetAmountColumn(val OptString) {
+	s.AmountColumn = val
+}
+
+// SetDebitColumn sets the value of DebitColumn.
+func (s *CsvMapping) SetDebitColumn(val OptString) {
+	s.DebitColumn = val
+}
+
+// SetCreditColumn sets the value of CreditColumn.
+func (s *CsvMapping) SetCreditColumn(val OptString) {
+	s.CreditColumn = val
+}
+
+// SetDescriptionColumn sets the value of DescriptionColumn.
+func (s *CsvMapping) SetDescriptionColumn(val OptString) {
+	s.DescriptionColumn = val
+}
+
+// SetDecimalSeparator sets the value of DecimalSeparator.
+func (s *CsvMapping) SetDecimalSeparator(val OptCsvMappingDecimalSeparator) {
+	s.DecimalSeparator = val
+}
+
+// SetSignConvention sets the value of SignConvention.
+func (s *CsvMapping) SetSignConvention(val OptCsvMappingSignConvention) {
+	s.SignConvention = val
+}
+
+type CsvMappingDecimalSeparator string
+
+const (
+	CsvMappingDecimalSeparator_Dot CsvMappingDecimalSeparator = "."
+	CsvMappingDecimalSeparator_    CsvMappingDecimalSeparator = ","
+)
+
+// AllValues returns all CsvMappingDecimalSeparator values.
+func (CsvMappingDecimalSeparator) AllValues() []CsvMappingDecimalSeparator {
+	return []CsvMappingDecimalSeparator{
+		CsvMappingDecimalSeparator_Dot,
+		CsvMappingDecimalSeparator_,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CsvMappingDecimalSeparator) MarshalText() ([]byte, error) {
+	switch s {
+	case CsvMappingDecimalSeparator_Dot:
+		return []byte(s), nil
+	case CsvMappingDecimalSeparator_:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CsvMappingDecimalSeparator) UnmarshalText(data []byte) error {
+	switch CsvMappingDecimalSeparator(data) {
+	case CsvMappingDecimalSeparator_Dot:
+		*s = CsvMappingDecimalSeparator_Dot
+		return nil
+	case CsvMappingDecimalSeparator_:
+		*s = CsvMappingDecimalSeparator_
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// * `signed` - amount column is negative for expenses
+// * `inverted` - amount column is positive for expenses
+// * `debit_credit` - expenses and income are in separate unsigned columns.
+type CsvMappingSignConvention string
+
+const (
+	CsvMappingSignConventionSigned      CsvMappingSignConvention = "signed"
+	CsvMappingSignConventionInverted    CsvMappingSignConvention = "inverted"
+	CsvMappingSignConventionDebitCredit CsvMappingSignConvention = "debit_credit"
+)
+
+// AllValues returns all CsvMappingSignConvention values.
+func (CsvMappingSignConvention) AllValues() []CsvMappingSignConvention {
+	return []CsvMappingSignConvention{
+		CsvMappingSignConventionSigned,
+		CsvMappingSignConventionInverted,
+		CsvMappingSignConventionDebitCredit,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CsvMappingSignConvention) MarshalText() ([]byte, error) {
+	switch s {
+	case CsvMappingSignConventionSigned:
+		return []byte(s), nil
+	case CsvMappingSignConventionInverted:
+		return []byte(s), nil
+	case CsvMappingSignConventionDebitCredit:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CsvMappingSignConvention) UnmarshalText(data []byte) error {
+	switch CsvMappingSignConvention(data) {
+	case CsvMappingSignConventionSigned:
+		*s = CsvMappingSignConventionSigned
+		return nil
+	case CsvMappingSignConventionInverted:
+		*s = CsvMappingSignConventionInverted
+		return nil
+	case CsvMappingSignConventionDebitCredit:
+		*s = CsvMappingSignConventionDebitCredit
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// DeleteEnvelopeNoContent is response for DeleteEnvelope operation.
+type DeleteEnvelopeNoContent struct{}
+
+func (*DeleteEnvelopeNoContent) deleteEnvelopeRes() {}
+
+// DeleteEnvelopeNotFound is response for DeleteEnvelope operation.
+type DeleteEnvelopeNotFound struct{}
+
+func (*DeleteEnvelopeNotFound) deleteEnvelopeRes() {}
+
+// DeletePeriodNoContent is response for DeletePeriod operation.
+type DeletePeriodNoContent struct{}
+
+func (*DeletePeriodNoContent) deletePeriodRes() {}
+
+// DeletePeriodNotFound is response for DeletePeriod operation.
+type DeletePeriodNotFound struct{}
+
+func (*DeletePeriodNotFound) deletePeriodRes() {}
+
+// DeleteRecurringTransactionNoContent is response for DeleteRecurringTransaction operation.
+type DeleteRecurringTransactionNoContent struct{}
+
+func (*DeleteRecurringTransactionNoContent) deleteRecurringTransactionRes() {}
+
+// DeleteRecurringTransactionNotFound is response for DeleteRecurringTransaction operation.
+type DeleteRecurringTransactionNotFound struct{}
+
+func (*DeleteRecurringTransactionNotFound) deleteRecurringTransactionRes() {}
+
+// DeleteRuleNoContent is response for DeleteRule operation.
+type DeleteRuleNoContent struct{}
+
+func (*DeleteRuleNoContent) deleteRuleRes() {}
+
+// DeleteRuleNotFound is response for DeleteRule operation.
+type DeleteRuleNotFound struct{}
+
+func (*DeleteRuleNotFound) deleteRuleRes() {}
+
+// DeleteTransactionNoContent is response for DeleteTransaction operation.
+type DeleteTransactionNoContent struct{}
+
+func (*DeleteTransactionNoContent) deleteTransactionRes() {}
+
+// DeleteTransactionNotFound is response for DeleteTransaction operation.
+type DeleteTransactionNotFound struct{}
+
+func (*DeleteTransactionNotFound) deleteTransactionRes() {}
+
+// Ref: #/components/schemas/Envelope
+type Envelope struct {
+	ID             uuid.UUID      `json:"id"`
+	Name           string         `json:"name"`
+	RolloverPolicy RolloverPolicy `json:"rolloverPolicy"`
+	// When the envelope was moved to the trash.
+	DeletedAt OptDateTime `json:"deletedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *Envelope) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Envelope) GetName() string {
+	return s.Name
+}
+
+// GetRolloverPolicy returns the value of RolloverPolicy.
+func (s *Envelope) GetRolloverPolicy() RolloverPolicy {
+	return s.RolloverPolicy
+}
+
+// GetDeletedAt returns the value of DeletedAt.
+func (s *Envelope) GetDeletedAt() OptDateTime {
+	return s.DeletedAt
+}
+
+// SetID sets the value of ID.
+func (s *Envelope) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Envelope) SetName(val string) {
+	s.Name = val
+}
+
+// SetRolloverPolicy sets the value of RolloverPolicy.
+func (s *Envelope) SetRolloverPolicy(val RolloverPolicy) {
+	s.RolloverPolicy = val
+}
+
+// SetDeletedAt sets the value of DeletedAt.
+func (s *Envelope) SetDeletedAt(val OptDateTime) {
+	s.DeletedAt = val
+}
+
+func (*Envelope) restoreEnvelopeRes() {}
+
+// EnvelopeHeaders wraps Envelope with response headers.
+type EnvelopeHeaders struct {
+	ETag     OptString
+	Response Envelope
+}
+
+// GetETag returns the value of ETag.
+func (s *EnvelopeHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *EnvelopeHeaders) GetResponse() Envelope {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *EnvelopeHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *EnvelopeHeaders) SetResponse(val Envelope) {
+	s.Response = val
+}
+
+func (*EnvelopeHeaders) getEnvelopeRes()    {}
+func (*EnvelopeHeaders) updateEnvelopeRes() {}
+
+// Ref: #/components/schemas/EnvelopeSummary
+type EnvelopeSummary struct {
+	// The ID of the budget bucket.
+	EnvelopeId uuid.UUID `json:"envelopeId"`
+	// The name of the budget bucket (e.g., 'groceries').
+	EnvelopeName string `json:"envelopeName"`
+	// Total funding allocated to this envelope in cents.
+	Amount int64 `json:"amount"`
+	// Total amount spent from this envelope in cents.
+	Spent int64 `json:"spent"`
+	// Balance carried over from the previous period in cents, according to the envelope rollover policy.
+	CarriedOver int64 `json:"carriedOver"`
+	// Current balance of the envelope in cents, including the carried over balance.
+	Remaining int64 `json:"remaining"`
+	// Projected balance of the envelope at the end of the period in cents.
+	ProjectedEndingBalance OptInt64 `json:"projectedEndingBalance"`
+}
+
+// GetEnvelopeId returns the value of EnvelopeId.
+func (s *EnvelopeSummary) GetEnvelopeId() uuid.UUID {
+	return s.EnvelopeId
+}
+
+// GetEnvelopeName returns the value of EnvelopeName.
+func (s *EnvelopeSummary) GetEnvelopeName() string {
+	return s.EnvelopeName
+}
+
+// GetAmount returns the value of Amount.
+func (s *EnvelopeSummary) GetAmount() int64 {
+	return s.Amount
+}
+
+// GetSpent returns the value of Spent.
+func (s *EnvelopeSummary) GetSpent() int64 {
+	return s.Spent
+}
+
+// GetCarriedOver returns the value of CarriedOver.
+func (s *EnvelopeSummary) GetCarriedOver() int64 {
+	return s.CarriedOver
+}
+
+// GetRemaining returns the value of Remaining.
+func (s *EnvelopeSummary) GetRemaining() int64 {
+	return s.Remaining
+}
+
+// GetProjectedEndingBalance returns the value of ProjectedEndingBalance.
+func (s *EnvelopeSummary) GetProjectedEndingBalance() OptInt64 {
+	return s.ProjectedEndingBalance
+}
+
+// SetEnvelopeId sets the value of EnvelopeId.
+func (s *EnvelopeSummary) SetEnvelopeId(val uuid.UUID) {
+	s.EnvelopeId = val
+}
+
+// SetEnvelopeName sets the value of EnvelopeName.
+func (s *EnvelopeSummary) SetEnvelopeName(val string) {
+	s.EnvelopeName = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *EnvelopeSummary) SetAmount(val int64) {
+	s.Amount = val
+}
+
+// SetSpent sets the value of Spent.
+func (s *EnvelopeSummary) SetSpent(val int64) {
+	s.Spent = val
+}
+
+// SetCarriedOver sets the value of CarriedOver.
+func (s *EnvelopeSummary) SetCarriedOver(val int64) {
+	s.CarriedOver = val
+}
+
+// SetRemaining sets the value of Remaining.
+func (s *EnvelopeSummary) SetRemaining(val int64) {
+	s.Remaining = val
+}
+
+// SetProjectedEndingBalance sets the value of ProjectedEndingBalance.
+func (s *EnvelopeSummary) SetProjectedEndingBalance(val OptInt64) {
+	s.ProjectedEndingBalance = val
+}
+
+// Ref: #/components/schemas/Error
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *Error) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *Error) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val string) {
+	s.Message = val
+}
+
+// ErrorStatusCode wraps Error with StatusCode.
+type ErrorStatusCode struct {
+	StatusCode int
+	Response   Error
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorStatusCode) GetResponse() Error {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
+}
+
+// GetEnvelopeNotFound is response for GetEnvelope operation.
+type GetEnvelopeNotFound struct{}
+
+func (*GetEnvelopeNotFound) getEnvelopeRes() {}
+
+// GetMemberSpendingNotFound is response for GetMemberSpending operation.
+type GetMemberSpendingNotFound struct{}
+
+func (*GetMemberSpendingNotFound) getMemberSpendingRes() {}
+
+type GetMemberSpendingOKApplicationJSON []MemberSpending
+
+func (*GetMemberSpendingOKApplicationJSON) getMemberSpendingRes() {}
+
+// GetPeriodNotFound is response for GetPeriod operation.
+type GetPeriodNotFound struct{}
+
+func (*GetPeriodNotFound) getPeriodRes() {}
+
+// GetRecurringTransactionNotFound is response for GetRecurringTransaction operation.
+type GetRecurringTransactionNotFound struct{}
+
+func (*GetRecurringTransactionNotFound) getRecurringTransactionRes() {}
+
+// GetRuleNotFound is response for GetRule operation.
+type GetRuleNotFound struct{}
+
+func (*GetRuleNotFound) getRuleRes() {}
+
+// GetTransactionNotFound is response for GetTransaction operation.
+type GetTransactionNotFound struct{}
+
+func (*GetTransactionNotFound) getTransactionRes() {}
+
+// Ref: #/components/schemas/Holiday
+type Holiday struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+	// Whether the holiday recurs on the same date every year.
+	Yearly bool `json:"yearly"`
+}
+
+// GetDate returns the value of Date.
+func (s *Holiday) GetDate() time.Time {
+	return s.Date
+}
+
+// GetName returns the value of Name.
+func (s *Holiday) GetName() string {
+	return s.Name
+}
+
+// GetYearly returns the value of Yearly.
+func (s *Holiday) GetYearly() bool {
+	return s.Yearly
+}
+
+// SetDate sets the value of Date.
+func (s *Holiday) SetDate(val time.Time) {
+	s.Date = val
+}
+
+// SetName sets the value of Name.
+func (s *Holiday) SetName(val string) {
+	s.Name = val
+}
+
+// SetYearly sets the value of Yearly.
+func (s *Holiday) SetYearly(val bool) {
+	s.Yearly = val
+}
+
+// Ref: #/components/schemas/Household
+type Household struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Members []Member  `json:"members"`
+}
+
+// GetID returns the value of ID.
+func (s *Household) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Household) GetName() string {
+	return s.Name
+}
+
+// GetMembers returns the value of Members.
+func (s *Household) GetMembers() []Member {
+	return s.Members
+}
+
+// SetID sets the value of ID.
+func (s *Household) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Household) SetName(val string) {
+	s.Name = val
+}
+
+// SetMembers sets the value of Members.
+func (s *Household) SetMembers(val []Member) {
+	s.Members = val
+}
+
+// Ref: #/components/schemas/ImportHolidays
+type ImportHolidays struct {
+	// Raw contents of the iCalendar file.
+	Content string `json:"content"`
+}
+
+// GetContent returns the value of Content.
+func (s *ImportHolidays) GetContent() string {
+	return s.Content
+}
+
+// SetContent sets the value of Content.
+func (s *ImportHolidays) SetContent(val string) {
+	s.Content = val
+}
+
+// Ref: #/components/schemas/ImportRequest
+type ImportRequest struct {
+	// * `csv` - CSV export, read according to `csv` column mapping
+	// * `ofx`, `qfx` - Open Financial Exchange statement (SGML or XML)
+	// * `camt053` - ISO 20022 CAMT.053 XML statement.
+	Format OptImportRequestFormat `json:"format"`
+	// Raw contents of the statement file.
+	Content string `json:"content"`
+	// Required for the `csv` format.
+	Csv OptCsvMapping `json:"csv"`
+	// The budget bucket imported transactions are recorded in. When omitted, it is set by rules or the
+	// period default envelope.
+	EnvelopeId OptUUID `json:"envelopeId"`
+	// Analytics tag applied to imported transactions. When omitted, it is set by rules.
+	Category OptString `json:"category"`
+	// Record new rows instead of only previewing the import.
+	Commit OptBool `json:"commit"`
+}
+
+// GetFormat returns the value of Format.
+func (s *ImportRequest) GetFormat() OptImportRequestFormat {
+	return s.Format
+}
+
+// GetContent returns the value of Content.
+func (s *ImportRequest) GetContent() string {
+	return s.Content
+}
+
+// GetCsv returns the value of Csv.
+func (s *ImportRequest) GetCsv() OptCsvMapping {
+	return s.Csv
+}
+
+// GetEnvelopeId returns the value of EnvelopeId.
+func (s *ImportRequest) GetEnvelopeId() OptUUID {
+	return s.EnvelopeId
+}
+
+// GetCategory returns the value of Category.
+func (s *ImportRequest) GetCategory() OptString {
+	return s.Category
+}
+
+// GetCommit returns the value of Commit.
+func (s *ImportRequest) GetCommit() OptBool {
+	return s.Commit
+}
+
+// SetFormat sets the value of Format.
+func (s *ImportRequest) SetFormat(val OptImportRequestFormat) {
+	s.Format = val
+}
+
+// SetContent sets the value of Content.
+func (s *ImportRequest) SetContent(val string) {
+	s.Content = val
+}
+
+// SetCsv sets the value of Csv.
+func (s *ImportRequest) SetCsv(val OptCsvMapping) {
+	s.Csv = val
+}
+
+// SetEnvelopeId sets the value of EnvelopeId.
+func (s *ImportRequest) SetEnvelopeId(val OptUUID) {
+	s.EnvelopeId = val
+}
+
+// SetCategory sets the value of Category.
+func (s *ImportRequest) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetCommit sets the value of Commit.
+func (s *ImportRequest) SetCommit(val OptBool) {
+	s.Commit = val
+}
+
+// * `csv` - CSV export, read according to `csv` column mapping
+// * `ofx`, `qfx` - Open Financial Exchange statement (SGML or XML)
+// * `camt053` - ISO 20022 CAMT.053 XML statement.
+type ImportRequestFormat string
+
+const (
+	ImportRequestFormatCsv     ImportRequestFormat = "csv"
+	ImportRequestFormatOfx     ImportRequestFormat = "ofx"
+	ImportRequestFormatQfx     ImportRequestFormat = "qfx"
+	ImportRequestFormatCamt053 ImportRequestFormat = "camt053"
+)
+
+// AllValues returns all ImportRequestFormat values.
+func (ImportRequestFormat) AllValues() []ImportRequestFormat {
+	return []ImportRequestFormat{
+		ImportRequestFormatCsv,
+		ImportRequestFormatOfx,
+		ImportRequestFormatQfx,
+		ImportRequestFormatCamt053,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ImportRequestFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case ImportRequestFormatCsv:
+		return []byte(s), nil
+	case ImportRequestFormatOfx:
+		return []byte(s), nil
+	case ImportRequestFormatQfx:
+		return []byte(s), nil
+	case ImportRequestFormatCamt053:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ImportRequestFormat) UnmarshalText(data []byte) error {
+	switch ImportRequestFormat(data) {
+	case ImportRequestFormatCsv:
+		*s = ImportRequestFormatCsv
+		return nil
+	case ImportRequestFormatOfx:
+		*s = ImportRequestFormatOfx
+		return nil
+	case ImportRequestFormatQfx:
+		*s = ImportRequestFormatQfx
+		return nil
+	case ImportRequestFormatCamt053:
+		*s = ImportRequestFormatCamt053
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ImportResult
+type ImportResult struct {
+	// Whether new rows were recorded.
+	Committed      bool        `json:"committed"`
+	NewCount       int         `json:"newCount"`
+	DuplicateCount int         `json:"duplicateCount"`
+	InvalidCount   int         `json:"invalidCount"`
+	Rows           []ImportRow `json:"rows"`
+}
+
+// GetCommitted returns the value of Committed.
+func (s *ImportResult) GetCommitted() bool {
+	return s.Committed
+}
+
+// GetNewCount returns the value of NewCount.
+func (s *ImportResult) GetNewCount() int {
+	return s.NewCount
+}
+
+// GetDuplicateCount returns the value of DuplicateCount.
+func (s *ImportResult) GetDuplicateCount() int {
+	return s.DuplicateCount
+}
+
+// GetInvalidCount returns the value of InvalidCount.
+func (s *ImportResult) GetInvalidCount() int {
+	return s.InvalidCount
+}
+
+// GetRows returns the value of Rows.
+func (s *ImportResult) GetRows() []ImportRow {
+	return s.Rows
+}
+
+// SetCommitted sets the value of Committed.
+func (s *ImportResult) SetCommitted(val bool) {
+	s.Committed = val
+}
+
+// SetNewCount sets the value of NewCount.
+func (s *ImportResult) SetNewCount(val int) {
+	s.NewCount = val
+}
+
+// SetDuplicateCount sets the value of DuplicateCount.
+func (s *ImportResult) SetDuplicateCount(val int) {
+	s.DuplicateCount = val
+}
+
+// SetInvalidCount sets the value of InvalidCount.
+func (s *ImportResult) SetInvalidCount(val int) {
+	s.InvalidCount = val
+}
+
+// SetRows sets the value of Rows.
+func (s *ImportResult) SetRows(val []ImportRow) {
+	s.Rows = val
+}
+
+// Ref: #/components/schemas/ImportRow
+type ImportRow struct {
+	// Line number (CSV) or entry number (OFX, CAMT.053) in the statement, starting from 1.
+	Line        int             `json:"line"`
+	Status      ImportRowStatus `json:"status"`
+	Transaction OptTransaction  `json:"transaction"`
+	// Why the row is invalid.
+	Error OptString `json:"error"`
+}
+
+// GetLine returns the value of Line.
+func (s *ImportRow) GetLine() int {
+	return s.Line
+}
+
+// GetStatus returns the value of Status.
+func (s *ImportRow) GetStatus() ImportRowStatus {
+	return s.Status
+}
+
+// GetTransaction returns the value of Transaction.
+func (s *ImportRow) GetTransaction() OptTransaction {
+	return s.Transaction
+}
+
+// GetError returns the value of Error.
+func (s *ImportRow) GetError() OptString {
+	return s.Error
+}
+
+// SetLine sets the value of Line.
+func (s *ImportRow) SetLine(val int) {
+	s.Line = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ImportRow) SetStatus(val ImportRowStatus) {
+	s.Status = val
+}
+
+// SetTransaction sets the value of Transaction.
+func (s *ImportRow) SetTransaction(val OptTransaction) {
+	s.Transaction = val
+}
+
+// SetError sets the value of Error.
+func (s *ImportRow) SetError(val OptString) {
+	s.Error = val
+}
+
+type ImportRowStatus string
+
+const (
+	ImportRowStatusNew       ImportRowStatus = "new"
+	ImportRowStatusDuplicate ImportRowStatus = "duplicate"
+	ImportRowStatusInvalid   ImportRowStatus = "invalid"
+)
+
+// AllValues returns all ImportRowStatus values.
+func (ImportRowStatus) AllValues() []ImportRowStatus {
+	return []ImportRowStatus{
+		ImportRowStatusNew,
+		ImportRowStatusDuplicate,
+		ImportRowStatusInvalid,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ImportRowStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ImportRowStatusNew:
+		return []byte(s), nil
+	case ImportRowStatusDuplicate:
+		return []byte(s), nil
+	case ImportRowStatusInvalid:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ImportRowStatus) UnmarshalText(data []byte) error {
+	switch ImportRowStatus(data) {
+	case ImportRowStatusNew:
+		*s = ImportRowStatusNew
+		return nil
+	case ImportRowStatusDuplicate:
+		*s = ImportRowStatusDuplicate
+		return nil
+	case ImportRowStatusInvalid:
+		*s = ImportRowStatusInvalid
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Invitation
+type Invitation struct {
+	// One-time code to pass to joinHousehold.
+	Code      string    `json:"code"`
+	Role      Role      `json:"role"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// GetCode returns the value of Code.
+func (s *Invitation) GetCode() string {
+	return s.Code
+}
+
+// GetRole returns the value of Role.
+func (s *Invitation) GetRole() Role {
+	return s.Role
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *Invitation) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetCode sets the value of Code.
+func (s *Invitation) SetCode(val string) {
+	s.Code = val
+}
+
+// SetRole sets the value of Role.
+func (s *Invitation) SetRole(val Role) {
+	s.Role = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *Invitation) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// Ref: #/components/schemas/JoinHousehold
+type JoinHousehold struct {
+	Code string `json:"code"`
+}
+
+// GetCode returns the value of Code.
+func (s *JoinHousehold) GetCode() string {
+	return s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *JoinHousehold) SetCode(val string) {
+	s.Code = val
+}
+
+// ListTransactionsOKHeaders wraps []Transaction with response headers.
+type ListTransactionsOKHeaders struct {
+	XNextCursor OptString
+	Response    []Transaction
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListTransactionsOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetResponse returns the value of Response.
+func (s *ListTransactionsOKHeaders) GetResponse() []Transaction {
+	return s.Response
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListTransactionsOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListTransactionsOKHeaders) SetResponse(val []Transaction) {
+	s.Response = val
+}
+
+type ListTransactionsSign string
+
+const (
+	ListTransactionsSignIncome  ListTransactionsSign = "income"
+	ListTransactionsSignExpense ListTransactionsSign = "expense"
+)
+
+// AllValues returns all ListTransactionsSign values.
+func (ListTransactionsSign) AllValues() []ListTransactionsSign {
+	return []ListTransactionsSign{
+		ListTransactionsSignIncome,
+		ListTransactionsSignExpense,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListTransactionsSign) MarshalText() ([]byte, error) {
+	switch s {
+	case ListTransactionsSignIncome:
+		return []byte(s), nil
+	case ListTransactionsSignExpense:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListTransactionsSign) UnmarshalText(data []byte) error {
+	switch ListTransactionsSign(data) {
+	case ListTransactionsSignIncome:
+		*s = ListTransactionsSignIncome
+		return nil
+	case ListTransactionsSignExpense:
+		*s = ListTransactionsSignExpense
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ListTransactionsSort string
+
+const (
+	ListTransactionsSortDateDesc   ListTransactionsSort = "date_desc"
+	ListTransactionsSortDateAsc    ListTransactionsSort = "date_asc"
+	ListTransactionsSortAmountDesc ListTransactionsSort = "amount_desc"
+	ListTransactionsSortAmountAsc  ListTransactionsSort = "amount_asc"
+)
+
+// AllValues returns all ListTransactionsSort values.
+func (ListTransactionsSort) AllValues() []ListTransactionsSort {
+	return []ListTransactionsSort{
+		ListTransactionsSortDateDesc,
+		ListTransactionsSortDateAsc,
+		ListTransactionsSortAmountDesc,
+		ListTransactionsSortAmountAsc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListTransactionsSort) MarshalText() ([]byte, error) {
+	switch s {
+	case ListTransactionsSortDateDesc:
+		return []byte(s), nil
+	case ListTransactionsSortDateAsc:
+		return []byte(s), nil
+	case ListTransactionsSortAmountDesc:
+		return []byte(s), nil
+	case ListTransactionsSortAmountAsc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListTransactionsSort) UnmarshalText(data []byte) error {
+	switch ListTransactionsSort(data) {
+	case ListTransactionsSortDateDesc:
+		*s = ListTransactionsSortDateDesc
+		return nil
+	case ListTransactionsSortDateAsc:
+		*s = ListTransactionsSortDateAsc
+		return nil
+	case ListTransactionsSortAmountDesc:
+		*s = ListTransactionsSortAmountDesc
+		return nil
+	case ListTransactionsSortAmountAsc:
+		*s = ListTransactionsSortAmountAsc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Member
+type Member struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email OptString `json:"email"`
+	Role  Role      `json:"role"`
+	// Envelopes a contributor may record transactions in.
+	EnvelopeIds []uuid.UUID `json:"envelopeIds"`
+}
+
+// GetID returns the value of ID.
+func (s *Member) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Member) GetName() string {
+	return s.Name
+}
+
+// GetEmail returns the value of Email.
+func (s *Member) GetEmail() OptString {
+	return s.Email
+}
+
+// GetRole returns the value of Role.
+func (s *Member) GetRole() Role {
+	return s.Role
+}
+
+// GetEnvelopeIds returns the value of EnvelopeIds.
+func (s *Member) GetEnvelopeIds() []uuid.UUID {
+	return s.EnvelopeIds
+}
+
+// SetID sets the value of ID.
+func (s *Member) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Member) SetName(val string) {
+	s.Name = val
+}
+
+// SetEmail sets the value of Email.
+func (s *Member) SetEmail(val OptString) {
+	s.Email = val
+}
+
+// SetRole sets the value of Role.
+func (s *Member) SetRole(val Role) {
+	s.Role = val
+}
+
+// SetEnvelopeIds sets the value of EnvelopeIds.
+func (s *Member) SetEnvelopeIds(val []uuid.UUID) {
+	s.EnvelopeIds = val
+}
+
+func (*Member) updateMemberRes() {}
+
+// Ref: #/components/schemas/MemberSpending
+type MemberSpending struct {
+	// Absent for former members and transactions recorded before attribution existed.
+	UserId   OptUUID   `json:"userId"`
+	UserName OptString `json:"userName"`
+	// Whether this sums up the transactions of users who have left the household.
+	FormerMember OptBool `json:"formerMember"`
+	// Total expenses in currency cents.
+	Spent int64 `json:"spent"`
+	// Total income in currency cents.
+	Income           int64 `json:"income"`
+	TransactionCount int   `json:"transactionCount"`
+}
+
+// GetUserId returns the value of UserId.
+func (s *MemberSpending) GetUserId() OptUUID {
+	return s.UserId
+}
+
+// GetUserName returns the value of UserName.
+func (s *MemberSpending) GetUserName() OptString {
+	return s.UserName
+}
+
+// GetFormerMember returns the value of FormerMember.
+func (s *MemberSpending) GetFormerMember() OptBool {
+	return s.FormerMember
+}
+
+// GetSpent returns the value of Spent.
+func (s *MemberSpending) GetSpent() int64 {
+	return s.Spent
+}
+
+// GetIncome returns the value of Income.
+func (s *MemberSpending) GetIncome() int64 {
+	return s.Income
+}
+
+// GetTransactionCount returns the value of TransactionCount.
+func (s *MemberSpending) GetTransactionCount() int {
+	return s.TransactionCount
+}
+
+// SetUserId sets the value of UserId.
+func (s *MemberSpending) SetUserId(val OptUUID) {
+	s.UserId = val
+}
+
+// SetUserName sets the value of UserName.
+func (s *MemberSpending) SetUserName(val OptString) {
+	s.UserName = val
+}
+
+// SetFormerMember sets the value of FormerMember.
+func (s *MemberSpending) SetFormerMember(val OptBool) {
+	s.FormerMember = val
+}
+
+// SetSpent sets the value of Spent.
+func (s *MemberSpending) SetSpent(val int64) {
+	s.Spent = val
+}
+
+// SetIncome sets the value of Income.
+func (s *MemberSpending) SetIncome(val int64) {
+	s.Income = val
+}
+
+// SetTransactionCount sets the value of TransactionCount.
+func (s *MemberSpending) SetTransactionCount(val int) {
+	s.TransactionCount = val
+}
+
+// NewOptAuditEntity returns new OptAuditEntity with value set to v.
+func NewOptAuditEntity(v AuditEntity) OptAuditEntity {
+	return OptAuditEntity{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAuditEntity is optional AuditEntity.
+type OptAuditEntity struct {
+	Value AuditEntity
+	Set   bool
+}
+
+// IsSet returns true if OptAuditEntity was set.
+func (o OptAuditEntity) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuditEntity) Reset() {
+	var v AuditEntity
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAuditEntity) SetTo(v AuditEntity) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditEntity) Get() (v AuditEntity, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditEntity) Or(d AuditEntity) AuditEntity {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAuditEntryAfter returns new OptAuditEntryAfter with value set to v.
+func NewOptAuditEntryAfter(v AuditEntryAfter) OptAuditEntryAfter {
+	return OptAuditEntryAfter{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAuditEntryAfter is optional AuditEntryAfter.
+type OptAuditEntryAfter struct {
+	Value AuditEntryAfter
+	Set   bool
+}
+
+// IsSet returns true if OptAuditEntryAfter was set.
+func (o OptAuditEntryAfter) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuditEntryAfter) Reset() {
+	var v AuditEntryAfter
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAuditEntryAfter) SetTo(v AuditEntryAfter) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditEntryAfter) Get() (v AuditEntryAfter, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditEntryAfter) Or(d AuditEntryAfter) AuditEntryAfter {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAuditEntryBefore returns new OptAuditEntryBefore with value set to v.
+func NewOptAuditEntryBefore(v AuditEntryBefore) OptAuditEntryBefore {
+	return OptAuditEntryBefore{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAuditEntryBefore is optional AuditEntryBefore.
+type OptAuditEntryBefore struct {
+	Value AuditEntryBefore
+	Set   bool
+}
+
+// IsSet returns true if OptAuditEntryBefore was set.
+func (o OptAuditEntryBefore) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuditEntryBefore) Reset() {
+	var v AuditEntryBefore
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAuditEntryBefore) SetTo(v AuditEntryBefore) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditEntryBefore) Get() (v AuditEntryBefore, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditEntryBefore) Or(d AuditEntryBefore) AuditEntryBefore {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateInvitation returns new OptCreateInvitation with value set to v.
+func NewOptCreateInvitation(v CreateInvitation) OptCreateInvitation {
+	return OptCreateInvitation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateInvitation is optional CreateInvitation.
+type OptCreateInvitation struct {
+	Value CreateInvitation
+	Set   bool
+}
+
+// IsSet returns true if OptCreateInvitation was set.
+func (o OptCreateInvitation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateInvitation) Reset() {
+	var v CreateInvitation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateInvitation) SetTo(v CreateInvitation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateInvitation) Get() (v CreateInvitation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateInvitation) Or(d CreateInvitation) CreateInvitation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCsvMapping returns new OptCsvMapping with value set to v.
+func NewOptCsvMapping(v CsvMapping) OptCsvMapping {
+	return OptCsvMapping{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCsvMapping is optional CsvMapping.
+type OptCsvMapping struct {
+	Value CsvMapping
+	Set   bool
+}
+
+// IsSet returns true if OptCsvMapping was set.
+func (o OptCsvMapping) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCsvMapping) Reset() {
+	var v CsvMapping
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCsvMapping) SetTo(v CsvMapping) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCsvMapping) Get() (v CsvMapping, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCsvMapping) Or(d CsvMapping) CsvMapping {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCsvMappingDecimalSeparator returns new OptCsvMappingDecimalSeparator with value set to v.
+func NewOptCsvMappingDecimalSeparator(v CsvMappingDecimalSeparator) OptCsvMappingDecimalSeparator {
+	return OptCsvMappingDecimalSeparator{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCsvMappingDecimalSeparator is optional CsvMappingDecimalSeparator.
+type OptCsvMappingDecimalSeparator struct {
+	Value CsvMappingDecimalSeparator
+	Set   bool
+}
+
+// IsSet returns true if OptCsvMappingDecimalSeparator was set.
+func (o OptCsvMappingDecimalSeparator) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCsvMappingDecimalSeparator) Reset() {
+	var v CsvMappingDecimalSeparator
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCsvMappingDecimalSeparator) SetTo(v CsvMappingDecimalSeparator) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCsvMappingDecimalSeparator) Get() (v CsvMappingDecimalSeparator, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCsvMappingDecimalSeparator) Or(d CsvMappingDecimalSeparator) CsvMappingDecimalSeparator {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCsvMappingSignConvention returns new OptCsvMappingSignConvention with value set to v.
+func NewOptCsvMappingSignConvention(v CsvMappingSignConvention) OptCsvMappingSignConvention {
+	return OptCsvMappingSignConvention{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCsvMappingSignConvention is optional CsvMappingSignConvention.
+type OptCsvMappingSignConvention struct {
+	Value CsvMappingSignConvention
+	Set   bool
+}
+
+// IsSet returns true if OptCsvMappingSignConvention was set.
+func (o OptCsvMappingSignConvention) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCsvMappingSignConvention) Reset() {
+	var v CsvMappingSignConvention
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCsvMappingSignConvention) SetTo(v CsvMappingSignConvention) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCsvMappingSignConvention) Get() (v CsvMappingSignConvention, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCsvMappingSignConvention) Or(d CsvMappingSignConvention) CsvMappingSignConvention {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptImportRequestFormat returns new OptImportRequestFormat with value set to v.
+func NewOptImportRequestFormat(v ImportRequestFormat) OptImportRequestFormat {
+	return OptImportRequestFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptImportRequestFormat is optional ImportRequestFormat.
+type OptImportRequestFormat struct {
+	Value ImportRequestFormat
+	Set   bool
+}
+
+// IsSet returns true if OptImportRequestFormat was set.
+func (o OptImportRequestFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptImportRequestFormat) Reset() {
+	var v ImportRequestFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptImportRequestFormat) SetTo(v ImportRequestFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptImportRequestFormat) Get() (v ImportRequestFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptImportRequestFormat) Or(d ImportRequestFormat) ImportRequestFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListTransactionsSign returns new OptListTransactionsSign with value set to v.
+func NewOptListTransactionsSign(v ListTransactionsSign) OptListTransactionsSign {
+	return OptListTransactionsSign{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListTransactionsSign is optional ListTransactionsSign.
+type OptListTransactionsSign struct {
+	Value ListTransactionsSign
+	Set   bool
+}
+
+// IsSet returns true if OptListTransactionsSign was set.
+func (o OptListTransactionsSign) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListTransactionsSign) Reset() {
+	var v ListTransactionsSign
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListTransactionsSign) SetTo(v ListTransactionsSign) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListTransactionsSign) Get() (v ListTransactionsSign, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListTransactionsSign) Or(d ListTransactionsSign) ListTransactionsSign {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListTransactionsSort returns new OptListTransactionsSort with value set to v.
+func NewOptListTransactionsSort(v ListTransactionsSort) OptListTransactionsSort {
+	return OptListTransactionsSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListTransactionsSort is optional ListTransactionsSort.
+type OptListTransactionsSort struct {
+	Value ListTransactionsSort
+	Set   bool
+}
+
+// IsSet returns true if OptListTransactionsSort was set.
+func (o OptListTransactionsSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListTransactionsSort) Reset() {
+	var v ListTransactionsSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListTransactionsSort) SetTo(v ListTransactionsSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListTransactionsSort) Get() (v ListTransactionsSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListTransactionsSort) Or(d ListTransactionsSort) ListTransactionsSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt64 returns new OptNilInt64 with value set to v.
+func NewOptNilInt64(v int64) OptNilInt64 {
+	return OptNilInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt64 is optional nullable int64.
+type OptNilInt64 struct {
+	Value int64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt64 was set.
+func (o OptNilInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt64) SetTo(v int64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt64) Get() (v int64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUUID returns new OptNilUUID with value set to v.
+func NewOptNilUUID(v uuid.UUID) OptNilUUID {
+	return OptNilUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUUID is optional nullable uuid.UUID.
+type OptNilUUID struct {
+	Value uuid.UUID
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUUID was set.
+func (o OptNilUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUUID) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUUID) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v uuid.UUID
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPatternType returns new OptPatternType with value set to v.
+func NewOptPatternType(v PatternType) OptPatternType {
+	return OptPatternType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPatternType is optional PatternType.
+type OptPatternType struct {
+	Value PatternType
+	Set   bool
+}
+
+// IsSet returns true if OptPatternType was set.
+func (o OptPatternType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPatternType) Reset() {
+	var v PatternType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPatternType) SetTo(v PatternType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPatternType) Get() (v PatternType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPatternType) Or(d PatternType) PatternType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetResponse sets the value of Response.
-func (s *ErrorStatusCode) SetResponse(val Error) {
-	s.Response = val
+// NewOptPeriodScheduleAdjustment returns new OptPeriodScheduleAdjustment with value set to v.
+func NewOptPeriodScheduleAdjustment(v PeriodScheduleAdjustment) OptPeriodScheduleAdjustment {
+	return OptPeriodScheduleAdjustment{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetEnvelopeNotFound is response for GetEnvelope operation.
-type GetEnvelopeNotFound struct{}
+// OptPeriodScheduleAdjustment is optional PeriodScheduleAdjustment.
+type OptPeriodScheduleAdjustment struct {
+	Value PeriodScheduleAdjustment
+	Set   bool
+}
 
-func (*GetEnvelopeNotFound) getEnvelopeRes() {}
+// IsSet returns true if OptPeriodScheduleAdjustment was set.
+func (o OptPeriodScheduleAdjustment) IsSet() bool { return o.Set }
 
-// GetPeriodNotFound is response for GetPeriod operation.
-type GetPeriodNotFound struct{}
+// Reset unsets value.
+func (o *OptPeriodScheduleAdjustment) Reset() {
+	var v PeriodScheduleAdjustment
+	o.Value = v
+	o.Set = false
+}
 
-func (*GetPeriodNotFound) getPeriodRes() {}
+// SetTo sets value to v.
+func (o *OptPeriodScheduleAdjustment) SetTo(v PeriodScheduleAdjustment) {
+	o.Set = true
+	o.Value = v
+}
 
-// GetTransactionNotFound is response for GetTransaction operation.
-type GetTransactionNotFound struct{}
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPeriodScheduleAdjustment) Get() (v PeriodScheduleAdjustment, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
 
-func (*GetTransactionNotFound) getTransactionRes() {}
+// Or returns value if set, or given parameter if does not.
+func (o OptPeriodScheduleAdjustment) Or(d PeriodScheduleAdjustment) PeriodScheduleAdjustment {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
-// NewOptDate returns new OptDate with value set to v.
-func NewOptDate(v time.Time) OptDate {
-	return OptDate{
+// NewOptPeriodScheduleHolidayCountry returns new OptPeriodScheduleHolidayCountry with value set to v.
+func NewOptPeriodScheduleHolidayCountry(v PeriodScheduleHolidayCountry) OptPeriodScheduleHolidayCountry {
+	return OptPeriodScheduleHolidayCountry{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDate is optional time.Time.
-type OptDate struct {
-	Value time.Time
+// OptPeriodScheduleHolidayCountry is optional PeriodScheduleHolidayCountry.
+type OptPeriodScheduleHolidayCountry struct {
+	Value PeriodScheduleHolidayCountry
 	Set   bool
 }
 
-// IsSet returns true if OptDate was set.
-func (o OptDate) IsSet() bool { return o.Set }
+// IsSet returns true if OptPeriodScheduleHolidayCountry was set.
+func (o OptPeriodScheduleHolidayCountry) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDate) Reset() {
-	var v time.Time
+func (o *OptPeriodScheduleHolidayCountry) Reset() {
+	var v PeriodScheduleHolidayCountry
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDate) SetTo(v time.Time) {
+func (o *OptPeriodScheduleHolidayCountry) SetTo(v PeriodScheduleHolidayCountry) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDate) Get() (v time.Time, ok bool) {
+func (o OptPeriodScheduleHolidayCountry) Get() (v PeriodScheduleHolidayCountry, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -386,45 +3096,45 @@ func (o OptDate) Get() (v time.Time, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDate) Or(d time.Time) time.Time {
+func (o OptPeriodScheduleHolidayCountry) Or(d PeriodScheduleHolidayCountry) PeriodScheduleHolidayCountry {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
+// NewOptRecurrenceSchedule returns new OptRecurrenceSchedule with value set to v.
+func NewOptRecurrenceSchedule(v RecurrenceSchedule) OptRecurrenceSchedule {
+	return OptRecurrenceSchedule{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
+// OptRecurrenceSchedule is optional RecurrenceSchedule.
+type OptRecurrenceSchedule struct {
+	Value RecurrenceSchedule
 	Set   bool
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// IsSet returns true if OptRecurrenceSchedule was set.
+func (o OptRecurrenceSchedule) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
+func (o *OptRecurrenceSchedule) Reset() {
+	var v RecurrenceSchedule
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
+func (o *OptRecurrenceSchedule) SetTo(v RecurrenceSchedule) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
+func (o OptRecurrenceSchedule) Get() (v RecurrenceSchedule, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -432,45 +3142,45 @@ func (o OptDateTime) Get() (v time.Time, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
+func (o OptRecurrenceSchedule) Or(d RecurrenceSchedule) RecurrenceSchedule {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt64 returns new OptInt64 with value set to v.
-func NewOptInt64(v int64) OptInt64 {
-	return OptInt64{
+// NewOptRole returns new OptRole with value set to v.
+func NewOptRole(v Role) OptRole {
+	return OptRole{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt64 is optional int64.
-type OptInt64 struct {
-	Value int64
+// OptRole is optional Role.
+type OptRole struct {
+	Value Role
 	Set   bool
 }
 
-// IsSet returns true if OptInt64 was set.
-func (o OptInt64) IsSet() bool { return o.Set }
+// IsSet returns true if OptRole was set.
+func (o OptRole) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt64) Reset() {
-	var v int64
+func (o *OptRole) Reset() {
+	var v Role
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt64) SetTo(v int64) {
+func (o *OptRole) SetTo(v Role) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt64) Get() (v int64, ok bool) {
+func (o OptRole) Get() (v Role, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -478,62 +3188,45 @@ func (o OptInt64) Get() (v int64, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt64) Or(d int64) int64 {
+func (o OptRole) Or(d Role) Role {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilUUID returns new OptNilUUID with value set to v.
-func NewOptNilUUID(v uuid.UUID) OptNilUUID {
-	return OptNilUUID{
+// NewOptRolloverPolicy returns new OptRolloverPolicy with value set to v.
+func NewOptRolloverPolicy(v RolloverPolicy) OptRolloverPolicy {
+	return OptRolloverPolicy{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilUUID is optional nullable uuid.UUID.
-type OptNilUUID struct {
-	Value uuid.UUID
+// OptRolloverPolicy is optional RolloverPolicy.
+type OptRolloverPolicy struct {
+	Value RolloverPolicy
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilUUID was set.
-func (o OptNilUUID) IsSet() bool { return o.Set }
+// IsSet returns true if OptRolloverPolicy was set.
+func (o OptRolloverPolicy) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilUUID) Reset() {
-	var v uuid.UUID
+func (o *OptRolloverPolicy) Reset() {
+	var v RolloverPolicy
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilUUID) SetTo(v uuid.UUID) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilUUID) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilUUID) SetToNull() {
+func (o *OptRolloverPolicy) SetTo(v RolloverPolicy) {
 	o.Set = true
-	o.Null = true
-	var v uuid.UUID
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptRolloverPolicy) Get() (v RolloverPolicy, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -541,7 +3234,7 @@ func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
+func (o OptRolloverPolicy) Or(d RolloverPolicy) RolloverPolicy {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -594,6 +3287,52 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
+// NewOptTransaction returns new OptTransaction with value set to v.
+func NewOptTransaction(v Transaction) OptTransaction {
+	return OptTransaction{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTransaction is optional Transaction.
+type OptTransaction struct {
+	Value Transaction
+	Set   bool
+}
+
+// IsSet returns true if OptTransaction was set.
+func (o OptTransaction) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTransaction) Reset() {
+	var v Transaction
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTransaction) SetTo(v Transaction) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTransaction) Get() (v Transaction, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTransaction) Or(d Transaction) Transaction {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptUUID returns new OptUUID with value set to v.
 func NewOptUUID(v uuid.UUID) OptUUID {
 	return OptUUID{
@@ -640,6 +3379,78 @@ func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
 	return d
 }
 
+// * `substring` - description contains the pattern
+// * `regex` - description matches the pattern as a regular expression.
+// Ref: #/components/schemas/PatternType
+type PatternType string
+
+const (
+	PatternTypeSubstring PatternType = "substring"
+	PatternTypeRegex     PatternType = "regex"
+)
+
+// AllValues returns all PatternType values.
+func (PatternType) AllValues() []PatternType {
+	return []PatternType{
+		PatternTypeSubstring,
+		PatternTypeRegex,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PatternType) MarshalText() ([]byte, error) {
+	switch s {
+	case PatternTypeSubstring:
+		return []byte(s), nil
+	case PatternTypeRegex:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PatternType) UnmarshalText(data []byte) error {
+	switch PatternType(data) {
+	case PatternTypeSubstring:
+		*s = PatternTypeSubstring
+		return nil
+	case PatternTypeRegex:
+		*s = PatternTypeRegex
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/PeriodGap
+type PeriodGap struct {
+	// First uncovered day, the end date of the period before.
+	StartDate time.Time `json:"startDate"`
+	// Day after the last uncovered one, the start date of the period after.
+	EndDate time.Time `json:"endDate"`
+}
+
+// GetStartDate returns the value of StartDate.
+func (s *PeriodGap) GetStartDate() time.Time {
+	return s.StartDate
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *PeriodGap) GetEndDate() time.Time {
+	return s.EndDate
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *PeriodGap) SetStartDate(val time.Time) {
+	s.StartDate = val
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *PeriodGap) SetEndDate(val time.Time) {
+	s.EndDate = val
+}
+
 // Ref: #/components/schemas/PeriodListItem
 type PeriodListItem struct {
 	ID                uuid.UUID `json:"id"`
@@ -683,9 +3494,295 @@ func (s *PeriodListItem) SetEndDate(val time.Time) {
 	s.EndDate = val
 }
 
-// SetDefaultEnvelopeId sets the value of DefaultEnvelopeId.
-func (s *PeriodListItem) SetDefaultEnvelopeId(val OptUUID) {
-	s.DefaultEnvelopeId = val
+// SetDefaultEnvelopeId sets the value of DefaultEnvelopeId.
+func (s *PeriodListItem) SetDefaultEnvelopeId(val OptUUID) {
+	s.DefaultEnvelopeId = val
+}
+
+// When periods without explicit dates start:
+// * `monthly` - every month on `dayOfMonth` (clamped to the last day of shorter months)
+// * `last_business_day` - on the last business day of every month
+// * `weekly` - every `intervalWeeks` weeks, starting from `anchorDate`
+// * `semi_monthly` - every month on `dayOfMonth` and `secondDayOfMonth`
+// Starts that are not business days, being weekends or holidays, are moved as `adjustment` says.
+// Periods start at midnight in `timeZone`.
+// Ref: #/components/schemas/PeriodSchedule
+type PeriodSchedule struct {
+	Kind             PeriodScheduleKind          `json:"kind"`
+	DayOfMonth       OptInt                      `json:"dayOfMonth"`
+	SecondDayOfMonth OptInt                      `json:"secondDayOfMonth"`
+	IntervalWeeks    OptInt                      `json:"intervalWeeks"`
+	AnchorDate       OptDate                     `json:"anchorDate"`
+	Adjustment       OptPeriodScheduleAdjustment `json:"adjustment"`
+	// Country whose built-in calendar of public holidays applies, in addition to imported holidays.
+	HolidayCountry OptPeriodScheduleHolidayCountry `json:"holidayCountry"`
+	// IANA time zone name.
+	TimeZone string `json:"timeZone"`
+}
+
+// GetKind returns the value of Kind.
+func (s *PeriodSchedule) GetKind() PeriodScheduleKind {
+	return s.Kind
+}
+
+// GetDayOfMonth returns the value of DayOfMonth.
+func (s *PeriodSchedule) GetDayOfMonth() OptInt {
+	return s.DayOfMonth
+}
+
+// GetSecondDayOfMonth returns the value of SecondDayOfMonth.
+func (s *PeriodSchedule) GetSecondDayOfMonth() OptInt {
+	return s.SecondDayOfMonth
+}
+
+// GetIntervalWeeks returns the value of IntervalWeeks.
+func (s *PeriodSchedule) GetIntervalWeeks() OptInt {
+	return s.IntervalWeeks
+}
+
+// GetAnchorDate returns the value of AnchorDate.
+func (s *PeriodSchedule) GetAnchorDate() OptDate {
+	return s.AnchorDate
+}
+
+// GetAdjustment returns the value of Adjustment.
+func (s *PeriodSchedule) GetAdjustment() OptPeriodScheduleAdjustment {
+	return s.Adjustment
+}
+
+// GetHolidayCountry returns the value of HolidayCountry.
+func (s *PeriodSchedule) GetHolidayCountry() OptPeriodScheduleHolidayCountry {
+	return s.HolidayCountry
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *PeriodSchedule) GetTimeZone() string {
+	return s.TimeZone
+}
+
+// SetKind sets the value of Kind.
+func (s *PeriodSchedule) SetKind(val PeriodScheduleKind) {
+	s.Kind = val
+}
+
+// SetDayOfMonth sets the value of DayOfMonth.
+func (s *PeriodSchedule) SetDayOfMonth(val OptInt) {
+	s.DayOfMonth = val
+}
+
+// SetSecondDayOfMonth sets the value of SecondDayOfMonth.
+func (s *PeriodSchedule) SetSecondDayOfMonth(val OptInt) {
+	s.SecondDayOfMonth = val
+}
+
+// SetIntervalWeeks sets the value of IntervalWeeks.
+func (s *PeriodSchedule) SetIntervalWeeks(val OptInt) {
+	s.IntervalWeeks = val
+}
+
+// SetAnchorDate sets the value of AnchorDate.
+func (s *PeriodSchedule) SetAnchorDate(val OptDate) {
+	s.AnchorDate = val
+}
+
+// SetAdjustment sets the value of Adjustment.
+func (s *PeriodSchedule) SetAdjustment(val OptPeriodScheduleAdjustment) {
+	s.Adjustment = val
+}
+
+// SetHolidayCountry sets the value of HolidayCountry.
+func (s *PeriodSchedule) SetHolidayCountry(val OptPeriodScheduleHolidayCountry) {
+	s.HolidayCountry = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *PeriodSchedule) SetTimeZone(val string) {
+	s.TimeZone = val
+}
+
+type PeriodScheduleAdjustment string
+
+const (
+	PeriodScheduleAdjustmentNone      PeriodScheduleAdjustment = "none"
+	PeriodScheduleAdjustmentPreceding PeriodScheduleAdjustment = "preceding"
+	PeriodScheduleAdjustmentFollowing PeriodScheduleAdjustment = "following"
+)
+
+// AllValues returns all PeriodScheduleAdjustment values.
+func (PeriodScheduleAdjustment) AllValues() []PeriodScheduleAdjustment {
+	return []PeriodScheduleAdjustment{
+		PeriodScheduleAdjustmentNone,
+		PeriodScheduleAdjustmentPreceding,
+		PeriodScheduleAdjustmentFollowing,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PeriodScheduleAdjustment) MarshalText() ([]byte, error) {
+	switch s {
+	case PeriodScheduleAdjustmentNone:
+		return []byte(s), nil
+	case PeriodScheduleAdjustmentPreceding:
+		return []byte(s), nil
+	case PeriodScheduleAdjustmentFollowing:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PeriodScheduleAdjustment) UnmarshalText(data []byte) error {
+	switch PeriodScheduleAdjustment(data) {
+	case PeriodScheduleAdjustmentNone:
+		*s = PeriodScheduleAdjustmentNone
+		return nil
+	case PeriodScheduleAdjustmentPreceding:
+		*s = PeriodScheduleAdjustmentPreceding
+		return nil
+	case PeriodScheduleAdjustmentFollowing:
+		*s = PeriodScheduleAdjustmentFollowing
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// PeriodScheduleHeaders wraps PeriodSchedule with response headers.
+type PeriodScheduleHeaders struct {
+	ETag     OptString
+	Response PeriodSchedule
+}
+
+// GetETag returns the value of ETag.
+func (s *PeriodScheduleHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *PeriodScheduleHeaders) GetResponse() PeriodSchedule {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *PeriodScheduleHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *PeriodScheduleHeaders) SetResponse(val PeriodSchedule) {
+	s.Response = val
+}
+
+// Country whose built-in calendar of public holidays applies, in addition to imported holidays.
+type PeriodScheduleHolidayCountry string
+
+const (
+	PeriodScheduleHolidayCountryDE PeriodScheduleHolidayCountry = "DE"
+	PeriodScheduleHolidayCountryFR PeriodScheduleHolidayCountry = "FR"
+	PeriodScheduleHolidayCountryGB PeriodScheduleHolidayCountry = "GB"
+	PeriodScheduleHolidayCountryUS PeriodScheduleHolidayCountry = "US"
+)
+
+// AllValues returns all PeriodScheduleHolidayCountry values.
+func (PeriodScheduleHolidayCountry) AllValues() []PeriodScheduleHolidayCountry {
+	return []PeriodScheduleHolidayCountry{
+		PeriodScheduleHolidayCountryDE,
+		PeriodScheduleHolidayCountryFR,
+		PeriodScheduleHolidayCountryGB,
+		PeriodScheduleHolidayCountryUS,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PeriodScheduleHolidayCountry) MarshalText() ([]byte, error) {
+	switch s {
+	case PeriodScheduleHolidayCountryDE:
+		return []byte(s), nil
+	case PeriodScheduleHolidayCountryFR:
+		return []byte(s), nil
+	case PeriodScheduleHolidayCountryGB:
+		return []byte(s), nil
+	case PeriodScheduleHolidayCountryUS:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PeriodScheduleHolidayCountry) UnmarshalText(data []byte) error {
+	switch PeriodScheduleHolidayCountry(data) {
+	case PeriodScheduleHolidayCountryDE:
+		*s = PeriodScheduleHolidayCountryDE
+		return nil
+	case PeriodScheduleHolidayCountryFR:
+		*s = PeriodScheduleHolidayCountryFR
+		return nil
+	case PeriodScheduleHolidayCountryGB:
+		*s = PeriodScheduleHolidayCountryGB
+		return nil
+	case PeriodScheduleHolidayCountryUS:
+		*s = PeriodScheduleHolidayCountryUS
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PeriodScheduleKind string
+
+const (
+	PeriodScheduleKindMonthly         PeriodScheduleKind = "monthly"
+	PeriodScheduleKindLastBusinessDay PeriodScheduleKind = "last_business_day"
+	PeriodScheduleKindWeekly          PeriodScheduleKind = "weekly"
+	PeriodScheduleKindSemiMonthly     PeriodScheduleKind = "semi_monthly"
+)
+
+// AllValues returns all PeriodScheduleKind values.
+func (PeriodScheduleKind) AllValues() []PeriodScheduleKind {
+	return []PeriodScheduleKind{
+		PeriodScheduleKindMonthly,
+		PeriodScheduleKindLastBusinessDay,
+		PeriodScheduleKindWeekly,
+		PeriodScheduleKindSemiMonthly,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PeriodScheduleKind) MarshalText() ([]byte, error) {
+	switch s {
+	case PeriodScheduleKindMonthly:
+		return []byte(s), nil
+	case PeriodScheduleKindLastBusinessDay:
+		return []byte(s), nil
+	case PeriodScheduleKindWeekly:
+		return []byte(s), nil
+	case PeriodScheduleKindSemiMonthly:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PeriodScheduleKind) UnmarshalText(data []byte) error {
+	switch PeriodScheduleKind(data) {
+	case PeriodScheduleKindMonthly:
+		*s = PeriodScheduleKindMonthly
+		return nil
+	case PeriodScheduleKindLastBusinessDay:
+		*s = PeriodScheduleKindLastBusinessDay
+		return nil
+	case PeriodScheduleKindWeekly:
+		*s = PeriodScheduleKindWeekly
+		return nil
+	case PeriodScheduleKindSemiMonthly:
+		*s = PeriodScheduleKindSemiMonthly
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
 // Ref: #/components/schemas/PeriodSummary
@@ -699,6 +3796,8 @@ type PeriodSummary struct {
 	TotalRemaining int64 `json:"totalRemaining"`
 	// Total spendings for the period in currency cents.
 	TotalSpent int64 `json:"totalSpent"`
+	// Total balance carried over from previous periods in currency cents.
+	TotalCarriedOver int64 `json:"totalCarriedOver"`
 	// Projected balance at the end of the period in currency cents.
 	ProjectedEndingBalance OptInt64          `json:"projectedEndingBalance"`
 	DefaultEnvelopeId      OptUUID           `json:"defaultEnvelopeId"`
@@ -735,6 +3834,11 @@ func (s *PeriodSummary) GetTotalSpent() int64 {
 	return s.TotalSpent
 }
 
+// GetTotalCarriedOver returns the value of TotalCarriedOver.
+func (s *PeriodSummary) GetTotalCarriedOver() int64 {
+	return s.TotalCarriedOver
+}
+
 // GetProjectedEndingBalance returns the value of ProjectedEndingBalance.
 func (s *PeriodSummary) GetProjectedEndingBalance() OptInt64 {
 	return s.ProjectedEndingBalance
@@ -780,6 +3884,11 @@ func (s *PeriodSummary) SetTotalSpent(val int64) {
 	s.TotalSpent = val
 }
 
+// SetTotalCarriedOver sets the value of TotalCarriedOver.
+func (s *PeriodSummary) SetTotalCarriedOver(val int64) {
+	s.TotalCarriedOver = val
+}
+
 // SetProjectedEndingBalance sets the value of ProjectedEndingBalance.
 func (s *PeriodSummary) SetProjectedEndingBalance(val OptInt64) {
 	s.ProjectedEndingBalance = val
@@ -795,8 +3904,598 @@ func (s *PeriodSummary) SetEnvelopeSummaries(val []EnvelopeSummary) {
 	s.EnvelopeSummaries = val
 }
 
-func (*PeriodSummary) getPeriodRes()    {}
-func (*PeriodSummary) updatePeriodRes() {}
+// PeriodSummaryHeaders wraps PeriodSummary with response headers.
+type PeriodSummaryHeaders struct {
+	ETag     OptString
+	Response PeriodSummary
+}
+
+// GetETag returns the value of ETag.
+func (s *PeriodSummaryHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *PeriodSummaryHeaders) GetResponse() PeriodSummary {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *PeriodSummaryHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *PeriodSummaryHeaders) SetResponse(val PeriodSummary) {
+	s.Response = val
+}
+
+func (*PeriodSummaryHeaders) getPeriodRes()    {}
+func (*PeriodSummaryHeaders) updatePeriodRes() {}
+
+// When a recurring transaction occurs:
+// * `monthly` - every month on `dayOfMonth` (clamped to the last day of shorter months)
+// * `weekly` - every `intervalWeeks` weeks, starting from `anchorDate`.
+// Ref: #/components/schemas/RecurrenceSchedule
+type RecurrenceSchedule struct {
+	Kind          RecurrenceScheduleKind `json:"kind"`
+	DayOfMonth    OptInt                 `json:"dayOfMonth"`
+	IntervalWeeks OptInt                 `json:"intervalWeeks"`
+	AnchorDate    OptDate                `json:"anchorDate"`
+}
+
+// GetKind returns the value of Kind.
+func (s *RecurrenceSchedule) GetKind() RecurrenceScheduleKind {
+	return s.Kind
+}
+
+// GetDayOfMonth returns the value of DayOfMonth.
+func (s *RecurrenceSchedule) GetDayOfMonth() OptInt {
+	return s.DayOfMonth
+}
+
+// GetIntervalWeeks returns the value of IntervalWeeks.
+func (s *RecurrenceSchedule) GetIntervalWeeks() OptInt {
+	return s.IntervalWeeks
+}
+
+// GetAnchorDate returns the value of AnchorDate.
+func (s *RecurrenceSchedule) GetAnchorDate() OptDate {
+	return s.AnchorDate
+}
+
+// SetKind sets the value of Kind.
+func (s *RecurrenceSchedule) SetKind(val RecurrenceScheduleKind) {
+	s.Kind = val
+}
+
+// SetDayOfMonth sets the value of DayOfMonth.
+func (s *RecurrenceSchedule) SetDayOfMonth(val OptInt) {
+	s.DayOfMonth = val
+}
+
+// SetIntervalWeeks sets the value of IntervalWeeks.
+func (s *RecurrenceSchedule) SetIntervalWeeks(val OptInt) {
+	s.IntervalWeeks = val
+}
+
+// SetAnchorDate sets the value of AnchorDate.
+func (s *RecurrenceSchedule) SetAnchorDate(val OptDate) {
+	s.AnchorDate = val
+}
+
+type RecurrenceScheduleKind string
+
+const (
+	RecurrenceScheduleKindMonthly RecurrenceScheduleKind = "monthly"
+	RecurrenceScheduleKindWeekly  RecurrenceScheduleKind = "weekly"
+)
+
+// AllValues returns all RecurrenceScheduleKind values.
+func (RecurrenceScheduleKind) AllValues() []RecurrenceScheduleKind {
+	return []RecurrenceScheduleKind{
+		RecurrenceScheduleKindMonthly,
+		RecurrenceScheduleKindWeekly,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RecurrenceScheduleKind) MarshalText() ([]byte, error) {
+	switch s {
+	case RecurrenceScheduleKindMonthly:
+		return []byte(s), nil
+	case RecurrenceScheduleKindWeekly:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RecurrenceScheduleKind) UnmarshalText(data []byte) error {
+	switch RecurrenceScheduleKind(data) {
+	case RecurrenceScheduleKindMonthly:
+		*s = RecurrenceScheduleKindMonthly
+		return nil
+	case RecurrenceScheduleKindWeekly:
+		*s = RecurrenceScheduleKindWeekly
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/RecurringTransaction
+type RecurringTransaction struct {
+	ID uuid.UUID `json:"id"`
+	// The budget bucket generated transactions belong to.
+	EnvelopeId uuid.UUID `json:"envelopeId"`
+	// Transaction amount in currency cents. Positive for income/funding, negative for expenses.
+	Amount      int64  `json:"amount"`
+	Description string `json:"description"`
+	// Analytics tag (what was bought).
+	Category string             `json:"category"`
+	Schedule RecurrenceSchedule `json:"schedule"`
+}
+
+// GetID returns the value of ID.
+func (s *RecurringTransaction) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetEnvelopeId returns the value of EnvelopeId.
+func (s *RecurringTransaction) GetEnvelopeId() uuid.UUID {
+	return s.EnvelopeId
+}
+
+// GetAmount returns the value of Amount.
+func (s *RecurringTransaction) GetAmount() int64 {
+	return s.Amount
+}
+
+// GetDescription returns the value of Description.
+func (s *RecurringTransaction) GetDescription() string {
+	return s.Description
+}
+
+// GetCategory returns the value of Category.
+func (s *RecurringTransaction) GetCategory() string {
+	return s.Category
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *RecurringTransaction) GetSchedule() RecurrenceSchedule {
+	return s.Schedule
+}
+
+// SetID sets the value of ID.
+func (s *RecurringTransaction) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetEnvelopeId sets the value of EnvelopeId.
+func (s *RecurringTransaction) SetEnvelopeId(val uuid.UUID) {
+	s.EnvelopeId = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *RecurringTransaction) SetAmount(val int64) {
+	s.Amount = val
+}
+
+// SetDescription sets the value of Description.
+func (s *RecurringTransaction) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetCategory sets the value of Category.
+func (s *RecurringTransaction) SetCategory(val string) {
+	s.Category = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *RecurringTransaction) SetSchedule(val RecurrenceSchedule) {
+	s.Schedule = val
+}
+
+// RecurringTransactionHeaders wraps RecurringTransaction with response headers.
+type RecurringTransactionHeaders struct {
+	ETag     OptString
+	Response RecurringTransaction
+}
+
+// GetETag returns the value of ETag.
+func (s *RecurringTransactionHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *RecurringTransactionHeaders) GetResponse() RecurringTransaction {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *RecurringTransactionHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *RecurringTransactionHeaders) SetResponse(val RecurringTransaction) {
+	s.Response = val
+}
+
+func (*RecurringTransactionHeaders) getRecurringTransactionRes()    {}
+func (*RecurringTransactionHeaders) updateRecurringTransactionRes() {}
+
+// RestoreEnvelopeNotFound is response for RestoreEnvelope operation.
+type RestoreEnvelopeNotFound struct{}
+
+func (*RestoreEnvelopeNotFound) restoreEnvelopeRes() {}
+
+// RestoreTransactionNotFound is response for RestoreTransaction operation.
+type RestoreTransactionNotFound struct{}
+
+func (*RestoreTransactionNotFound) restoreTransactionRes() {}
+
+// RevokeAccessTokenNoContent is response for RevokeAccessToken operation.
+type RevokeAccessTokenNoContent struct{}
+
+func (*RevokeAccessTokenNoContent) revokeAccessTokenRes() {}
+
+// RevokeAccessTokenNotFound is response for RevokeAccessToken operation.
+type RevokeAccessTokenNotFound struct{}
+
+func (*RevokeAccessTokenNotFound) revokeAccessTokenRes() {}
+
+// Access level of a household member:
+// * `owner` - everything, including managing members
+// * `editor` - everything except managing members
+// * `contributor` - reads everything, records transactions in granted envelopes only
+// * `viewer` - reads everything.
+// Ref: #/components/schemas/Role
+type Role string
+
+const (
+	RoleOwner       Role = "owner"
+	RoleEditor      Role = "editor"
+	RoleContributor Role = "contributor"
+	RoleViewer      Role = "viewer"
+)
+
+// AllValues returns all Role values.
+func (Role) AllValues() []Role {
+	return []Role{
+		RoleOwner,
+		RoleEditor,
+		RoleContributor,
+		RoleViewer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Role) MarshalText() ([]byte, error) {
+	switch s {
+	case RoleOwner:
+		return []byte(s), nil
+	case RoleEditor:
+		return []byte(s), nil
+	case RoleContributor:
+		return []byte(s), nil
+	case RoleViewer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Role) UnmarshalText(data []byte) error {
+	switch Role(data) {
+	case RoleOwner:
+		*s = RoleOwner
+		return nil
+	case RoleEditor:
+		*s = RoleEditor
+		return nil
+	case RoleContributor:
+		*s = RoleContributor
+		return nil
+	case RoleViewer:
+		*s = RoleViewer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// What happens to the envelope balance left at the end of a period:
+// * `reset` - the balance is discarded and the next period starts from zero
+// * `carry_positive` - a surplus is carried over to the next period, a deficit is discarded
+// * `carry_all` - both surplus and deficit are carried over to the next period.
+// Ref: #/components/schemas/RolloverPolicy
+type RolloverPolicy string
+
+const (
+	RolloverPolicyReset         RolloverPolicy = "reset"
+	RolloverPolicyCarryPositive RolloverPolicy = "carry_positive"
+	RolloverPolicyCarryAll      RolloverPolicy = "carry_all"
+)
+
+// AllValues returns all RolloverPolicy values.
+func (RolloverPolicy) AllValues() []RolloverPolicy {
+	return []RolloverPolicy{
+		RolloverPolicyReset,
+		RolloverPolicyCarryPositive,
+		RolloverPolicyCarryAll,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RolloverPolicy) MarshalText() ([]byte, error) {
+	switch s {
+	case RolloverPolicyReset:
+		return []byte(s), nil
+	case RolloverPolicyCarryPositive:
+		return []byte(s), nil
+	case RolloverPolicyCarryAll:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RolloverPolicy) UnmarshalText(data []byte) error {
+	switch RolloverPolicy(data) {
+	case RolloverPolicyReset:
+		*s = RolloverPolicyReset
+		return nil
+	case RolloverPolicyCarryPositive:
+		*s = RolloverPolicyCarryPositive
+		return nil
+	case RolloverPolicyCarryAll:
+		*s = RolloverPolicyCarryAll
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Automatically sets the category and/or envelope of transactions recorded or imported without them.
+// All conditions that are set must match. Rules are evaluated by ascending priority and each field
+// is taken from the first matching rule that sets it.
+// Ref: #/components/schemas/Rule
+type Rule struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// Rules with lower priority are evaluated first.
+	Priority int `json:"priority"`
+	// Case-insensitive pattern the transaction description must match.
+	DescriptionPattern OptString   `json:"descriptionPattern"`
+	PatternType        PatternType `json:"patternType"`
+	// Minimum signed amount in currency cents, inclusive.
+	MinAmount OptInt64 `json:"minAmount"`
+	// Maximum signed amount in currency cents, inclusive.
+	MaxAmount OptInt64 `json:"maxAmount"`
+	// Days of week the transaction must fall on (0 = Sunday), in the time zone of the period schedule.
+	// Empty matches any day.
+	Weekdays []int `json:"weekdays"`
+	// Category to set.
+	Category OptString `json:"category"`
+	// Envelope to set.
+	EnvelopeId OptUUID `json:"envelopeId"`
+}
+
+// GetID returns the value of ID.
+func (s *Rule) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Rule) GetName() string {
+	return s.Name
+}
+
+// GetPriority returns the value of Priority.
+func (s *Rule) GetPriority() int {
+	return s.Priority
+}
+
+// GetDescriptionPattern returns the value of DescriptionPattern.
+func (s *Rule) GetDescriptionPattern() OptString {
+	return s.DescriptionPattern
+}
+
+// GetPatternType returns the value of PatternType.
+func (s *Rule) GetPatternType() PatternType {
+	return s.PatternType
+}
+
+// GetMinAmount returns the value of MinAmount.
+func (s *Rule) GetMinAmount() OptInt64 {
+	return s.MinAmount
+}
+
+// GetMaxAmount returns the value of MaxAmount.
+func (s *Rule) GetMaxAmount() OptInt64 {
+	return s.MaxAmount
+}
+
+// GetWeekdays returns the value of Weekdays.
+func (s *Rule) GetWeekdays() []int {
+	return s.Weekdays
+}
+
+// GetCategory returns the value of Category.
+func (s *Rule) GetCategory() OptString {
+	return s.Category
+}
+
+// GetEnvelopeId returns the value of EnvelopeId.
+func (s *Rule) GetEnvelopeId() OptUUID {
+	return s.EnvelopeId
+}
+
+// SetID sets the value of ID.
+func (s *Rule) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Rule) SetName(val string) {
+	s.Name = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *Rule) SetPriority(val int) {
+	s.Priority = val
+}
+
+// SetDescriptionPattern sets the value of DescriptionPattern.
+func (s *Rule) SetDescriptionPattern(val OptString) {
+	s.DescriptionPattern = val
+}
+
+// SetPatternType sets the value of PatternType.
+func (s *Rule) SetPatternType(val PatternType) {
+	s.PatternType = val
+}
+
+// SetMinAmount sets the value of MinAmount.
+func (s *Rule) SetMinAmount(val OptInt64) {
+	s.MinAmount = val
+}
+
+// SetMaxAmount sets the value of MaxAmount.
+func (s *Rule) SetMaxAmount(val OptInt64) {
+	s.MaxAmount = val
+}
+
+// SetWeekdays sets the value of Weekdays.
+func (s *Rule) SetWeekdays(val []int) {
+	s.Weekdays = val
+}
+
+// SetCategory sets the value of Category.
+func (s *Rule) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetEnvelopeId sets the value of EnvelopeId.
+func (s *Rule) SetEnvelopeId(val OptUUID) {
+	s.EnvelopeId = val
+}
+
+// Ref: #/components/schemas/RuleApplication
+type RuleApplication struct {
+	// Whether the changes were saved.
+	Committed bool         `json:"committed"`
+	Changes   []RuleChange `json:"changes"`
+}
+
+// GetCommitted returns the value of Committed.
+func (s *RuleApplication) GetCommitted() bool {
+	return s.Committed
+}
+
+// GetChanges returns the value of Changes.
+func (s *RuleApplication) GetChanges() []RuleChange {
+	return s.Changes
+}
+
+// SetCommitted sets the value of Committed.
+func (s *RuleApplication) SetCommitted(val bool) {
+	s.Committed = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *RuleApplication) SetChanges(val []RuleChange) {
+	s.Changes = val
+}
+
+func (*RuleApplication) applyRulesRes() {}
+
+// Ref: #/components/schemas/RuleChange
+type RuleChange struct {
+	Before Transaction `json:"before"`
+	After  Transaction `json:"after"`
+}
+
+// GetBefore returns the value of Before.
+func (s *RuleChange) GetBefore() Transaction {
+	return s.Before
+}
+
+// GetAfter returns the value of After.
+func (s *RuleChange) GetAfter() Transaction {
+	return s.After
+}
+
+// SetBefore sets the value of Before.
+func (s *RuleChange) SetBefore(val Transaction) {
+	s.Before = val
+}
+
+// SetAfter sets the value of After.
+func (s *RuleChange) SetAfter(val Transaction) {
+	s.After = val
+}
+
+// RuleHeaders wraps Rule with response headers.
+type RuleHeaders struct {
+	ETag     OptString
+	Response Rule
+}
+
+// GetETag returns the value of ETag.
+func (s *RuleHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *RuleHeaders) GetResponse() Rule {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *RuleHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *RuleHeaders) SetResponse(val Rule) {
+	s.Response = val
+}
+
+func (*RuleHeaders) getRuleRes()    {}
+func (*RuleHeaders) updateRuleRes() {}
+
+// Half-open range of character (Unicode code point) offsets.
+// Ref: #/components/schemas/TextRange
+type TextRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// GetStart returns the value of Start.
+func (s *TextRange) GetStart() int {
+	return s.Start
+}
+
+// GetEnd returns the value of End.
+func (s *TextRange) GetEnd() int {
+	return s.End
+}
+
+// SetStart sets the value of Start.
+func (s *TextRange) SetStart(val int) {
+	s.Start = val
+}
+
+// SetEnd sets the value of End.
+func (s *TextRange) SetEnd(val int) {
+	s.End = val
+}
 
 // Ref: #/components/schemas/Transaction
 type Transaction struct {
@@ -810,6 +4509,18 @@ type Transaction struct {
 	Date        time.Time `json:"date"`
 	// Analytics tag (what was bought).
 	Category OptString `json:"category"`
+	// Set when the transaction is one leg of an inter-envelope transfer.
+	TransferId OptUUID `json:"transferId"`
+	// Bank-assigned unique reference of imported transactions (OFX FITID, CAMT.053 AcctSvcrRef).
+	ExternalRef OptString `json:"externalRef"`
+	// User who recorded the transaction. Absent for transactions recorded before attribution existed.
+	CreatedBy OptUUID `json:"createdBy"`
+	// User who last changed the transaction.
+	UpdatedBy OptUUID `json:"updatedBy"`
+	// Lines spreading the transaction across envelopes. Empty for regular transactions.
+	Splits []TransactionSplit `json:"splits"`
+	// When the transaction was moved to the trash.
+	DeletedAt OptDateTime `json:"deletedAt"`
 }
 
 // GetID returns the value of ID.
@@ -847,6 +4558,36 @@ func (s *Transaction) GetCategory() OptString {
 	return s.Category
 }
 
+// GetTransferId returns the value of TransferId.
+func (s *Transaction) GetTransferId() OptUUID {
+	return s.TransferId
+}
+
+// GetExternalRef returns the value of ExternalRef.
+func (s *Transaction) GetExternalRef() OptString {
+	return s.ExternalRef
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *Transaction) GetCreatedBy() OptUUID {
+	return s.CreatedBy
+}
+
+// GetUpdatedBy returns the value of UpdatedBy.
+func (s *Transaction) GetUpdatedBy() OptUUID {
+	return s.UpdatedBy
+}
+
+// GetSplits returns the value of Splits.
+func (s *Transaction) GetSplits() []TransactionSplit {
+	return s.Splits
+}
+
+// GetDeletedAt returns the value of DeletedAt.
+func (s *Transaction) GetDeletedAt() OptDateTime {
+	return s.DeletedAt
+}
+
 // SetID sets the value of ID.
 func (s *Transaction) SetID(val uuid.UUID) {
 	s.ID = val
@@ -882,13 +4623,258 @@ func (s *Transaction) SetCategory(val OptString) {
 	s.Category = val
 }
 
-func (*Transaction) createTransactionRes() {}
-func (*Transaction) getTransactionRes()    {}
-func (*Transaction) updateTransactionRes() {}
+// SetTransferId sets the value of TransferId.
+func (s *Transaction) SetTransferId(val OptUUID) {
+	s.TransferId = val
+}
+
+// SetExternalRef sets the value of ExternalRef.
+func (s *Transaction) SetExternalRef(val OptString) {
+	s.ExternalRef = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *Transaction) SetCreatedBy(val OptUUID) {
+	s.CreatedBy = val
+}
+
+// SetUpdatedBy sets the value of UpdatedBy.
+func (s *Transaction) SetUpdatedBy(val OptUUID) {
+	s.UpdatedBy = val
+}
+
+// SetSplits sets the value of Splits.
+func (s *Transaction) SetSplits(val []TransactionSplit) {
+	s.Splits = val
+}
+
+// SetDeletedAt sets the value of DeletedAt.
+func (s *Transaction) SetDeletedAt(val OptDateTime) {
+	s.DeletedAt = val
+}
+
+func (*Transaction) createTransactionRes()  {}
+func (*Transaction) restoreTransactionRes() {}
+
+// TransactionHeaders wraps Transaction with response headers.
+type TransactionHeaders struct {
+	ETag     OptString
+	Response Transaction
+}
+
+// GetETag returns the value of ETag.
+func (s *TransactionHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *TransactionHeaders) GetResponse() Transaction {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *TransactionHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TransactionHeaders) SetResponse(val Transaction) {
+	s.Response = val
+}
+
+func (*TransactionHeaders) getTransactionRes()    {}
+func (*TransactionHeaders) updateTransactionRes() {}
+
+// Ref: #/components/schemas/TransactionSearchResult
+type TransactionSearchResult struct {
+	Transaction Transaction `json:"transaction"`
+	// Relevance of the match, higher is better.
+	Rank float64 `json:"rank"`
+	// Excerpt of the description around the first match.
+	Snippet string `json:"snippet"`
+	// Matched parts of the snippet.
+	Highlights []TextRange `json:"highlights"`
+}
+
+// GetTransaction returns the value of Transaction.
+func (s *TransactionSearchResult) GetTransaction() Transaction {
+	return s.Transaction
+}
+
+// GetRank returns the value of Rank.
+func (s *TransactionSearchResult) GetRank() float64 {
+	return s.Rank
+}
+
+// GetSnippet returns the value of Snippet.
+func (s *TransactionSearchResult) GetSnippet() string {
+	return s.Snippet
+}
+
+// GetHighlights returns the value of Highlights.
+func (s *TransactionSearchResult) GetHighlights() []TextRange {
+	return s.Highlights
+}
+
+// SetTransaction sets the value of Transaction.
+func (s *TransactionSearchResult) SetTransaction(val Transaction) {
+	s.Transaction = val
+}
+
+// SetRank sets the value of Rank.
+func (s *TransactionSearchResult) SetRank(val float64) {
+	s.Rank = val
+}
+
+// SetSnippet sets the value of Snippet.
+func (s *TransactionSearchResult) SetSnippet(val string) {
+	s.Snippet = val
+}
+
+// SetHighlights sets the value of Highlights.
+func (s *TransactionSearchResult) SetHighlights(val []TextRange) {
+	s.Highlights = val
+}
+
+// Ref: #/components/schemas/TransactionSplit
+type TransactionSplit struct {
+	EnvelopeId uuid.UUID `json:"envelopeId"`
+	// Line amount in currency cents, same sign convention as the transaction amount.
+	Amount   int64     `json:"amount"`
+	Category OptString `json:"category"`
+}
+
+// GetEnvelopeId returns the value of EnvelopeId.
+func (s *TransactionSplit) GetEnvelopeId() uuid.UUID {
+	return s.EnvelopeId
+}
+
+// GetAmount returns the value of Amount.
+func (s *TransactionSplit) GetAmount() int64 {
+	return s.Amount
+}
+
+// GetCategory returns the value of Category.
+func (s *TransactionSplit) GetCategory() OptString {
+	return s.Category
+}
+
+// SetEnvelopeId sets the value of EnvelopeId.
+func (s *TransactionSplit) SetEnvelopeId(val uuid.UUID) {
+	s.EnvelopeId = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *TransactionSplit) SetAmount(val int64) {
+	s.Amount = val
+}
+
+// SetCategory sets the value of Category.
+func (s *TransactionSplit) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// Ref: #/components/schemas/Transfer
+type Transfer struct {
+	ID             uuid.UUID `json:"id"`
+	PeriodId       uuid.UUID `json:"periodId"`
+	FromEnvelopeId uuid.UUID `json:"fromEnvelopeId"`
+	ToEnvelopeId   uuid.UUID `json:"toEnvelopeId"`
+	// Transferred amount in currency cents.
+	Amount int64     `json:"amount"`
+	Date   time.Time `json:"date"`
+}
+
+// GetID returns the value of ID.
+func (s *Transfer) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetPeriodId returns the value of PeriodId.
+func (s *Transfer) GetPeriodId() uuid.UUID {
+	return s.PeriodId
+}
+
+// GetFromEnvelopeId returns the value of FromEnvelopeId.
+func (s *Transfer) GetFromEnvelopeId() uuid.UUID {
+	return s.FromEnvelopeId
+}
+
+// GetToEnvelopeId returns the value of ToEnvelopeId.
+func (s *Transfer) GetToEnvelopeId() uuid.UUID {
+	return s.ToEnvelopeId
+}
+
+// GetAmount returns the value of Amount.
+func (s *Transfer) GetAmount() int64 {
+	return s.Amount
+}
+
+// GetDate returns the value of Date.
+func (s *Transfer) GetDate() time.Time {
+	return s.Date
+}
+
+// SetID sets the value of ID.
+func (s *Transfer) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetPeriodId sets the value of PeriodId.
+func (s *Transfer) SetPeriodId(val uuid.UUID) {
+	s.PeriodId = val
+}
+
+// SetFromEnvelopeId sets the value of FromEnvelopeId.
+func (s *Transfer) SetFromEnvelopeId(val uuid.UUID) {
+	s.FromEnvelopeId = val
+}
+
+// SetToEnvelopeId sets the value of ToEnvelopeId.
+func (s *Transfer) SetToEnvelopeId(val uuid.UUID) {
+	s.ToEnvelopeId = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *Transfer) SetAmount(val int64) {
+	s.Amount = val
+}
+
+// SetDate sets the value of Date.
+func (s *Transfer) SetDate(val time.Time) {
+	s.Date = val
+}
+
+// Ref: #/components/schemas/Trash
+type Trash struct {
+	Transactions []Transaction `json:"transactions"`
+	Envelopes    []Envelope    `json:"envelopes"`
+}
+
+// GetTransactions returns the value of Transactions.
+func (s *Trash) GetTransactions() []Transaction {
+	return s.Transactions
+}
+
+// GetEnvelopes returns the value of Envelopes.
+func (s *Trash) GetEnvelopes() []Envelope {
+	return s.Envelopes
+}
+
+// SetTransactions sets the value of Transactions.
+func (s *Trash) SetTransactions(val []Transaction) {
+	s.Transactions = val
+}
+
+// SetEnvelopes sets the value of Envelopes.
+func (s *Trash) SetEnvelopes(val []Envelope) {
+	s.Envelopes = val
+}
 
 // Ref: #/components/schemas/UpdateEnvelope
 type UpdateEnvelope struct {
-	Name OptString `json:"name"`
+	Name           OptString         `json:"name"`
+	RolloverPolicy OptRolloverPolicy `json:"rolloverPolicy"`
 }
 
 // GetName returns the value of Name.
@@ -896,16 +4882,57 @@ func (s *UpdateEnvelope) GetName() OptString {
 	return s.Name
 }
 
+// GetRolloverPolicy returns the value of RolloverPolicy.
+func (s *UpdateEnvelope) GetRolloverPolicy() OptRolloverPolicy {
+	return s.RolloverPolicy
+}
+
 // SetName sets the value of Name.
 func (s *UpdateEnvelope) SetName(val OptString) {
 	s.Name = val
 }
 
+// SetRolloverPolicy sets the value of RolloverPolicy.
+func (s *UpdateEnvelope) SetRolloverPolicy(val OptRolloverPolicy) {
+	s.RolloverPolicy = val
+}
+
 // UpdateEnvelopeNotFound is response for UpdateEnvelope operation.
 type UpdateEnvelopeNotFound struct{}
 
 func (*UpdateEnvelopeNotFound) updateEnvelopeRes() {}
 
+// Ref: #/components/schemas/UpdateMember
+type UpdateMember struct {
+	Role        Role        `json:"role"`
+	EnvelopeIds []uuid.UUID `json:"envelopeIds"`
+}
+
+// GetRole returns the value of Role.
+func (s *UpdateMember) GetRole() Role {
+	return s.Role
+}
+
+// GetEnvelopeIds returns the value of EnvelopeIds.
+func (s *UpdateMember) GetEnvelopeIds() []uuid.UUID {
+	return s.EnvelopeIds
+}
+
+// SetRole sets the value of Role.
+func (s *UpdateMember) SetRole(val Role) {
+	s.Role = val
+}
+
+// SetEnvelopeIds sets the value of EnvelopeIds.
+func (s *UpdateMember) SetEnvelopeIds(val []uuid.UUID) {
+	s.EnvelopeIds = val
+}
+
+// UpdateMemberNotFound is response for UpdateMember operation.
+type UpdateMemberNotFound struct{}
+
+func (*UpdateMemberNotFound) updateMemberRes() {}
+
 // Ref: #/components/schemas/UpdatePeriod
 type UpdatePeriod struct {
 	StartDate         OptDate    `json:"startDate"`
@@ -959,6 +4986,178 @@ type UpdatePeriodNotFound struct{}
 
 func (*UpdatePeriodNotFound) updatePeriodRes() {}
 
+// Ref: #/components/schemas/UpdateRecurringTransaction
+type UpdateRecurringTransaction struct {
+	EnvelopeId  OptUUID               `json:"envelopeId"`
+	Amount      OptInt64              `json:"amount"`
+	Description OptString             `json:"description"`
+	Category    OptString             `json:"category"`
+	Schedule    OptRecurrenceSchedule `json:"schedule"`
+}
+
+// GetEnvelopeId returns the value of EnvelopeId.
+func (s *UpdateRecurringTransaction) GetEnvelopeId() OptUUID {
+	return s.EnvelopeId
+}
+
+// GetAmount returns the value of Amount.
+func (s *UpdateRecurringTransaction) GetAmount() OptInt64 {
+	return s.Amount
+}
+
+// GetDescription returns the value of Description.
+func (s *UpdateRecurringTransaction) GetDescription() OptString {
+	return s.Description
+}
+
+// GetCategory returns the value of Category.
+func (s *UpdateRecurringTransaction) GetCategory() OptString {
+	return s.Category
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *UpdateRecurringTransaction) GetSchedule() OptRecurrenceSchedule {
+	return s.Schedule
+}
+
+// SetEnvelopeId sets the value of EnvelopeId.
+func (s *UpdateRecurringTransaction) SetEnvelopeId(val OptUUID) {
+	s.EnvelopeId = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *UpdateRecurringTransaction) SetAmount(val OptInt64) {
+	s.Amount = val
+}
+
+// SetDescription sets the value of Description.
+func (s *UpdateRecurringTransaction) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetCategory sets the value of Category.
+func (s *UpdateRecurringTransaction) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *UpdateRecurringTransaction) SetSchedule(val OptRecurrenceSchedule) {
+	s.Schedule = val
+}
+
+// UpdateRecurringTransactionNotFound is response for UpdateRecurringTransaction operation.
+type UpdateRecurringTransactionNotFound struct{}
+
+func (*UpdateRecurringTransactionNotFound) updateRecurringTransactionRes() {}
+
+// Ref: #/components/schemas/UpdateRule
+type UpdateRule struct {
+	Name               OptString      `json:"name"`
+	Priority           OptInt         `json:"priority"`
+	DescriptionPattern OptString      `json:"descriptionPattern"`
+	PatternType        OptPatternType `json:"patternType"`
+	MinAmount          OptNilInt64    `json:"minAmount"`
+	MaxAmount          OptNilInt64    `json:"maxAmount"`
+	Weekdays           []int          `json:"weekdays"`
+	Category           OptString      `json:"category"`
+	EnvelopeId         OptNilUUID     `json:"envelopeId"`
+}
+
+// GetName returns the value of Name.
+func (s *UpdateRule) GetName() OptString {
+	return s.Name
+}
+
+// GetPriority returns the value of Priority.
+func (s *UpdateRule) GetPriority() OptInt {
+	return s.Priority
+}
+
+// GetDescriptionPattern returns the value of DescriptionPattern.
+func (s *UpdateRule) GetDescriptionPattern() OptString {
+	return s.DescriptionPattern
+}
+
+// GetPatternType returns the value of PatternType.
+func (s *UpdateRule) GetPatternType() OptPatternType {
+	return s.PatternType
+}
+
+// GetMinAmount returns the value of MinAmount.
+func (s *UpdateRule) GetMinAmount() OptNilInt64 {
+	return s.MinAmount
+}
+
+// GetMaxAmount returns the value of MaxAmount.
+func (s *UpdateRule) GetMaxAmount() OptNilInt64 {
+	return s.MaxAmount
+}
+
+// GetWeekdays returns the value of Weekdays.
+func (s *UpdateRule) GetWeekdays() []int {
+	return s.Weekdays
+}
+
+// GetCategory returns the value of Category.
+func (s *UpdateRule) GetCategory() OptString {
+	return s.Category
+}
+
+// GetEnvelopeId returns the value of EnvelopeId.
+func (s *UpdateRule) GetEnvelopeId() OptNilUUID {
+	return s.EnvelopeId
+}
+
+// SetName sets the value of Name.
+func (s *UpdateRule) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *UpdateRule) SetPriority(val OptInt) {
+	s.Priority = val
+}
+
+// SetDescriptionPattern sets the value of DescriptionPattern.
+func (s *UpdateRule) SetDescriptionPattern(val OptString) {
+	s.DescriptionPattern = val
+}
+
+// SetPatternType sets the value of PatternType.
+func (s *UpdateRule) SetPatternType(val OptPatternType) {
+	s.PatternType = val
+}
+
+// SetMinAmount sets the value of MinAmount.
+func (s *UpdateRule) SetMinAmount(val OptNilInt64) {
+	s.MinAmount = val
+}
+
+// SetMaxAmount sets the value of MaxAmount.
+func (s *UpdateRule) SetMaxAmount(val OptNilInt64) {
+	s.MaxAmount = val
+}
+
+// SetWeekdays sets the value of Weekdays.
+func (s *UpdateRule) SetWeekdays(val []int) {
+	s.Weekdays = val
+}
+
+// SetCategory sets the value of Category.
+func (s *UpdateRule) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetEnvelopeId sets the value of EnvelopeId.
+func (s *UpdateRule) SetEnvelopeId(val OptNilUUID) {
+	s.EnvelopeId = val
+}
+
+// UpdateRuleNotFound is response for UpdateRule operation.
+type UpdateRuleNotFound struct{}
+
+func (*UpdateRuleNotFound) updateRuleRes() {}
+
 // Ref: #/components/schemas/UpdateTransaction
 type UpdateTransaction struct {
 	EnvelopeId  OptUUID     `json:"envelopeId"`
@@ -966,6 +5165,8 @@ type UpdateTransaction struct {
 	Description OptString   `json:"description"`
 	Date        OptDateTime `json:"date"`
 	Category    OptString   `json:"category"`
+	// Replaces the split lines. An empty array turns the transaction back into a regular one.
+	Splits []TransactionSplit `json:"splits"`
 }
 
 // GetEnvelopeId returns the value of EnvelopeId.
@@ -993,6 +5194,11 @@ func (s *UpdateTransaction) GetCategory() OptString {
 	return s.Category
 }
 
+// GetSplits returns the value of Splits.
+func (s *UpdateTransaction) GetSplits() []TransactionSplit {
+	return s.Splits
+}
+
 // SetEnvelopeId sets the value of EnvelopeId.
 func (s *UpdateTransaction) SetEnvelopeId(val OptUUID) {
 	s.EnvelopeId = val
@@ -1018,6 +5224,11 @@ func (s *UpdateTransaction) SetCategory(val OptString) {
 	s.Category = val
 }
 
+// SetSplits sets the value of Splits.
+func (s *UpdateTransaction) SetSplits(val []TransactionSplit) {
+	s.Splits = val
+}
+
 // UpdateTransactionNotFound is response for UpdateTransaction operation.
 type UpdateTransactionNotFound struct{}
 
@@ -1025,8 +5236,9 @@ func (*UpdateTransactionNotFound) updateTransactionRes() {}
 
 // Ref: #/components/schemas/User
 type User struct {
-	ID   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email OptString `json:"email"`
 }
 
 // GetID returns the value of ID.
@@ -1039,6 +5251,11 @@ func (s *User) GetName() string {
 	return s.Name
 }
 
+// GetEmail returns the value of Email.
+func (s *User) GetEmail() OptString {
+	return s.Email
+}
+
 // SetID sets the value of ID.
 func (s *User) SetID(val uuid.UUID) {
 	s.ID = val
@@ -1048,3 +5265,8 @@ func (s *User) SetID(val uuid.UUID) {
 func (s *User) SetName(val string) {
 	s.Name = val
 }
+
+// SetEmail sets the value of Email.
+func (s *User) SetEmail(val OptString) {
+	s.Email = val
+}
@@ -34,24 +34,55 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 }
 
 var operationRolesBearerAuth = map[string][]string{
-	CreateEnvelopeOperation:    []string{},
-	CreatePeriodOperation:      []string{},
-	CreateTransactionOperation: []string{},
-	DeleteEnvelopeOperation:    []string{},
-	DeletePeriodOperation:      []string{},
-	DeleteTransactionOperation: []string{},
-	GetCurrentPeriodOperation:  []string{},
-	GetCurrentUserOperation:    []string{},
-	GetEnvelopeOperation:       []string{},
-	GetPeriodOperation:         []string{},
-	GetTransactionOperation:    []string{},
-	ListEnvelopesOperation:     []string{},
-	ListPeriodsOperation:       []string{},
-	ListTransactionsOperation:  []string{},
-	ListUsersOperation:         []string{},
-	UpdateEnvelopeOperation:    []string{},
-	UpdatePeriodOperation:      []string{},
-	UpdateTransactionOperation: []string{},
+	ApplyRulesOperation:                 []string{},
+	CreateAccessTokenOperation:          []string{},
+	CreateEnvelopeOperation:             []string{},
+	CreateInvitationOperation:           []string{},
+	CreatePeriodOperation:               []string{},
+	CreateRecurringTransactionOperation: []string{},
+	CreateRuleOperation:                 []string{},
+	CreateTransactionOperation:          []string{},
+	CreateTransferOperation:             []string{},
+	DeleteEnvelopeOperation:             []string{},
+	DeletePeriodOperation:               []string{},
+	DeleteRecurringTransactionOperation: []string{},
+	DeleteRuleOperation:                 []string{},
+	DeleteTransactionOperation:          []string{},
+	GetCurrentPeriodOperation:           []string{},
+	GetCurrentUserOperation:             []string{},
+	GetEnvelopeOperation:                []string{},
+	GetHouseholdOperation:               []string{},
+	GetMemberSpendingOperation:          []string{},
+	GetPeriodOperation:                  []string{},
+	GetPeriodScheduleOperation:          []string{},
+	GetRecurringTransactionOperation:    []string{},
+	GetRuleOperation:                    []string{},
+	GetTransactionOperation:             []string{},
+	ImportHolidaysOperation:             []string{},
+	ImportTransactionsOperation:         []string{},
+	JoinHouseholdOperation:              []string{},
+	ListAccessTokensOperation:           []string{},
+	ListAuditEntriesOperation:           []string{},
+	ListEnvelopesOperation:              []string{},
+	ListHolidaysOperation:               []string{},
+	ListPeriodGapsOperation:             []string{},
+	ListPeriodsOperation:                []string{},
+	ListRecurringTransactionsOperation:  []string{},
+	ListRulesOperation:                  []string{},
+	ListTransactionsOperation:           []string{},
+	ListTrashOperation:                  []string{},
+	ListUsersOperation:                  []string{},
+	RestoreEnvelopeOperation:            []string{},
+	RestoreTransactionOperation:         []string{},
+	RevokeAccessTokenOperation:          []string{},
+	SearchTransactionsOperation:         []string{},
+	UpdateEnvelopeOperation:             []string{},
+	UpdateMemberOperation:               []string{},
+	UpdatePeriodOperation:               []string{},
+	UpdatePeriodScheduleOperation:       []string{},
+	UpdateRecurringTransactionOperation: []string{},
+	UpdateRuleOperation:                 []string{},
+	UpdateTransactionOperation:          []string{},
 }
 
 func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
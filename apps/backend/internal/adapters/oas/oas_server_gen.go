@@ -8,27 +8,73 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// ApplyRules implements applyRules operation.
+	//
+	// Re-evaluates all rules against the transactions of the period, overwriting the category
+	// and envelope of matching transactions. Transfers are left alone. With `commit: false` (the default)
+	// nothing is saved and the result only reports what would change.
+	//
+	// POST /periods/{periodId}/apply-rules
+	ApplyRules(ctx context.Context, req *ApplyRules, params ApplyRulesParams) (ApplyRulesRes, error)
+	// CreateAccessToken implements createAccessToken operation.
+	//
+	// The token authenticates scripts as the current user, sent as a bearer token like any other.
+	// It is shown only once. Tokens cannot be managed with an access token.
+	//
+	// POST /me/tokens
+	CreateAccessToken(ctx context.Context, req *CreateAccessToken) (*CreatedAccessToken, error)
 	// CreateEnvelope implements createEnvelope operation.
 	//
 	// Create a new envelope.
 	//
 	// POST /envelopes
-	CreateEnvelope(ctx context.Context, req *CreateEnvelope) (*Envelope, error)
+	CreateEnvelope(ctx context.Context, req *CreateEnvelope, params CreateEnvelopeParams) (*Envelope, error)
+	// CreateInvitation implements createInvitation operation.
+	//
+	// Returns a one-time code that expires after seven days. The code is shown only once.
+	//
+	// POST /household/invitations
+	CreateInvitation(ctx context.Context, req OptCreateInvitation) (*Invitation, error)
 	// CreatePeriod implements createPeriod operation.
 	//
-	// Create a new financial period.
+	// Periods run from their start date up to, but not including, their end date, so the next
+	// period may start on the day this one ends. Omitted dates follow the period schedule of the
+	// household: without dates, the scheduled period around today is created. A period overlapping
+	// another one is rejected
+	// with 409. When the server rejects period gaps, a period leaving days uncovered between it
+	// and its neighbours is rejected with 400.
 	//
 	// POST /periods
-	CreatePeriod(ctx context.Context, req *CreatePeriod) (*PeriodSummary, error)
+	CreatePeriod(ctx context.Context, req *CreatePeriod, params CreatePeriodParams) (*PeriodSummary, error)
+	// CreateRecurringTransaction implements createRecurringTransaction operation.
+	//
+	// Create a recurring transaction template.
+	//
+	// POST /recurring-transactions
+	CreateRecurringTransaction(ctx context.Context, req *CreateRecurringTransaction) (*RecurringTransaction, error)
+	// CreateRule implements createRule operation.
+	//
+	// Create a categorisation rule.
+	//
+	// POST /rules
+	CreateRule(ctx context.Context, req *CreateRule) (*Rule, error)
 	// CreateTransaction implements createTransaction operation.
 	//
 	// Create a new transaction.
 	//
 	// POST /transactions
-	CreateTransaction(ctx context.Context, req *CreateTransaction) (CreateTransactionRes, error)
+	CreateTransaction(ctx context.Context, req *CreateTransaction, params CreateTransactionParams) (CreateTransactionRes, error)
+	// CreateTransfer implements createTransfer operation.
+	//
+	// Transfer funds between envelopes.
+	//
+	// POST /transfers
+	CreateTransfer(ctx context.Context, req *CreateTransfer) (*Transfer, error)
 	// DeleteEnvelope implements deleteEnvelope operation.
 	//
-	// Delete an envelope.
+	// Moves the envelope to the trash, from where it can be restored until the retention period ends.
+	// Fails with 409 while transactions outside the trash, recurring transactions or rules use the
+	// envelope.
 	//
 	// DELETE /envelopes/{envelopeId}
 	DeleteEnvelope(ctx context.Context, params DeleteEnvelopeParams) (DeleteEnvelopeRes, error)
@@ -38,9 +84,22 @@ type Handler interface {
 	//
 	// DELETE /periods/{periodId}
 	DeletePeriod(ctx context.Context, params DeletePeriodParams) (DeletePeriodRes, error)
+	// DeleteRecurringTransaction implements deleteRecurringTransaction operation.
+	//
+	// Transactions already generated from the template are kept.
+	//
+	// DELETE /recurring-transactions/{recurringTransactionId}
+	DeleteRecurringTransaction(ctx context.Context, params DeleteRecurringTransactionParams) (DeleteRecurringTransactionRes, error)
+	// DeleteRule implements deleteRule operation.
+	//
+	// Delete a categorisation rule.
+	//
+	// DELETE /rules/{ruleId}
+	DeleteRule(ctx context.Context, params DeleteRuleParams) (DeleteRuleRes, error)
 	// DeleteTransaction implements deleteTransaction operation.
 	//
-	// Delete a transaction.
+	// Moves the transaction to the trash, from where it can be restored until the retention period ends.
+	// Deleting either leg of a transfer deletes the whole transfer.
 	//
 	// DELETE /transactions/{transactionId}
 	DeleteTransaction(ctx context.Context, params DeleteTransactionParams) (DeleteTransactionRes, error)
@@ -62,54 +121,207 @@ type Handler interface {
 	//
 	// GET /envelopes/{envelopeId}
 	GetEnvelope(ctx context.Context, params GetEnvelopeParams) (GetEnvelopeRes, error)
+	// GetHousehold implements getHousehold operation.
+	//
+	// Get the household of the current user.
+	//
+	// GET /household
+	GetHousehold(ctx context.Context) (*Household, error)
+	// GetMemberSpending implements getMemberSpending operation.
+	//
+	// Totals of the transactions each member recorded in the period. Transfers are not counted.
+	//
+	// GET /periods/{periodId}/member-spending
+	GetMemberSpending(ctx context.Context, params GetMemberSpendingParams) (GetMemberSpendingRes, error)
 	// GetPeriod implements getPeriod operation.
 	//
 	// Get period by ID.
 	//
 	// GET /periods/{periodId}
 	GetPeriod(ctx context.Context, params GetPeriodParams) (GetPeriodRes, error)
+	// GetPeriodSchedule implements getPeriodSchedule operation.
+	//
+	// Households that have not configured a schedule get the default one, starting periods on the 5th of
+	// the month or the business day before it.
+	//
+	// GET /household/period-schedule
+	GetPeriodSchedule(ctx context.Context) (*PeriodScheduleHeaders, error)
+	// GetRecurringTransaction implements getRecurringTransaction operation.
+	//
+	// Get recurring transaction template by ID.
+	//
+	// GET /recurring-transactions/{recurringTransactionId}
+	GetRecurringTransaction(ctx context.Context, params GetRecurringTransactionParams) (GetRecurringTransactionRes, error)
+	// GetRule implements getRule operation.
+	//
+	// Get categorisation rule by ID.
+	//
+	// GET /rules/{ruleId}
+	GetRule(ctx context.Context, params GetRuleParams) (GetRuleRes, error)
 	// GetTransaction implements getTransaction operation.
 	//
 	// Get transaction by ID.
 	//
 	// GET /transactions/{transactionId}
 	GetTransaction(ctx context.Context, params GetTransactionParams) (GetTransactionRes, error)
+	// ImportHolidays implements importHolidays operation.
+	//
+	// Replaces the imported holidays of the household. Every day an all-day event covers is a holiday,
+	// events with a time are holidays on their start date. Events may recur yearly (`RRULE:FREQ=YEARLY`),
+	// other recurrence rules are rejected.
+	//
+	// PUT /household/holidays
+	ImportHolidays(ctx context.Context, req *ImportHolidays) ([]Holiday, error)
+	// ImportTransactions implements importTransactions operation.
+	//
+	// Parses a bank statement, assigns each row to the period containing its date and
+	// detects rows that were already recorded. With `commit: false` (the default) nothing is saved,
+	// so the result can be reviewed first; re-send the same request with `commit: true` to record the
+	// new rows.
+	//
+	// POST /imports
+	ImportTransactions(ctx context.Context, req *ImportRequest) (*ImportResult, error)
+	// JoinHousehold implements joinHousehold operation.
+	//
+	// The current user leaves their household. Its data stays with the remaining members. Households
+	// cannot be joined with an access token.
+	//
+	// POST /household/join
+	JoinHousehold(ctx context.Context, req *JoinHousehold) (*Household, error)
+	// ListAccessTokens implements listAccessTokens operation.
+	//
+	// Revoked tokens are not listed.
+	//
+	// GET /me/tokens
+	ListAccessTokens(ctx context.Context) ([]AccessToken, error)
+	// ListAuditEntries implements listAuditEntries operation.
+	//
+	// Every change to household data is recorded with who made it, when, and the entity
+	// before and after the change. Entries are listed newest first.
+	//
+	// GET /audit
+	ListAuditEntries(ctx context.Context, params ListAuditEntriesParams) ([]AuditEntry, error)
 	// ListEnvelopes implements listEnvelopes operation.
 	//
 	// List all envelopes.
 	//
 	// GET /envelopes
 	ListEnvelopes(ctx context.Context) ([]Envelope, error)
+	// ListHolidays implements listHolidays operation.
+	//
+	// Holidays of the built-in calendar chosen in the period schedule and imported ones, which period
+	// starts are moved off.
+	//
+	// GET /household/holidays
+	ListHolidays(ctx context.Context, params ListHolidaysParams) ([]Holiday, error)
+	// ListPeriodGaps implements listPeriodGaps operation.
+	//
+	// Date ranges between consecutive periods that no period covers, earliest first.
+	//
+	// GET /periods/gaps
+	ListPeriodGaps(ctx context.Context) ([]PeriodGap, error)
 	// ListPeriods implements listPeriods operation.
 	//
 	// List all financial periods.
 	//
 	// GET /periods
 	ListPeriods(ctx context.Context) ([]PeriodListItem, error)
+	// ListRecurringTransactions implements listRecurringTransactions operation.
+	//
+	// List recurring transaction templates.
+	//
+	// GET /recurring-transactions
+	ListRecurringTransactions(ctx context.Context) ([]RecurringTransaction, error)
+	// ListRules implements listRules operation.
+	//
+	// List categorisation rules.
+	//
+	// GET /rules
+	ListRules(ctx context.Context) ([]Rule, error)
 	// ListTransactions implements listTransactions operation.
 	//
 	// List transactions.
 	//
 	// GET /transactions
-	ListTransactions(ctx context.Context, params ListTransactionsParams) ([]Transaction, error)
+	ListTransactions(ctx context.Context, params ListTransactionsParams) (*ListTransactionsOKHeaders, error)
+	// ListTrash implements listTrash operation.
+	//
+	// Deleted items stay in the trash until they are restored or the retention period ends,
+	// after which they are deleted permanently. Items are listed most recently deleted first.
+	//
+	// GET /trash
+	ListTrash(ctx context.Context) (*Trash, error)
 	// ListUsers implements listUsers operation.
 	//
 	// List all household users.
 	//
 	// GET /users
 	ListUsers(ctx context.Context) ([]User, error)
+	// RestoreEnvelope implements restoreEnvelope operation.
+	//
+	// Restore a deleted envelope from the trash.
+	//
+	// POST /envelopes/{envelopeId}/restore
+	RestoreEnvelope(ctx context.Context, params RestoreEnvelopeParams) (RestoreEnvelopeRes, error)
+	// RestoreTransaction implements restoreTransaction operation.
+	//
+	// Restoring either leg of a transfer restores the whole transfer.
+	// Fails with 409 while an envelope of the transaction is in the trash itself.
+	//
+	// POST /transactions/{transactionId}/restore
+	RestoreTransaction(ctx context.Context, params RestoreTransactionParams) (RestoreTransactionRes, error)
+	// RevokeAccessToken implements revokeAccessToken operation.
+	//
+	// Revoke a personal access token.
+	//
+	// DELETE /me/tokens/{tokenId}
+	RevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) (RevokeAccessTokenRes, error)
+	// SearchTransactions implements searchTransactions operation.
+	//
+	// Full-text search over transaction descriptions across all periods. Matching is case, diacritic and
+	// script insensitive (Serbian Cyrillic and Latin), and partial words are matched by similarity.
+	// Results are ordered by relevance.
+	//
+	// GET /transactions/search
+	SearchTransactions(ctx context.Context, params SearchTransactionsParams) ([]TransactionSearchResult, error)
 	// UpdateEnvelope implements updateEnvelope operation.
 	//
 	// Update an envelope.
 	//
 	// PATCH /envelopes/{envelopeId}
 	UpdateEnvelope(ctx context.Context, req *UpdateEnvelope, params UpdateEnvelopeParams) (UpdateEnvelopeRes, error)
+	// UpdateMember implements updateMember operation.
+	//
+	// Only owners may manage members. A household always keeps at least one owner.
+	//
+	// PUT /household/members/{userId}
+	UpdateMember(ctx context.Context, req *UpdateMember, params UpdateMemberParams) (UpdateMemberRes, error)
 	// UpdatePeriod implements updatePeriod operation.
 	//
-	// Update a period.
+	// Changed dates are subject to the same checks as on creation: the period must not overlap
+	// another one (409), nor, when the server rejects period gaps, leave days uncovered (400).
+	// They must also keep every transaction of the period, including those in the trash (409).
 	//
 	// PATCH /periods/{periodId}
 	UpdatePeriod(ctx context.Context, req *UpdatePeriod, params UpdatePeriodParams) (UpdatePeriodRes, error)
+	// UpdatePeriodSchedule implements updatePeriodSchedule operation.
+	//
+	// Existing periods keep their dates, the schedule applies to periods created afterwards.
+	//
+	// PUT /household/period-schedule
+	UpdatePeriodSchedule(ctx context.Context, req *PeriodSchedule, params UpdatePeriodScheduleParams) (*PeriodScheduleHeaders, error)
+	// UpdateRecurringTransaction implements updateRecurringTransaction operation.
+	//
+	// Update a recurring transaction template.
+	//
+	// PATCH /recurring-transactions/{recurringTransactionId}
+	UpdateRecurringTransaction(ctx context.Context, req *UpdateRecurringTransaction, params UpdateRecurringTransactionParams) (UpdateRecurringTransactionRes, error)
+	// UpdateRule implements updateRule operation.
+	//
+	// Update a categorisation rule.
+	//
+	// PATCH /rules/{ruleId}
+	UpdateRule(ctx context.Context, req *UpdateRule, params UpdateRuleParams) (UpdateRuleRes, error)
 	// UpdateTransaction implements updateTransaction operation.
 	//
 	// Update a transaction.
@@ -13,21 +13,74 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// ApplyRules implements applyRules operation.
+//
+// Re-evaluates all rules against the transactions of the period, overwriting the category
+// and envelope of matching transactions. Transfers are left alone. With `commit: false` (the default)
+// nothing is saved and the result only reports what would change.
+//
+// POST /periods/{periodId}/apply-rules
+func (UnimplementedHandler) ApplyRules(ctx context.Context, req *ApplyRules, params ApplyRulesParams) (r ApplyRulesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateAccessToken implements createAccessToken operation.
+//
+// The token authenticates scripts as the current user, sent as a bearer token like any other.
+// It is shown only once. Tokens cannot be managed with an access token.
+//
+// POST /me/tokens
+func (UnimplementedHandler) CreateAccessToken(ctx context.Context, req *CreateAccessToken) (r *CreatedAccessToken, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateEnvelope implements createEnvelope operation.
 //
 // Create a new envelope.
 //
 // POST /envelopes
-func (UnimplementedHandler) CreateEnvelope(ctx context.Context, req *CreateEnvelope) (r *Envelope, _ error) {
+func (UnimplementedHandler) CreateEnvelope(ctx context.Context, req *CreateEnvelope, params CreateEnvelopeParams) (r *Envelope, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateInvitation implements createInvitation operation.
+//
+// Returns a one-time code that expires after seven days. The code is shown only once.
+//
+// POST /household/invitations
+func (UnimplementedHandler) CreateInvitation(ctx context.Context, req OptCreateInvitation) (r *Invitation, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // CreatePeriod implements createPeriod operation.
 //
-// Create a new financial period.
+// Periods run from their start date up to, but not including, their end date, so the next
+// period may start on the day this one ends. Omitted dates follow the period schedule of the
+// household: without dates, the scheduled period around today is created. A period overlapping
+// another one is rejected
+// with 409. When the server rejects period gaps, a period leaving days uncovered between it
+// and its neighbours is rejected with 400.
 //
 // POST /periods
-func (UnimplementedHandler) CreatePeriod(ctx context.Context, req *CreatePeriod) (r *PeriodSummary, _ error) {
+func (UnimplementedHandler) CreatePeriod(ctx context.Context, req *CreatePeriod, params CreatePeriodParams) (r *PeriodSummary, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateRecurringTransaction implements createRecurringTransaction operation.
+//
+// Create a recurring transaction template.
+//
+// POST /recurring-transactions
+func (UnimplementedHandler) CreateRecurringTransaction(ctx context.Context, req *CreateRecurringTransaction) (r *RecurringTransaction, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateRule implements createRule operation.
+//
+// Create a categorisation rule.
+//
+// POST /rules
+func (UnimplementedHandler) CreateRule(ctx context.Context, req *CreateRule) (r *Rule, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -36,13 +89,24 @@ func (UnimplementedHandler) CreatePeriod(ctx context.Context, req *CreatePeriod)
 // Create a new transaction.
 //
 // POST /transactions
-func (UnimplementedHandler) CreateTransaction(ctx context.Context, req *CreateTransaction) (r CreateTransactionRes, _ error) {
+func (UnimplementedHandler) CreateTransaction(ctx context.Context, req *CreateTransaction, params CreateTransactionParams) (r CreateTransactionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateTransfer implements createTransfer operation.
+//
+// Transfer funds between envelopes.
+//
+// POST /transfers
+func (UnimplementedHandler) CreateTransfer(ctx context.Context, req *CreateTransfer) (r *Transfer, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // DeleteEnvelope implements deleteEnvelope operation.
 //
-// Delete an envelope.
+// Moves the envelope to the trash, from where it can be restored until the retention period ends.
+// Fails with 409 while transactions outside the trash, recurring transactions or rules use the
+// envelope.
 //
 // DELETE /envelopes/{envelopeId}
 func (UnimplementedHandler) DeleteEnvelope(ctx context.Context, params DeleteEnvelopeParams) (r DeleteEnvelopeRes, _ error) {
@@ -58,9 +122,28 @@ func (UnimplementedHandler) DeletePeriod(ctx context.Context, params DeletePerio
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteRecurringTransaction implements deleteRecurringTransaction operation.
+//
+// Transactions already generated from the template are kept.
+//
+// DELETE /recurring-transactions/{recurringTransactionId}
+func (UnimplementedHandler) DeleteRecurringTransaction(ctx context.Context, params DeleteRecurringTransactionParams) (r DeleteRecurringTransactionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteRule implements deleteRule operation.
+//
+// Delete a categorisation rule.
+//
+// DELETE /rules/{ruleId}
+func (UnimplementedHandler) DeleteRule(ctx context.Context, params DeleteRuleParams) (r DeleteRuleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteTransaction implements deleteTransaction operation.
 //
-// Delete a transaction.
+// Moves the transaction to the trash, from where it can be restored until the retention period ends.
+// Deleting either leg of a transfer deletes the whole transfer.
 //
 // DELETE /transactions/{transactionId}
 func (UnimplementedHandler) DeleteTransaction(ctx context.Context, params DeleteTransactionParams) (r DeleteTransactionRes, _ error) {
@@ -94,6 +177,24 @@ func (UnimplementedHandler) GetEnvelope(ctx context.Context, params GetEnvelopeP
 	return r, ht.ErrNotImplemented
 }
 
+// GetHousehold implements getHousehold operation.
+//
+// Get the household of the current user.
+//
+// GET /household
+func (UnimplementedHandler) GetHousehold(ctx context.Context) (r *Household, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMemberSpending implements getMemberSpending operation.
+//
+// Totals of the transactions each member recorded in the period. Transfers are not counted.
+//
+// GET /periods/{periodId}/member-spending
+func (UnimplementedHandler) GetMemberSpending(ctx context.Context, params GetMemberSpendingParams) (r GetMemberSpendingRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPeriod implements getPeriod operation.
 //
 // Get period by ID.
@@ -103,6 +204,34 @@ func (UnimplementedHandler) GetPeriod(ctx context.Context, params GetPeriodParam
 	return r, ht.ErrNotImplemented
 }
 
+// GetPeriodSchedule implements getPeriodSchedule operation.
+//
+// Households that have not configured a schedule get the default one, starting periods on the 5th of
+// the month or the business day before it.
+//
+// GET /household/period-schedule
+func (UnimplementedHandler) GetPeriodSchedule(ctx context.Context) (r *PeriodScheduleHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetRecurringTransaction implements getRecurringTransaction operation.
+//
+// Get recurring transaction template by ID.
+//
+// GET /recurring-transactions/{recurringTransactionId}
+func (UnimplementedHandler) GetRecurringTransaction(ctx context.Context, params GetRecurringTransactionParams) (r GetRecurringTransactionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetRule implements getRule operation.
+//
+// Get categorisation rule by ID.
+//
+// GET /rules/{ruleId}
+func (UnimplementedHandler) GetRule(ctx context.Context, params GetRuleParams) (r GetRuleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetTransaction implements getTransaction operation.
 //
 // Get transaction by ID.
@@ -112,6 +241,58 @@ func (UnimplementedHandler) GetTransaction(ctx context.Context, params GetTransa
 	return r, ht.ErrNotImplemented
 }
 
+// ImportHolidays implements importHolidays operation.
+//
+// Replaces the imported holidays of the household. Every day an all-day event covers is a holiday,
+// events with a time are holidays on their start date. Events may recur yearly (`RRULE:FREQ=YEARLY`),
+// other recurrence rules are rejected.
+//
+// PUT /household/holidays
+func (UnimplementedHandler) ImportHolidays(ctx context.Context, req *ImportHolidays) (r []Holiday, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ImportTransactions implements importTransactions operation.
+//
+// Parses a bank statement, assigns each row to the period containing its date and
+// detects rows that were already recorded. With `commit: false` (the default) nothing is saved,
+// so the result can be reviewed first; re-send the same request with `commit: true` to record the
+// new rows.
+//
+// POST /imports
+func (UnimplementedHandler) ImportTransactions(ctx context.Context, req *ImportRequest) (r *ImportResult, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// JoinHousehold implements joinHousehold operation.
+//
+// The current user leaves their household. Its data stays with the remaining members. Households
+// cannot be joined with an access token.
+//
+// POST /household/join
+func (UnimplementedHandler) JoinHousehold(ctx context.Context, req *JoinHousehold) (r *Household, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListAccessTokens implements listAccessTokens operation.
+//
+// Revoked tokens are not listed.
+//
+// GET /me/tokens
+func (UnimplementedHandler) ListAccessTokens(ctx context.Context) (r []AccessToken, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListAuditEntries implements listAuditEntries operation.
+//
+// Every change to household data is recorded with who made it, when, and the entity
+// before and after the change. Entries are listed newest first.
+//
+// GET /audit
+func (UnimplementedHandler) ListAuditEntries(ctx context.Context, params ListAuditEntriesParams) (r []AuditEntry, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListEnvelopes implements listEnvelopes operation.
 //
 // List all envelopes.
@@ -121,6 +302,25 @@ func (UnimplementedHandler) ListEnvelopes(ctx context.Context) (r []Envelope, _
 	return r, ht.ErrNotImplemented
 }
 
+// ListHolidays implements listHolidays operation.
+//
+// Holidays of the built-in calendar chosen in the period schedule and imported ones, which period
+// starts are moved off.
+//
+// GET /household/holidays
+func (UnimplementedHandler) ListHolidays(ctx context.Context, params ListHolidaysParams) (r []Holiday, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPeriodGaps implements listPeriodGaps operation.
+//
+// Date ranges between consecutive periods that no period covers, earliest first.
+//
+// GET /periods/gaps
+func (UnimplementedHandler) ListPeriodGaps(ctx context.Context) (r []PeriodGap, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListPeriods implements listPeriods operation.
 //
 // List all financial periods.
@@ -130,12 +330,40 @@ func (UnimplementedHandler) ListPeriods(ctx context.Context) (r []PeriodListItem
 	return r, ht.ErrNotImplemented
 }
 
+// ListRecurringTransactions implements listRecurringTransactions operation.
+//
+// List recurring transaction templates.
+//
+// GET /recurring-transactions
+func (UnimplementedHandler) ListRecurringTransactions(ctx context.Context) (r []RecurringTransaction, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListRules implements listRules operation.
+//
+// List categorisation rules.
+//
+// GET /rules
+func (UnimplementedHandler) ListRules(ctx context.Context) (r []Rule, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListTransactions implements listTransactions operation.
 //
 // List transactions.
 //
 // GET /transactions
-func (UnimplementedHandler) ListTransactions(ctx context.Context, params ListTransactionsParams) (r []Transaction, _ error) {
+func (UnimplementedHandler) ListTransactions(ctx context.Context, params ListTransactionsParams) (r *ListTransactionsOKHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTrash implements listTrash operation.
+//
+// Deleted items stay in the trash until they are restored or the retention period ends,
+// after which they are deleted permanently. Items are listed most recently deleted first.
+//
+// GET /trash
+func (UnimplementedHandler) ListTrash(ctx context.Context) (r *Trash, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -148,6 +376,45 @@ func (UnimplementedHandler) ListUsers(ctx context.Context) (r []User, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// RestoreEnvelope implements restoreEnvelope operation.
+//
+// Restore a deleted envelope from the trash.
+//
+// POST /envelopes/{envelopeId}/restore
+func (UnimplementedHandler) RestoreEnvelope(ctx context.Context, params RestoreEnvelopeParams) (r RestoreEnvelopeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreTransaction implements restoreTransaction operation.
+//
+// Restoring either leg of a transfer restores the whole transfer.
+// Fails with 409 while an envelope of the transaction is in the trash itself.
+//
+// POST /transactions/{transactionId}/restore
+func (UnimplementedHandler) RestoreTransaction(ctx context.Context, params RestoreTransactionParams) (r RestoreTransactionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeAccessToken implements revokeAccessToken operation.
+//
+// Revoke a personal access token.
+//
+// DELETE /me/tokens/{tokenId}
+func (UnimplementedHandler) RevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) (r RevokeAccessTokenRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchTransactions implements searchTransactions operation.
+//
+// Full-text search over transaction descriptions across all periods. Matching is case, diacritic and
+// script insensitive (Serbian Cyrillic and Latin), and partial words are matched by similarity.
+// Results are ordered by relevance.
+//
+// GET /transactions/search
+func (UnimplementedHandler) SearchTransactions(ctx context.Context, params SearchTransactionsParams) (r []TransactionSearchResult, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateEnvelope implements updateEnvelope operation.
 //
 // Update an envelope.
@@ -157,15 +424,53 @@ func (UnimplementedHandler) UpdateEnvelope(ctx context.Context, req *UpdateEnvel
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateMember implements updateMember operation.
+//
+// Only owners may manage members. A household always keeps at least one owner.
+//
+// PUT /household/members/{userId}
+func (UnimplementedHandler) UpdateMember(ctx context.Context, req *UpdateMember, params UpdateMemberParams) (r UpdateMemberRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdatePeriod implements updatePeriod operation.
 //
-// Update a period.
+// Changed dates are subject to the same checks as on creation: the period must not overlap
+// another one (409), nor, when the server rejects period gaps, leave days uncovered (400).
+// They must also keep every transaction of the period, including those in the trash (409).
 //
 // PATCH /periods/{periodId}
 func (UnimplementedHandler) UpdatePeriod(ctx context.Context, req *UpdatePeriod, params UpdatePeriodParams) (r UpdatePeriodRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// UpdatePeriodSchedule implements updatePeriodSchedule operation.
+//
+// Existing periods keep their dates, the schedule applies to periods created afterwards.
+//
+// PUT /household/period-schedule
+func (UnimplementedHandler) UpdatePeriodSchedule(ctx context.Context, req *PeriodSchedule, params UpdatePeriodScheduleParams) (r *PeriodScheduleHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateRecurringTransaction implements updateRecurringTransaction operation.
+//
+// Update a recurring transaction template.
+//
+// PATCH /recurring-transactions/{recurringTransactionId}
+func (UnimplementedHandler) UpdateRecurringTransaction(ctx context.Context, req *UpdateRecurringTransaction, params UpdateRecurringTransactionParams) (r UpdateRecurringTransactionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateRule implements updateRule operation.
+//
+// Update a categorisation rule.
+//
+// PATCH /rules/{ruleId}
+func (UnimplementedHandler) UpdateRule(ctx context.Context, req *UpdateRule, params UpdateRuleParams) (r UpdateRuleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateTransaction implements updateTransaction operation.
 //
 // Update a transaction.
@@ -0,0 +1,76 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+const auditColumns = `id, actor_id, at, entity, entity_id, action, before, after`
+
+func (r *psqlRepo) SaveAuditEntry(ctx context.Context, e *service.AuditEntry) error {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO audit_log (household_id, ` + auditColumns + `)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err = r.getDB(ctx).Exec(ctx, query, householdID, e.ID, e.ActorID, e.At, e.Entity, e.EntityID, e.Action, e.Before, e.After)
+	return err
+}
+
+// ListAuditEntries lists the audit log of the household, newest first.
+func (r *psqlRepo) ListAuditEntries(ctx context.Context, filter service.AuditFilter) ([]service.AuditEntry, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + auditColumns + ` FROM audit_log WHERE household_id = $1`
+	args := []interface{}{householdID}
+	argCount := 2
+
+	if filter.Entity != nil {
+		query += fmt.Sprintf(" AND entity = $%d", argCount)
+		args = append(args, *filter.Entity)
+		argCount++
+	}
+	if filter.EntityID != nil {
+		query += fmt.Sprintf(" AND entity_id = $%d", argCount)
+		args = append(args, *filter.EntityID)
+		argCount++
+	}
+	if filter.ActorID != nil {
+		query += fmt.Sprintf(" AND actor_id = $%d", argCount)
+		args = append(args, *filter.ActorID)
+		argCount++
+	}
+	if filter.From != nil {
+		query += fmt.Sprintf(" AND at >= $%d", argCount)
+		args = append(args, *filter.From)
+		argCount++
+	}
+	if filter.To != nil {
+		query += fmt.Sprintf(" AND at <= $%d", argCount)
+		args = append(args, *filter.To)
+		argCount++
+	}
+	query += fmt.Sprintf(" ORDER BY at DESC, id DESC LIMIT $%d", argCount)
+	args = append(args, filter.Limit)
+
+	rows, err := r.getDB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []service.AuditEntry
+	for rows.Next() {
+		var e service.AuditEntry
+		if err := rows.Scan(&e.ID, &e.ActorID, &e.At, &e.Entity, &e.EntityID, &e.Action, &e.Before, &e.After); err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+	return res, rows.Err()
+}
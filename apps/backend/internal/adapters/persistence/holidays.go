@@ -0,0 +1,50 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+// ReplaceHolidays replaces the holidays imported into the household. Run it in a transaction,
+// so that a failing insert does not leave the household without any.
+func (r *psqlRepo) ReplaceHolidays(ctx context.Context, holidays []service.Holiday) error {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	db := r.getDB(ctx)
+	if _, err := db.Exec(ctx, `DELETE FROM holidays WHERE household_id = $1`, householdID); err != nil {
+		return err
+	}
+	query := `INSERT INTO holidays (household_id, day, name, yearly) VALUES ($1, $2, $3, $4)`
+	for _, h := range holidays {
+		if _, err := db.Exec(ctx, query, householdID, h.Date, h.Name, h.Yearly); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *psqlRepo) ListHolidays(ctx context.Context) ([]service.Holiday, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT day, name, yearly FROM holidays WHERE household_id = $1 ORDER BY day`
+	rows, err := r.getDB(ctx).Query(ctx, query, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []service.Holiday{}
+	for rows.Next() {
+		var h service.Holiday
+		if err := rows.Scan(&h.Date, &h.Name, &h.Yearly); err != nil {
+			return nil, err
+		}
+		res = append(res, h)
+	}
+	return res, rows.Err()
+}
@@ -0,0 +1,171 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var errUnscoped = errors.New("persistence: no household in context")
+
+// scope returns the household the queries of ctx are scoped to.
+// Running a household scoped query without one is a programming error.
+func scope(ctx context.Context) (uuid.UUID, error) {
+	id, ok := service.HouseholdIDFromContext(ctx)
+	if !ok {
+		return uuid.Nil, errUnscoped
+	}
+	return id, nil
+}
+
+func (r *psqlRepo) SaveHousehold(ctx context.Context, h *service.Household) error {
+	query := `INSERT INTO households (id, name) VALUES ($1, $2)
+              ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name`
+	_, err := r.getDB(ctx).Exec(ctx, query, h.ID, h.Name)
+	return err
+}
+
+func (r *psqlRepo) GetHousehold(ctx context.Context, id uuid.UUID) (*service.Household, error) {
+	query := `SELECT id, name FROM households WHERE id = $1`
+	h := &service.Household{}
+	err := r.getDB(ctx).QueryRow(ctx, query, id).Scan(&h.ID, &h.Name)
+	if err == pgx.ErrNoRows {
+		return nil, service.ErrNotFound
+	}
+	return h, err
+}
+
+// ClaimHousehold takes the household holding data from before tenancy off the market, returning its ID.
+// Only one caller ever gets it, concurrent ones wait and then get ErrNotFound like every later one.
+func (r *psqlRepo) ClaimHousehold(ctx context.Context) (uuid.UUID, error) {
+	query := `UPDATE households SET claimable = FALSE
+              WHERE id = (SELECT id FROM households WHERE claimable ORDER BY id LIMIT 1) AND claimable
+              RETURNING id`
+	var id uuid.UUID
+	err := r.getDB(ctx).QueryRow(ctx, query).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return uuid.Nil, service.ErrNotFound
+	}
+	return id, err
+}
+
+// SaveMember adds the user to the household, moving them out of any other one, and replaces their envelope grants.
+func (r *psqlRepo) SaveMember(ctx context.Context, householdID uuid.UUID, m *service.Member) error {
+	db := r.getDB(ctx)
+	query := `INSERT INTO household_members (user_id, household_id, role) VALUES ($1, $2, $3)
+              ON CONFLICT (user_id) DO UPDATE SET household_id = EXCLUDED.household_id, role = EXCLUDED.role`
+	if _, err := db.Exec(ctx, query, m.ID, householdID, m.Role); err != nil {
+		return err
+	}
+	if _, err := db.Exec(ctx, `DELETE FROM envelope_grants WHERE user_id = $1`, m.ID); err != nil {
+		return err
+	}
+	for _, envelopeID := range m.EnvelopeIDs {
+		query := `INSERT INTO envelope_grants (user_id, envelope_id, household_id) VALUES ($1, $2, $3)`
+		if _, err := db.Exec(ctx, query, m.ID, envelopeID, householdID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *psqlRepo) GetMember(ctx context.Context, userID uuid.UUID) (*service.Member, error) {
+	members, err := r.listMembers(ctx, &userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, service.ErrNotFound
+	}
+	return &members[0], nil
+}
+
+func (r *psqlRepo) ListMembers(ctx context.Context) ([]service.Member, error) {
+	return r.listMembers(ctx, nil)
+}
+
+// listMembers lists the members of the household with their grants, optionally just the given user.
+func (r *psqlRepo) listMembers(ctx context.Context, userID *uuid.UUID) ([]service.Member, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db := r.getDB(ctx)
+
+	query := `SELECT ` + userColumns + `, m.role FROM users
+              JOIN household_members m ON m.user_id = users.id
+              WHERE m.household_id = $1 AND ($2::uuid IS NULL OR m.user_id = $2)
+              ORDER BY name`
+	rows, err := db.Query(ctx, query, householdID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []service.Member
+	index := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var m service.Member
+		if err := rows.Scan(&m.ID, &m.Subject, &m.Name, &m.Email, &m.Role); err != nil {
+			return nil, err
+		}
+		index[m.ID] = len(res)
+		res = append(res, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	query = `SELECT user_id, envelope_id FROM envelope_grants
+             WHERE household_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+             ORDER BY user_id, envelope_id`
+	grants, err := db.Query(ctx, query, householdID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer grants.Close()
+
+	for grants.Next() {
+		var memberID, envelopeID uuid.UUID
+		if err := grants.Scan(&memberID, &envelopeID); err != nil {
+			return nil, err
+		}
+		if i, ok := index[memberID]; ok {
+			res[i].EnvelopeIDs = append(res[i].EnvelopeIDs, envelopeID)
+		}
+	}
+	return res, grants.Err()
+}
+
+func (r *psqlRepo) GetUserHouseholdID(ctx context.Context, userID uuid.UUID) (uuid.UUID, error) {
+	query := `SELECT household_id FROM household_members WHERE user_id = $1`
+	var id uuid.UUID
+	err := r.getDB(ctx).QueryRow(ctx, query, userID).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return uuid.Nil, service.ErrNotFound
+	}
+	return id, err
+}
+
+func (r *psqlRepo) SaveInvitation(ctx context.Context, inv *service.HouseholdInvitation) error {
+	query := `INSERT INTO household_invitations (id, household_id, code_hash, role, created_by, expires_at, accepted_by, accepted_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+              ON CONFLICT (id) DO UPDATE SET accepted_by = EXCLUDED.accepted_by, accepted_at = EXCLUDED.accepted_at`
+	_, err := r.getDB(ctx).Exec(ctx, query, inv.ID, inv.HouseholdID, inv.CodeHash, inv.Role, inv.CreatedBy, inv.ExpiresAt, inv.AcceptedBy, inv.AcceptedAt)
+	return err
+}
+
+// GetInvitationByCodeHash locks the invitation until the end of the surrounding transaction, so it can only be accepted once.
+func (r *psqlRepo) GetInvitationByCodeHash(ctx context.Context, codeHash string) (*service.HouseholdInvitation, error) {
+	query := `SELECT id, household_id, code_hash, role, created_by, expires_at, accepted_by, accepted_at
+              FROM household_invitations WHERE code_hash = $1 FOR UPDATE`
+	inv := &service.HouseholdInvitation{}
+	err := r.getDB(ctx).QueryRow(ctx, query, codeHash).Scan(&inv.ID, &inv.HouseholdID, &inv.CodeHash, &inv.Role, &inv.CreatedBy, &inv.ExpiresAt, &inv.AcceptedBy, &inv.AcceptedAt)
+	if err == pgx.ErrNoRows {
+		return nil, service.ErrNotFound
+	}
+	return inv, err
+}
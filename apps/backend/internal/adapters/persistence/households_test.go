@@ -0,0 +1,349 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func TestScopedQueriesRequireHousehold(t *testing.T) {
+	r := &psqlRepo{}
+	ctx := context.Background()
+
+	if _, err := r.GetPeriod(ctx, uuid.New()); !errors.Is(err, errUnscoped) {
+		t.Errorf("GetPeriod: expected errUnscoped, got %v", err)
+	}
+	if _, err := r.ListEnvelopes(ctx); !errors.Is(err, errUnscoped) {
+		t.Errorf("ListEnvelopes: expected errUnscoped, got %v", err)
+	}
+	if err := r.SaveTransaction(ctx, &service.Transaction{ID: uuid.New()}); !errors.Is(err, errUnscoped) {
+		t.Errorf("SaveTransaction: expected errUnscoped, got %v", err)
+	}
+	if err := r.DeleteRule(ctx, uuid.New(), 1); !errors.Is(err, errUnscoped) {
+		t.Errorf("DeleteRule: expected errUnscoped, got %v", err)
+	}
+}
+
+// householdFixture is the data a household owns in the isolation tests.
+type householdFixture struct {
+	ctx         context.Context
+	user        service.User
+	period      service.Period
+	envelope    service.Envelope
+	transaction service.Transaction
+	rule        service.Rule
+	recurring   service.RecurringTransaction
+}
+
+// testTx runs the test in a database transaction that is rolled back afterwards.
+// It needs DOBBY_TEST_DATABASE_URL pointing at a migrated database and skips otherwise.
+func testTx(t *testing.T) (*psqlRepo, context.Context) {
+	url := os.Getenv("DOBBY_TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("DOBBY_TEST_DATABASE_URL not set")
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		t.Fatalf("failed to begin: %v", err)
+	}
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	return &psqlRepo{db: pool}, context.WithValue(ctx, uowKey{}, tx)
+}
+
+func newHouseholdFixture(t *testing.T, r *psqlRepo, ctx context.Context, description string) *householdFixture {
+	t.Helper()
+	f := &householdFixture{}
+	h := service.Household{ID: uuid.New(), Name: description}
+	f.user = service.User{ID: uuid.New(), Subject: uuid.NewString(), Name: description}
+	f.ctx = service.WithHouseholdID(ctx, h.ID)
+
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	f.envelope = service.Envelope{ID: uuid.New(), Name: description, RolloverPolicy: service.RolloverReset}
+	f.period = service.Period{ID: uuid.New(), StartDate: start, EndDate: start.AddDate(0, 1, 0), DefaultEnvelopeID: &f.envelope.ID}
+	f.transaction = service.Transaction{
+		ID: uuid.New(), PeriodID: f.period.ID, EnvelopeID: f.envelope.ID,
+		Amount: -1000, Description: description, Category: "Groceries", Date: start, CreatedBy: &f.user.ID,
+	}
+	f.rule = service.Rule{ID: uuid.New(), Name: description, PatternType: service.PatternSubstring, DescriptionPattern: description, Category: "Groceries"}
+	f.recurring = service.RecurringTransaction{
+		ID: uuid.New(), EnvelopeID: f.envelope.ID, Amount: -500, Description: description,
+		Schedule: service.RecurrenceSchedule{Kind: service.RecurrenceMonthly, DayOfMonth: 1},
+	}
+
+	steps := []func() error{
+		func() error { return r.SaveHousehold(ctx, &h) },
+		func() error { return r.SaveUser(ctx, &f.user) },
+		func() error { return r.SaveMember(ctx, h.ID, &service.Member{User: f.user, Role: service.RoleOwner}) },
+		func() error { return r.SaveEnvelope(f.ctx, &f.envelope) },
+		func() error { return r.SavePeriod(f.ctx, &f.period) },
+		func() error { return r.SaveTransaction(f.ctx, &f.transaction) },
+		func() error { return r.SaveRule(f.ctx, &f.rule) },
+		func() error { return r.SaveRecurringTransaction(f.ctx, &f.recurring) },
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("failed to set up household %s: %v", description, err)
+		}
+	}
+	return f
+}
+
+// savepoint runs fn in a nested transaction that is always rolled back, so failed statements do not abort the test transaction.
+func savepoint(t *testing.T, ctx context.Context, fn func(ctx context.Context) error) error {
+	t.Helper()
+	sp, err := ctx.Value(uowKey{}).(pgx.Tx).Begin(ctx)
+	if err != nil {
+		t.Fatalf("failed to create savepoint: %v", err)
+	}
+	defer sp.Rollback(ctx)
+	return fn(context.WithValue(ctx, uowKey{}, sp))
+}
+
+func TestHouseholdIsolation(t *testing.T) {
+	r, ctx := testTx(t)
+	a := newHouseholdFixture(t, r, ctx, "isolation-alpha")
+	b := newHouseholdFixture(t, r, ctx, "isolation-bravo")
+
+	t.Run("reads", func(t *testing.T) {
+		if _, err := r.GetPeriod(a.ctx, b.period.ID); !errors.Is(err, service.ErrNotFound) {
+			t.Errorf("GetPeriod: expected ErrNotFound, got %v", err)
+		}
+		if _, err := r.GetEnvelope(a.ctx, b.envelope.ID); !errors.Is(err, service.ErrNotFound) {
+			t.Errorf("GetEnvelope: expected ErrNotFound, got %v", err)
+		}
+		if _, err := r.GetTransaction(a.ctx, b.transaction.ID); !errors.Is(err, service.ErrNotFound) {
+			t.Errorf("GetTransaction: expected ErrNotFound, got %v", err)
+		}
+		if _, err := r.GetRule(a.ctx, b.rule.ID); !errors.Is(err, service.ErrNotFound) {
+			t.Errorf("GetRule: expected ErrNotFound, got %v", err)
+		}
+		if _, err := r.GetRecurringTransaction(a.ctx, b.recurring.ID); !errors.Is(err, service.ErrNotFound) {
+			t.Errorf("GetRecurringTransaction: expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		periods, _ := r.ListPeriods(a.ctx)
+		envelopes, _ := r.ListEnvelopes(a.ctx)
+		rules, _ := r.ListRules(a.ctx)
+		recurring, _ := r.ListRecurringTransactions(a.ctx)
+		users, _ := r.ListUsers(a.ctx)
+		if len(periods) != 1 || len(envelopes) != 1 || len(rules) != 1 || len(recurring) != 1 || len(users) != 1 {
+			t.Errorf("expected one of each, got %d periods, %d envelopes, %d rules, %d recurring, %d users",
+				len(periods), len(envelopes), len(rules), len(recurring), len(users))
+		}
+		if len(users) == 1 && users[0].ID != a.user.ID {
+			t.Errorf("expected only own member, got %v", users[0].ID)
+		}
+		if _, err := r.GetMember(a.ctx, b.user.ID); !errors.Is(err, service.ErrNotFound) {
+			t.Errorf("GetMember: expected ErrNotFound, got %v", err)
+		}
+
+		filters := []service.TransactionFilter{
+			{},
+			{PeriodID: &b.period.ID},
+			{EnvelopeID: &b.envelope.ID},
+			{CreatedBy: &b.user.ID},
+		}
+		for _, filter := range filters {
+			txs, err := r.ListTransactions(a.ctx, filter)
+			if err != nil {
+				t.Fatalf("ListTransactions: %v", err)
+			}
+			for _, tx := range txs {
+				if tx.ID != a.transaction.ID {
+					t.Errorf("ListTransactions(%+v) leaked transaction %s", filter, tx.ID)
+				}
+			}
+		}
+
+		matches, err := r.SearchTransactions(a.ctx, "isolation-bravo", 50)
+		if err != nil {
+			t.Fatalf("SearchTransactions: %v", err)
+		}
+		for _, m := range matches {
+			if m.Transaction.ID != a.transaction.ID {
+				t.Errorf("SearchTransactions leaked transaction %s", m.Transaction.ID)
+			}
+		}
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		stats, err := r.GetPeriodStats(a.ctx, b.period.ID)
+		if err != nil {
+			t.Fatalf("GetPeriodStats: %v", err)
+		}
+		for _, s := range stats {
+			if s.Envelope.ID == b.envelope.ID || s.Spent != 0 {
+				t.Errorf("GetPeriodStats leaked %+v", s)
+			}
+		}
+		members, err := r.GetMemberStats(a.ctx, b.period.ID)
+		if err != nil {
+			t.Fatalf("GetMemberStats: %v", err)
+		}
+		for _, m := range members {
+			if m.TransactionCount != 0 || (m.UserID != nil && *m.UserID != a.user.ID) {
+				t.Errorf("GetMemberStats leaked %+v", m)
+			}
+		}
+	})
+
+	t.Run("writes", func(t *testing.T) {
+		updates := map[string]func(ctx context.Context) error{
+			"SavePeriod":   func(ctx context.Context) error { p := b.period; return r.SavePeriod(ctx, &p) },
+			"SaveEnvelope": func(ctx context.Context) error { e := b.envelope; e.Name = "hijacked"; return r.SaveEnvelope(ctx, &e) },
+			"SaveRule":     func(ctx context.Context) error { rule := b.rule; return r.SaveRule(ctx, &rule) },
+			"SaveRecurringTransaction": func(ctx context.Context) error {
+				rt := b.recurring
+				return r.SaveRecurringTransaction(ctx, &rt)
+			},
+			"DeletePeriod":   func(ctx context.Context) error { return r.DeletePeriod(ctx, b.period.ID, b.period.Version) },
+			"DeleteEnvelope": func(ctx context.Context) error { return r.DeleteEnvelope(ctx, b.envelope.ID, b.envelope.Version) },
+			"DeleteTransaction": func(ctx context.Context) error {
+				return r.DeleteTransaction(ctx, b.transaction.ID, b.transaction.Version)
+			},
+			"DeleteRule": func(ctx context.Context) error { return r.DeleteRule(ctx, b.rule.ID, b.rule.Version) },
+			"DeleteRecurringTransaction": func(ctx context.Context) error {
+				return r.DeleteRecurringTransaction(ctx, b.recurring.ID, b.recurring.Version)
+			},
+		}
+		for name, fn := range updates {
+			if err := savepoint(t, a.ctx, fn); !errors.Is(err, service.ErrNotFound) {
+				t.Errorf("%s: expected ErrNotFound, got %v", name, err)
+			}
+		}
+
+		// Same ID but A's own period and envelope: the row still belongs to B.
+		err := savepoint(t, a.ctx, func(ctx context.Context) error {
+			tx := b.transaction
+			tx.PeriodID, tx.EnvelopeID = a.period.ID, a.envelope.ID
+			return r.SaveTransaction(ctx, &tx)
+		})
+		if err == nil {
+			t.Error("SaveTransaction: expected overwriting another household's transaction to fail")
+		}
+	})
+
+	t.Run("references", func(t *testing.T) {
+		refs := map[string]func(ctx context.Context) error{
+			"transaction in foreign period": func(ctx context.Context) error {
+				tx := service.Transaction{ID: uuid.New(), PeriodID: b.period.ID, EnvelopeID: a.envelope.ID, Amount: -1, Date: a.period.StartDate}
+				return r.SaveTransaction(ctx, &tx)
+			},
+			"transaction in foreign envelope": func(ctx context.Context) error {
+				tx := service.Transaction{ID: uuid.New(), PeriodID: a.period.ID, EnvelopeID: b.envelope.ID, Amount: -1, Date: a.period.StartDate}
+				return r.SaveTransaction(ctx, &tx)
+			},
+			"split line in foreign envelope": func(ctx context.Context) error {
+				tx := service.Transaction{ID: uuid.New(), PeriodID: a.period.ID, EnvelopeID: a.envelope.ID, Amount: -2, Date: a.period.StartDate,
+					Splits: []service.TransactionSplit{{EnvelopeID: a.envelope.ID, Amount: -1}, {EnvelopeID: b.envelope.ID, Amount: -1}}}
+				return r.SaveTransaction(ctx, &tx)
+			},
+			"period defaulting to foreign envelope": func(ctx context.Context) error {
+				p := a.period
+				p.DefaultEnvelopeID = &b.envelope.ID
+				return r.SavePeriod(ctx, &p)
+			},
+			"rule targeting foreign envelope": func(ctx context.Context) error {
+				rule := a.rule
+				rule.EnvelopeID = &b.envelope.ID
+				return r.SaveRule(ctx, &rule)
+			},
+			"recurring transaction in foreign envelope": func(ctx context.Context) error {
+				rt := a.recurring
+				rt.EnvelopeID = b.envelope.ID
+				return r.SaveRecurringTransaction(ctx, &rt)
+			},
+		}
+		for name, fn := range refs {
+			if err := savepoint(t, a.ctx, fn); err == nil {
+				t.Errorf("%s: expected a foreign key violation", name)
+			}
+		}
+	})
+
+	// B's data survived everything A attempted.
+	got, err := r.GetTransaction(b.ctx, b.transaction.ID)
+	if err != nil {
+		t.Fatalf("GetTransaction: %v", err)
+	}
+	if got.Amount != b.transaction.Amount || got.EnvelopeID != b.envelope.ID {
+		t.Errorf("transaction of other household was modified: %+v", got)
+	}
+	if e, _ := r.GetEnvelope(b.ctx, b.envelope.ID); e == nil || e.Name != b.envelope.Name {
+		t.Errorf("envelope of other household was modified: %+v", e)
+	}
+}
+
+func TestClaimHousehold(t *testing.T) {
+	r, ctx := testTx(t)
+	h := service.Household{ID: uuid.New(), Name: "Before tenancy"}
+	if err := r.SaveHousehold(ctx, &h); err != nil {
+		t.Fatalf("SaveHousehold: %v", err)
+	}
+	// Rolled back with the test, so other households left to claim do not get in the way.
+	if _, err := r.getDB(ctx).Exec(ctx, `UPDATE households SET claimable = (id = $1)`, h.ID); err != nil {
+		t.Fatalf("failed to mark household claimable: %v", err)
+	}
+
+	id, err := r.ClaimHousehold(ctx)
+	if err != nil || id != h.ID {
+		t.Fatalf("expected to claim %v, got %v, %v", h.ID, id, err)
+	}
+	if _, err := r.ClaimHousehold(ctx); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("second claim: expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestMemberStatsOfFormerMembers(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "former-member")
+	former := service.User{ID: uuid.New(), Subject: uuid.NewString(), Name: "Former"}
+	if err := r.SaveUser(ctx, &former); err != nil {
+		t.Fatalf("SaveUser: %v", err)
+	}
+	for _, amount := range []int64{-300, 200} {
+		tx := service.Transaction{
+			ID: uuid.New(), PeriodID: f.period.ID, EnvelopeID: f.envelope.ID,
+			Amount: amount, Description: "left behind", Date: f.period.StartDate, CreatedBy: &former.ID,
+		}
+		if err := r.SaveTransaction(f.ctx, &tx); err != nil {
+			t.Fatalf("SaveTransaction: %v", err)
+		}
+	}
+
+	members, err := r.GetMemberStats(f.ctx, f.period.ID)
+	if err != nil {
+		t.Fatalf("GetMemberStats: %v", err)
+	}
+	var formerMembers []service.MemberSpending
+	var spent int64
+	for _, m := range members {
+		spent += m.Spent
+		if m.FormerMember {
+			formerMembers = append(formerMembers, m)
+		}
+	}
+	if len(formerMembers) != 1 || formerMembers[0].UserID != nil || formerMembers[0].Spent != 300 ||
+		formerMembers[0].Income != 200 || formerMembers[0].TransactionCount != 2 {
+		t.Errorf("expected one former member entry with the left behind transactions, got %+v", formerMembers)
+	}
+	if spent != 300-f.transaction.Amount {
+		t.Errorf("expected member totals to add up to %d spent, got %d", 300-f.transaction.Amount, spent)
+	}
+}
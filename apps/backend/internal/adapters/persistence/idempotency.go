@@ -0,0 +1,65 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ReserveIdempotencyKey records the request unless the user made one with its key after since.
+// The expired requests of the user are deleted first, which keeps the table small without a cleanup job.
+func (r *psqlRepo) ReserveIdempotencyKey(ctx context.Context, userID uuid.UUID, req *service.IdempotentRequest, since time.Time) (bool, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return false, err
+	}
+	db := r.getDB(ctx)
+	query := `DELETE FROM idempotency_keys WHERE household_id = $1 AND user_id = $2 AND created_at < $3`
+	if _, err := db.Exec(ctx, query, householdID, userID, since); err != nil {
+		return false, err
+	}
+	query = `INSERT INTO idempotency_keys (household_id, user_id, key, operation, request_hash, created_at)
+              VALUES ($1, $2, $3, $4, $5, $6)
+              ON CONFLICT (household_id, user_id, key) DO NOTHING`
+	result, err := db.Exec(ctx, query, householdID, userID, req.Key, req.Operation, req.RequestHash, req.CreatedAt)
+	if err != nil {
+		return false, err
+	}
+	return result.RowsAffected() == 1, nil
+}
+
+func (r *psqlRepo) GetIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (*service.IdempotentRequest, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT key, operation, request_hash, response, created_at FROM idempotency_keys
+              WHERE household_id = $1 AND user_id = $2 AND key = $3`
+	req := &service.IdempotentRequest{}
+	err = r.getDB(ctx).QueryRow(ctx, query, householdID, userID, key).
+		Scan(&req.Key, &req.Operation, &req.RequestHash, &req.Response, &req.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, service.ErrNotFound
+	}
+	return req, err
+}
+
+// CompleteIdempotencyKey records the response to the request made with the key.
+func (r *psqlRepo) CompleteIdempotencyKey(ctx context.Context, userID uuid.UUID, key string, response []byte) error {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE idempotency_keys SET response = $4 WHERE household_id = $1 AND user_id = $2 AND key = $3`
+	result, err := r.getDB(ctx).Exec(ctx, query, householdID, userID, key, response)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "idempotency")
+	now := time.Now()
+
+	req := &service.IdempotentRequest{Key: "retry-me", Operation: "create_envelope", RequestHash: "hash", CreatedAt: now.Add(-time.Hour)}
+	if reserved, err := r.ReserveIdempotencyKey(f.ctx, f.user.ID, req, now.Add(-2*time.Hour)); err != nil || !reserved {
+		t.Fatalf("expected a new key to be reserved, got %v, %v", reserved, err)
+	}
+	if reserved, err := r.ReserveIdempotencyKey(f.ctx, f.user.ID, req, now.Add(-2*time.Hour)); err != nil || reserved {
+		t.Errorf("expected a used key not to be reserved again, got %v, %v", reserved, err)
+	}
+	if err := r.CompleteIdempotencyKey(f.ctx, f.user.ID, req.Key, []byte(`{"Name":"Groceries"}`)); err != nil {
+		t.Fatalf("failed to complete: %v", err)
+	}
+	got, err := r.GetIdempotencyKey(f.ctx, f.user.ID, req.Key)
+	if err != nil || got.RequestHash != req.RequestHash || got.Response == nil {
+		t.Errorf("expected the completed request, got %+v, %v", got, err)
+	}
+
+	// Once outside the window the key is forgotten and can be reserved anew.
+	if reserved, err := r.ReserveIdempotencyKey(f.ctx, f.user.ID, req, now.Add(-time.Minute)); err != nil || !reserved {
+		t.Errorf("expected an expired key to be reserved, got %v, %v", reserved, err)
+	}
+	if got, err := r.GetIdempotencyKey(f.ctx, f.user.ID, req.Key); err != nil || got.Response != nil {
+		t.Errorf("expected the key reserved anew without a response, got %+v, %v", got, err)
+	}
+	if _, err := r.GetIdempotencyKey(f.ctx, f.user.ID, "unknown"); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("unknown key: expected ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+)
+
+func TestListTransactionsKeyset(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "keyset")
+
+	// All transactions share date and amount with the fixture one, so only the ID orders them.
+	save := func() uuid.UUID {
+		t.Helper()
+		tx := service.Transaction{ID: uuid.New(), PeriodID: f.period.ID, EnvelopeID: f.envelope.ID, Amount: f.transaction.Amount, Date: f.transaction.Date, Category: "Groceries"}
+		if err := r.SaveTransaction(f.ctx, &tx); err != nil {
+			t.Fatalf("SaveTransaction: %v", err)
+		}
+		return tx.ID
+	}
+	listed := map[uuid.UUID]int{f.transaction.ID: 0}
+	for range 4 {
+		listed[save()] = 0
+	}
+
+	for _, sort := range []service.TransactionSort{service.SortDateDesc, service.SortAmountAsc} {
+		t.Run(string(sort), func(t *testing.T) {
+			for id := range listed {
+				listed[id] = 0
+			}
+			inserted := uuid.Nil
+			var last *uuid.UUID
+			filter := service.TransactionFilter{PeriodID: &f.period.ID, Sort: sort, Limit: 2}
+			for page := 0; ; page++ {
+				txs, err := r.ListTransactions(f.ctx, filter)
+				if err != nil {
+					t.Fatalf("ListTransactions: %v", err)
+				}
+				if len(txs) == 0 {
+					break
+				}
+				for _, tx := range txs {
+					listed[tx.ID]++
+					if last != nil && (sort == service.SortDateDesc) != (tx.ID.String() < last.String()) {
+						t.Errorf("expected IDs ordered %s, got %v after %v", sort, tx.ID, *last)
+					}
+					last = &tx.ID
+				}
+				c := service.TransactionCursor{Sort: sort, Date: txs[len(txs)-1].Date, Amount: txs[len(txs)-1].Amount, ID: txs[len(txs)-1].ID}
+				filter.After = &c
+				if page == 0 {
+					// Another client records a transaction between the requests for two pages.
+					inserted = save()
+				}
+			}
+			for id, n := range listed {
+				if id != inserted && n != 1 {
+					t.Errorf("expected %v listed once, got %d times", id, n)
+				}
+			}
+			// Depending on its ID the new transaction falls before or after the cursor, but never on two pages.
+			if listed[inserted] > 1 {
+				t.Errorf("expected the inserted transaction listed at most once, got %d times", listed[inserted])
+			}
+			listed[inserted] = 0
+		})
+	}
+}
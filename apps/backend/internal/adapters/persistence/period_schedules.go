@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *psqlRepo) GetPeriodSchedule(ctx context.Context) (*service.PeriodSchedule, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT kind, day_of_month, second_day_of_month, interval_weeks, anchor_date, adjustment,
+                COALESCE(holiday_country, ''), time_zone, version
+              FROM period_schedules WHERE household_id = $1`
+	var (
+		sch                                         service.PeriodSchedule
+		dayOfMonth, secondDayOfMonth, intervalWeeks *int
+		anchorDate                                  *time.Time
+	)
+	err = r.getDB(ctx).QueryRow(ctx, query, householdID).Scan(&sch.Kind, &dayOfMonth, &secondDayOfMonth, &intervalWeeks,
+		&anchorDate, &sch.Adjustment, &sch.HolidayCountry, &sch.TimeZone, &sch.Version)
+	if err == pgx.ErrNoRows {
+		return nil, service.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if dayOfMonth != nil {
+		sch.DayOfMonth = *dayOfMonth
+	}
+	if secondDayOfMonth != nil {
+		sch.SecondDayOfMonth = *secondDayOfMonth
+	}
+	if intervalWeeks != nil {
+		sch.IntervalWeeks = *intervalWeeks
+	}
+	if anchorDate != nil {
+		sch.AnchorDate = *anchorDate
+	}
+	return &sch, nil
+}
+
+// SavePeriodSchedule stores the period schedule of the household, which has at most one.
+func (r *psqlRepo) SavePeriodSchedule(ctx context.Context, sch *service.PeriodSchedule) error {
+	// Only the fields relevant to the schedule kind are persisted, the rest are stored as NULL.
+	var (
+		dayOfMonth, secondDayOfMonth, intervalWeeks *int
+		anchorDate                                  *time.Time
+	)
+	switch sch.Kind {
+	case service.PeriodMonthly:
+		dayOfMonth = &sch.DayOfMonth
+	case service.PeriodSemiMonthly:
+		dayOfMonth, secondDayOfMonth = &sch.DayOfMonth, &sch.SecondDayOfMonth
+	case service.PeriodWeekly:
+		intervalWeeks = &sch.IntervalWeeks
+		anchorDate = &sch.AnchorDate
+	}
+
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	version := sch.Version + 1
+	query := `INSERT INTO period_schedules (household_id, kind, day_of_month, second_day_of_month, interval_weeks, anchor_date,
+                adjustment, holiday_country, time_zone, version)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+              ON CONFLICT (household_id) DO UPDATE SET
+                kind = EXCLUDED.kind,
+                day_of_month = EXCLUDED.day_of_month,
+                second_day_of_month = EXCLUDED.second_day_of_month,
+                interval_weeks = EXCLUDED.interval_weeks,
+                anchor_date = EXCLUDED.anchor_date,
+                adjustment = EXCLUDED.adjustment,
+                holiday_country = EXCLUDED.holiday_country,
+                time_zone = EXCLUDED.time_zone,
+                version = EXCLUDED.version
+              WHERE period_schedules.version = EXCLUDED.version - 1`
+	result, err := r.getDB(ctx).Exec(ctx, query, householdID, sch.Kind, dayOfMonth, secondDayOfMonth, intervalWeeks, anchorDate,
+		sch.Adjustment, nullIfEmpty(sch.HolidayCountry), sch.TimeZone, version)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		// The household has a schedule, just not the version the change is based on.
+		return service.ErrConflict
+	}
+	sch.Version = version
+	return nil
+}
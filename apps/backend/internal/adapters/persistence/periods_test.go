@@ -0,0 +1,185 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+)
+
+func TestSavePeriodRejectsOverlap(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "periods")
+
+	next := service.Period{ID: uuid.New(), StartDate: f.period.EndDate, EndDate: f.period.EndDate.AddDate(0, 1, 0)}
+	if err := r.SavePeriod(f.ctx, &next); err != nil {
+		t.Fatalf("period starting where the previous one ends: unexpected error %v", err)
+	}
+
+	overlapping := service.Period{ID: uuid.New(), StartDate: next.StartDate.AddDate(0, 0, -1), EndDate: next.EndDate}
+	if err := savepoint(t, f.ctx, func(ctx context.Context) error { return r.SavePeriod(ctx, &overlapping) }); !errors.Is(err, service.ErrPeriodOverlap) {
+		t.Errorf("overlapping period: expected ErrPeriodOverlap, got %v", err)
+	}
+	empty := service.Period{ID: uuid.New(), StartDate: next.EndDate, EndDate: next.EndDate}
+	if err := savepoint(t, f.ctx, func(ctx context.Context) error { return r.SavePeriod(ctx, &empty) }); !errors.Is(err, service.ErrValidation) {
+		t.Errorf("empty period: expected ErrValidation, got %v", err)
+	}
+}
+
+func TestPeriodSchedule(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "period-schedule")
+
+	if _, err := r.GetPeriodSchedule(f.ctx); !errors.Is(err, service.ErrNotFound) {
+		t.Fatalf("household without a schedule: expected ErrNotFound, got %v", err)
+	}
+	sch := service.PeriodSchedule{Kind: service.PeriodSemiMonthly, DayOfMonth: 1, SecondDayOfMonth: 15,
+		Adjustment: service.AdjustFollowing, HolidayCountry: "DE", TimeZone: "Europe/Berlin"}
+	if err := r.SavePeriodSchedule(f.ctx, &sch); err != nil {
+		t.Fatalf("failed to save schedule: %v", err)
+	}
+	stale := sch
+	sch.Kind, sch.SecondDayOfMonth = service.PeriodMonthly, 0
+	if err := r.SavePeriodSchedule(f.ctx, &sch); err != nil {
+		t.Fatalf("failed to update schedule: %v", err)
+	}
+	if err := savepoint(t, f.ctx, func(ctx context.Context) error { return r.SavePeriodSchedule(ctx, &stale) }); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("saving a stale version: expected ErrConflict, got %v", err)
+	}
+
+	got, err := r.GetPeriodSchedule(f.ctx)
+	if err != nil {
+		t.Fatalf("GetPeriodSchedule: %v", err)
+	}
+	if *got != sch {
+		t.Errorf("expected %+v, got %+v", sch, *got)
+	}
+}
+
+func TestReplaceHolidays(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "holidays")
+
+	first := []service.Holiday{
+		{Date: time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC), Name: "Company day", Yearly: true},
+		{Date: time.Date(2026, time.December, 24, 0, 0, 0, 0, time.UTC), Name: "Christmas Eve"},
+	}
+	if err := r.ReplaceHolidays(f.ctx, first); err != nil {
+		t.Fatalf("failed to import holidays: %v", err)
+	}
+	if err := r.ReplaceHolidays(f.ctx, first[1:]); err != nil {
+		t.Fatalf("failed to re-import holidays: %v", err)
+	}
+
+	got, err := r.ListHolidays(f.ctx)
+	if err != nil {
+		t.Fatalf("ListHolidays: %v", err)
+	}
+	if len(got) != 1 || !got[0].Date.Equal(first[1].Date) || got[0].Name != first[1].Name || got[0].Yearly {
+		t.Errorf("expected just %+v, got %+v", first[1], got)
+	}
+}
+
+func TestDeletePeriodInUse(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "delete-period")
+
+	del := func(ctx context.Context) error { return r.DeletePeriod(ctx, f.period.ID, f.period.Version) }
+	if err := savepoint(t, f.ctx, del); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("period with transactions: expected ErrConflict, got %v", err)
+	}
+	if err := r.DeletePeriod(f.ctx, uuid.New(), 1); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("unknown period: expected ErrNotFound, got %v", err)
+	}
+
+	// Transactions in the trash go with their period.
+	if err := r.DeleteTransaction(f.ctx, f.transaction.ID, f.transaction.Version); err != nil {
+		t.Fatalf("DeleteTransaction: %v", err)
+	}
+	if err := del(f.ctx); err != nil {
+		t.Errorf("period with trashed transactions only: unexpected error %v", err)
+	}
+}
+
+func TestGetPeriodStatsCountsSplitLines(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "period-stats-splits")
+
+	other := service.Envelope{ID: uuid.New(), Name: "period-stats-splits other", RolloverPolicy: service.RolloverReset}
+	// A purchase of 500 in the fixture envelope, partly paid back by 200 into the other one.
+	split := service.Transaction{ID: uuid.New(), PeriodID: f.period.ID, Amount: -300, Date: f.period.StartDate, Category: "Groceries",
+		Splits: []service.TransactionSplit{{EnvelopeID: f.envelope.ID, Amount: -500, Category: "Groceries"}, {EnvelopeID: other.ID, Amount: 200, Category: "Refund"}}}
+	split.EnvelopeID = split.Splits[0].EnvelopeID
+	for _, err := range []error{
+		r.SaveEnvelope(f.ctx, &other),
+		r.SaveTransaction(f.ctx, &split),
+	} {
+		if err != nil {
+			t.Fatalf("setup: %v", err)
+		}
+	}
+
+	stats, err := r.GetPeriodStats(f.ctx, f.period.ID)
+	if err != nil {
+		t.Fatalf("GetPeriodStats: %v", err)
+	}
+	type sums struct{ allocated, spent int64 }
+	// The fixture transaction of -1000 and the first line are spent from the fixture envelope, the split
+	// transaction itself is not counted on top of its lines.
+	want := map[uuid.UUID]sums{f.envelope.ID: {0, 1000 + 500}, other.ID: {200, 0}}
+	if len(stats) != len(want) {
+		t.Fatalf("expected stats of %d envelopes, got %+v", len(want), stats)
+	}
+	for _, stat := range stats {
+		if got := (sums{stat.Allocated, stat.Spent}); got != want[stat.Envelope.ID] {
+			t.Errorf("envelope %s: expected %+v, got %+v", stat.Envelope.Name, want[stat.Envelope.ID], got)
+		}
+	}
+}
+
+func TestListEnvelopeFlows(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "envelope-flows")
+
+	next := service.Period{ID: uuid.New(), StartDate: f.period.EndDate, EndDate: f.period.EndDate.AddDate(0, 1, 0)}
+	other := service.Envelope{ID: uuid.New(), Name: "envelope-flows other", RolloverPolicy: service.RolloverCarryAll}
+	income := service.Transaction{ID: uuid.New(), PeriodID: f.period.ID, EnvelopeID: f.envelope.ID, Amount: 5000, Date: f.period.StartDate, Category: "Salary"}
+	split := service.Transaction{ID: uuid.New(), PeriodID: f.period.ID, Amount: -300, Date: f.period.StartDate, Category: "Groceries",
+		Splits: []service.TransactionSplit{{EnvelopeID: f.envelope.ID, Amount: -100, Category: "Groceries"}, {EnvelopeID: other.ID, Amount: -200, Category: "Home"}}}
+	split.EnvelopeID = split.Splits[0].EnvelopeID
+	later := service.Transaction{ID: uuid.New(), PeriodID: next.ID, EnvelopeID: other.ID, Amount: 700, Date: next.StartDate, Category: "Salary"}
+	for _, err := range []error{
+		r.SavePeriod(f.ctx, &next),
+		r.SaveEnvelope(f.ctx, &other),
+		r.SaveTransaction(f.ctx, &income),
+		r.SaveTransaction(f.ctx, &split),
+		r.SaveTransaction(f.ctx, &later),
+	} {
+		if err != nil {
+			t.Fatalf("setup: %v", err)
+		}
+	}
+
+	flows, err := r.ListEnvelopeFlows(f.ctx, next.StartDate)
+	if err != nil {
+		t.Fatalf("ListEnvelopeFlows: %v", err)
+	}
+	// The fixture transaction of -1000, the income and the first split line are in the fixture envelope.
+	want := map[uuid.UUID]int64{f.envelope.ID: -1000 + 5000 - 100, other.ID: -200}
+	if len(flows) != len(want) {
+		t.Fatalf("expected %d flows of the first period, got %+v", len(want), flows)
+	}
+	for _, flow := range flows {
+		if flow.PeriodID != f.period.ID || flow.Net != want[flow.EnvelopeID] {
+			t.Errorf("unexpected flow %+v", flow)
+		}
+	}
+
+	flows, err = r.ListEnvelopeFlows(f.ctx, next.EndDate)
+	if err != nil || len(flows) != 3 || flows[2].PeriodID != next.ID || flows[2].Net != 700 {
+		t.Errorf("expected the flow of the next period last, got %+v, %v", flows, err)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
 	"github.com/google/uuid"
@@ -44,7 +46,12 @@ func NewPostgresTransactionManager(db *pgxpool.Pool) service.TransactionManager
 }
 
 func (m *psqlTxManager) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	tx, err := m.db.Begin(ctx)
+	begin := m.db.Begin
+	if outer, ok := ctx.Value(uowKey{}).(pgx.Tx); ok {
+		// Nested work runs in a savepoint and is committed with the enclosing transaction.
+		begin = outer.Begin
+	}
+	tx, err := begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -65,34 +72,133 @@ func (m *psqlTxManager) WithTx(ctx context.Context, fn func(ctx context.Context)
 	return tx.Commit(ctx)
 }
 
+// versionMiss tells why a version checked change affected no row: either the row selected by exists,
+// given the ID and household, is gone, or another change replaced the version the change was based on.
+func (r *psqlRepo) versionMiss(ctx context.Context, exists string, id, householdID uuid.UUID) error {
+	var found bool
+	if err := r.getDB(ctx).QueryRow(ctx, `SELECT EXISTS (`+exists+`)`, id, householdID).Scan(&found); err != nil {
+		return err
+	}
+	if found {
+		return service.ErrConflict
+	}
+	return service.ErrNotFound
+}
+
+const userColumns = `id, COALESCE(subject, ''), name, COALESCE(email, '')`
+
+func scanUser(row pgx.Row, u *service.User) error {
+	return row.Scan(&u.ID, &u.Subject, &u.Name, &u.Email)
+}
+
 func (r *psqlRepo) SaveUser(ctx context.Context, u *service.User) error {
-	query := `INSERT INTO users (id, name) VALUES ($1, $2)
-              ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name`
-	_, err := r.getDB(ctx).Exec(ctx, query, u.ID, u.Name)
+	query := `INSERT INTO users (id, subject, name, email) VALUES ($1, $2, $3, $4)
+              ON CONFLICT (id) DO UPDATE SET
+                subject = EXCLUDED.subject,
+                name = EXCLUDED.name,
+                email = EXCLUDED.email`
+	_, err := r.getDB(ctx).Exec(ctx, query, u.ID, nullIfEmpty(u.Subject), u.Name, nullIfEmpty(u.Email))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+			return service.ErrConflict
+		}
+	}
 	return err
 }
 
 func (r *psqlRepo) GetUser(ctx context.Context, id uuid.UUID) (*service.User, error) {
-	query := `SELECT id, name FROM users WHERE id = $1`
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+	u := &service.User{}
+	err := scanUser(r.getDB(ctx).QueryRow(ctx, query, id), u)
+	if err == pgx.ErrNoRows {
+		return nil, service.ErrNotFound
+	}
+	return u, err
+}
+
+func (r *psqlRepo) GetUserBySubject(ctx context.Context, subject string) (*service.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE subject = $1`
 	u := &service.User{}
-	err := r.getDB(ctx).QueryRow(ctx, query, id).Scan(&u.ID, &u.Name)
+	err := scanUser(r.getDB(ctx).QueryRow(ctx, query, subject), u)
 	if err == pgx.ErrNoRows {
 		return nil, service.ErrNotFound
 	}
 	return u, err
 }
 
+// ListUsers lists the members of the household.
+func (r *psqlRepo) ListUsers(ctx context.Context) ([]service.User, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + userColumns + ` FROM users
+              WHERE id IN (SELECT user_id FROM household_members WHERE household_id = $1)
+              ORDER BY name`
+	rows, err := r.getDB(ctx).Query(ctx, query, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []service.User
+	for rows.Next() {
+		var u service.User
+		if err := scanUser(rows, &u); err != nil {
+			return nil, err
+		}
+		res = append(res, u)
+	}
+	return res, nil
+}
+
+const periodColumns = `id, start_dt, end_dt, default_envelope_id, version`
+
+const periodExists = `SELECT 1 FROM financial_periods WHERE id = $1 AND household_id = $2`
+
+func scanPeriod(row pgx.Row, p *service.Period) error {
+	return row.Scan(&p.ID, &p.StartDate, &p.EndDate, &p.DefaultEnvelopeID, &p.Version)
+}
+
 func (r *psqlRepo) SavePeriod(ctx context.Context, p *service.Period) error {
-	query := `INSERT INTO financial_periods (id, start_dt, end_dt, default_envelope_id) VALUES ($1, $2, $3, $4)
-              ON CONFLICT (id) DO UPDATE SET start_dt = EXCLUDED.start_dt, end_dt = EXCLUDED.end_dt, default_envelope_id = EXCLUDED.default_envelope_id`
-	_, err := r.getDB(ctx).Exec(ctx, query, p.ID, p.StartDate, p.EndDate, p.DefaultEnvelopeID)
-	return err
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	version := p.Version + 1
+	query := `INSERT INTO financial_periods (id, household_id, start_dt, end_dt, default_envelope_id, version) VALUES ($1, $2, $3, $4, $5, $6)
+              ON CONFLICT (id) DO UPDATE SET start_dt = EXCLUDED.start_dt, end_dt = EXCLUDED.end_dt, default_envelope_id = EXCLUDED.default_envelope_id,
+                version = EXCLUDED.version
+              WHERE financial_periods.household_id = EXCLUDED.household_id AND financial_periods.version = EXCLUDED.version - 1`
+	result, err := r.getDB(ctx).Exec(ctx, query, p.ID, householdID, p.StartDate, p.EndDate, p.DefaultEnvelopeID, version)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23P01": // exclusion_violation, a concurrently created period overlaps
+				return service.ErrPeriodOverlap
+			case "23514": // check_violation
+				return service.ErrValidation
+			}
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return r.versionMiss(ctx, periodExists, p.ID, householdID)
+	}
+	p.Version = version
+	return nil
 }
 
 func (r *psqlRepo) GetPeriod(ctx context.Context, id uuid.UUID) (*service.Period, error) {
-	query := `SELECT id, start_dt, end_dt, default_envelope_id FROM financial_periods WHERE id = $1`
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + periodColumns + ` FROM financial_periods WHERE id = $1 AND household_id = $2`
 	p := &service.Period{}
-	err := r.getDB(ctx).QueryRow(ctx, query, id).Scan(&p.ID, &p.StartDate, &p.EndDate, &p.DefaultEnvelopeID)
+	err = scanPeriod(r.getDB(ctx).QueryRow(ctx, query, id, householdID), p)
 	if err == pgx.ErrNoRows {
 		return nil, service.ErrNotFound
 	}
@@ -100,11 +206,15 @@ func (r *psqlRepo) GetPeriod(ctx context.Context, id uuid.UUID) (*service.Period
 }
 
 func (r *psqlRepo) GetCurrentPeriod(ctx context.Context) (*service.Period, error) {
-	query := `SELECT id, start_dt, end_dt, default_envelope_id FROM financial_periods
-              WHERE NOW() BETWEEN start_dt AND end_dt
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + periodColumns + ` FROM financial_periods
+              WHERE household_id = $1 AND start_dt <= NOW() AND NOW() < end_dt
               ORDER BY start_dt ASC LIMIT 1`
 	p := &service.Period{}
-	err := r.getDB(ctx).QueryRow(ctx, query).Scan(&p.ID, &p.StartDate, &p.EndDate, &p.DefaultEnvelopeID)
+	err = scanPeriod(r.getDB(ctx).QueryRow(ctx, query, householdID), p)
 	if err == pgx.ErrNoRows {
 		return nil, service.ErrNotFound
 	}
@@ -112,8 +222,12 @@ func (r *psqlRepo) GetCurrentPeriod(ctx context.Context) (*service.Period, error
 }
 
 func (r *psqlRepo) ListPeriods(ctx context.Context) ([]service.Period, error) {
-	query := `SELECT id, start_dt, end_dt, default_envelope_id FROM financial_periods ORDER BY start_dt DESC`
-	rows, err := r.getDB(ctx).Query(ctx, query)
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + periodColumns + ` FROM financial_periods WHERE household_id = $1 ORDER BY start_dt DESC`
+	rows, err := r.getDB(ctx).Query(ctx, query, householdID)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +236,7 @@ func (r *psqlRepo) ListPeriods(ctx context.Context) ([]service.Period, error) {
 	var res []service.Period
 	for rows.Next() {
 		var p service.Period
-		if err := rows.Scan(&p.ID, &p.StartDate, &p.EndDate, &p.DefaultEnvelopeID); err != nil {
+		if err := scanPeriod(rows, &p); err != nil {
 			return nil, err
 		}
 		res = append(res, p)
@@ -130,16 +244,80 @@ func (r *psqlRepo) ListPeriods(ctx context.Context) ([]service.Period, error) {
 	return res, nil
 }
 
+func (r *psqlRepo) DeletePeriod(ctx context.Context, id uuid.UUID, version int64) error {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	// Transactions in the trash cannot be restored without their period, so they go with it.
+	query := `DELETE FROM transactions WHERE financial_period_id = $1 AND household_id = $2 AND deleted_at IS NOT NULL`
+	if _, err := r.getDB(ctx).Exec(ctx, query, id, householdID); err != nil {
+		return err
+	}
+	query = `DELETE FROM financial_periods WHERE id = $1 AND household_id = $2 AND version = $3`
+	result, err := r.getDB(ctx).Exec(ctx, query, id, householdID, version)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+			return service.ErrConflict
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return r.versionMiss(ctx, periodExists, id, householdID)
+	}
+	return nil
+}
+
+const envelopeColumns = `id, name, rollover_policy, deleted_at, version`
+
+const envelopeExists = `SELECT 1 FROM envelopes WHERE id = $1 AND household_id = $2 AND deleted_at IS NULL`
+
+func scanEnvelope(row pgx.Row, e *service.Envelope) error {
+	return row.Scan(&e.ID, &e.Name, &e.RolloverPolicy, &e.DeletedAt, &e.Version)
+}
+
 func (r *psqlRepo) SaveEnvelope(ctx context.Context, e *service.Envelope) error {
-	query := `INSERT INTO envelopes (id, name) VALUES ($1, $2)
-              ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name`
-	_, err := r.getDB(ctx).Exec(ctx, query, e.ID, e.Name)
-	return err
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	version := e.Version + 1
+	query := `INSERT INTO envelopes (id, household_id, name, rollover_policy, version) VALUES ($1, $2, $3, $4, $5)
+              ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, rollover_policy = EXCLUDED.rollover_policy, version = EXCLUDED.version
+              WHERE envelopes.household_id = EXCLUDED.household_id AND envelopes.version = EXCLUDED.version - 1`
+	result, err := r.getDB(ctx).Exec(ctx, query, e.ID, householdID, e.Name, e.RolloverPolicy, version)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return r.versionMiss(ctx, envelopeExists, e.ID, householdID)
+	}
+	e.Version = version
+	return nil
+}
+
+func (r *psqlRepo) GetEnvelope(ctx context.Context, id uuid.UUID) (*service.Envelope, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + envelopeColumns + ` FROM envelopes WHERE id = $1 AND household_id = $2 AND deleted_at IS NULL`
+	e := &service.Envelope{}
+	err = scanEnvelope(r.getDB(ctx).QueryRow(ctx, query, id, householdID), e)
+	if err == pgx.ErrNoRows {
+		return nil, service.ErrNotFound
+	}
+	return e, err
 }
 
 func (r *psqlRepo) ListEnvelopes(ctx context.Context) ([]service.Envelope, error) {
-	query := `SELECT id, name FROM envelopes`
-	rows, err := r.getDB(ctx).Query(ctx, query)
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + envelopeColumns + ` FROM envelopes WHERE household_id = $1 AND deleted_at IS NULL`
+	rows, err := r.getDB(ctx).Query(ctx, query, householdID)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +326,7 @@ func (r *psqlRepo) ListEnvelopes(ctx context.Context) ([]service.Envelope, error
 	var res []service.Envelope
 	for rows.Next() {
 		var e service.Envelope
-		if err := rows.Scan(&e.ID, &e.Name); err != nil {
+		if err := scanEnvelope(rows, &e); err != nil {
 			return nil, err
 		}
 		res = append(res, e)
@@ -156,53 +334,239 @@ func (r *psqlRepo) ListEnvelopes(ctx context.Context) ([]service.Envelope, error
 	return res, nil
 }
 
-func (r *psqlRepo) DeleteEnvelope(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM envelopes WHERE id = $1`
-	result, err := r.getDB(ctx).Exec(ctx, query, id)
+// DeleteEnvelope moves the envelope to the trash. Envelopes still used by transactions
+// outside the trash, recurring transactions or rules cannot be deleted.
+func (r *psqlRepo) DeleteEnvelope(ctx context.Context, id uuid.UUID, version int64) error {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	db := r.getDB(ctx)
+
+	query := `SELECT EXISTS (SELECT 1 FROM transactions WHERE envelope_id = $1 AND household_id = $2 AND deleted_at IS NULL)
+                  OR EXISTS (SELECT 1 FROM transaction_splits s JOIN transactions tr ON tr.id = s.transaction_id
+                             WHERE s.envelope_id = $1 AND s.household_id = $2 AND tr.deleted_at IS NULL)
+                  OR EXISTS (SELECT 1 FROM recurring_transactions WHERE envelope_id = $1 AND household_id = $2)
+                  OR EXISTS (SELECT 1 FROM rules WHERE envelope_id = $1 AND household_id = $2)`
+	var inUse bool
+	if err := db.QueryRow(ctx, query, id, householdID).Scan(&inUse); err != nil {
+		return err
+	}
+	if inUse {
+		return service.ErrConflict
+	}
+
+	query = `UPDATE envelopes SET deleted_at = NOW(), version = version + 1
+              WHERE id = $1 AND household_id = $2 AND deleted_at IS NULL AND version = $3`
+	result, err := db.Exec(ctx, query, id, householdID, version)
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
-			return service.ErrConflict
-		}
 		return err
 	}
 	if result.RowsAffected() == 0 {
-		return service.ErrNotFound
+		return r.versionMiss(ctx, envelopeExists, id, householdID)
 	}
-	return nil
+	// Like a deleted envelope used to, a trashed one stops being the default of periods.
+	query = `UPDATE financial_periods SET default_envelope_id = NULL, version = version + 1
+              WHERE default_envelope_id = $1 AND household_id = $2`
+	_, err = db.Exec(ctx, query, id, householdID)
+	return err
+}
+
+// likeEscaper escapes LIKE wildcards in user supplied search text.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+const transactionColumns = `id, financial_period_id, envelope_id, category, amount, description, date, transfer_id, external_ref, created_by, updated_by, deleted_at, version`
+
+const transactionExists = `SELECT 1 FROM transactions WHERE id = $1 AND household_id = $2 AND deleted_at IS NULL`
+
+func scanTransaction(row pgx.Row, t *service.Transaction) error {
+	return row.Scan(&t.ID, &t.PeriodID, &t.EnvelopeID, &t.Category, &t.Amount, &t.Description, &t.Date, &t.TransferID, &t.ExternalRef, &t.CreatedBy, &t.UpdatedBy, &t.DeletedAt, &t.Version)
 }
 
 func (r *psqlRepo) SaveTransaction(ctx context.Context, t *service.Transaction) error {
-	query := `INSERT INTO transactions (id, financial_period_id, envelope_id, category, amount, description, date)
-              VALUES ($1, $2, $3, $4, $5, $6, $7)
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	// created_by is immutable once the transaction exists
+	version := t.Version + 1
+	query := `INSERT INTO transactions (id, household_id, financial_period_id, envelope_id, category, amount, description, date, transfer_id, external_ref, created_by, updated_by, version)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
               ON CONFLICT (id) DO UPDATE SET 
                 financial_period_id = EXCLUDED.financial_period_id,
                 envelope_id = EXCLUDED.envelope_id,
                 category = EXCLUDED.category,
                 amount = EXCLUDED.amount,
                 description = EXCLUDED.description,
-                date = EXCLUDED.date`
-	_, err := r.getDB(ctx).Exec(ctx, query, t.ID, t.PeriodID, t.EnvelopeID, t.Category, t.Amount, t.Description, t.Date)
-	return err
+                date = EXCLUDED.date,
+                transfer_id = EXCLUDED.transfer_id,
+                external_ref = EXCLUDED.external_ref,
+                updated_by = EXCLUDED.updated_by,
+                version = EXCLUDED.version
+              WHERE transactions.household_id = EXCLUDED.household_id AND transactions.version = EXCLUDED.version - 1`
+	result, err := r.getDB(ctx).Exec(ctx, query, t.ID, householdID, t.PeriodID, t.EnvelopeID, t.Category, t.Amount, t.Description, t.Date, t.TransferID, t.ExternalRef, t.CreatedBy, t.UpdatedBy, version)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+			return service.ErrConflict
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return r.versionMiss(ctx, transactionExists, t.ID, householdID)
+	}
+	t.Version = version
+	return r.saveSplits(ctx, householdID, t)
+}
+
+// saveSplits replaces the split lines of the transaction.
+func (r *psqlRepo) saveSplits(ctx context.Context, householdID uuid.UUID, t *service.Transaction) error {
+	db := r.getDB(ctx)
+	if _, err := db.Exec(ctx, `DELETE FROM transaction_splits WHERE transaction_id = $1 AND household_id = $2`, t.ID, householdID); err != nil {
+		return err
+	}
+	for i, line := range t.Splits {
+		query := `INSERT INTO transaction_splits (transaction_id, household_id, line_no, envelope_id, category, amount) VALUES ($1, $2, $3, $4, $5, $6)`
+		if _, err := db.Exec(ctx, query, t.ID, householdID, i, line.EnvelopeID, line.Category, line.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadSplits fills in the split lines of the given transactions.
+func (r *psqlRepo) loadSplits(ctx context.Context, txs []service.Transaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+	index := make(map[uuid.UUID]int, len(txs))
+	ids := make([]uuid.UUID, len(txs))
+	for i, t := range txs {
+		index[t.ID] = i
+		ids[i] = t.ID
+	}
+
+	query := `SELECT transaction_id, envelope_id, category, amount FROM transaction_splits WHERE transaction_id = ANY($1) ORDER BY transaction_id, line_no`
+	rows, err := r.getDB(ctx).Query(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var txID uuid.UUID
+		var line service.TransactionSplit
+		if err := rows.Scan(&txID, &line.EnvelopeID, &line.Category, &line.Amount); err != nil {
+			return err
+		}
+		t := &txs[index[txID]]
+		t.Splits = append(t.Splits, line)
+	}
+	return rows.Err()
 }
 
 func (r *psqlRepo) ListTransactions(ctx context.Context, filter service.TransactionFilter) ([]service.Transaction, error) {
-	query := `SELECT id, financial_period_id, envelope_id, category, amount, description, date FROM transactions WHERE 1=1`
-	var args []interface{}
-	argCount := 1
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + transactionColumns + ` FROM transactions WHERE household_id = $1`
+	args := []interface{}{householdID}
+	argCount := 2
 
+	if !filter.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 	if filter.PeriodID != nil {
 		query += fmt.Sprintf(" AND financial_period_id = $%d", argCount)
 		args = append(args, *filter.PeriodID)
 		argCount++
 	}
 	if filter.EnvelopeID != nil {
-		query += fmt.Sprintf(" AND envelope_id = $%d", argCount)
+		query += fmt.Sprintf(" AND (envelope_id = $%d OR id IN (SELECT transaction_id FROM transaction_splits WHERE envelope_id = $%[1]d))", argCount)
 		args = append(args, *filter.EnvelopeID)
 		argCount++
 	}
+	if filter.TransferID != nil {
+		query += fmt.Sprintf(" AND transfer_id = $%d", argCount)
+		args = append(args, *filter.TransferID)
+		argCount++
+	}
+	if filter.ExternalRef != nil {
+		query += fmt.Sprintf(" AND external_ref = $%d", argCount)
+		args = append(args, *filter.ExternalRef)
+		argCount++
+	}
+	if filter.CreatedBy != nil {
+		query += fmt.Sprintf(" AND created_by = $%d", argCount)
+		args = append(args, *filter.CreatedBy)
+		argCount++
+	}
+	if filter.DateFrom != nil {
+		query += fmt.Sprintf(" AND date >= $%d", argCount)
+		args = append(args, *filter.DateFrom)
+		argCount++
+	}
+	if filter.DateTo != nil {
+		query += fmt.Sprintf(" AND date <= $%d", argCount)
+		args = append(args, *filter.DateTo)
+		argCount++
+	}
+	if filter.MinAmount != nil {
+		query += fmt.Sprintf(" AND amount >= $%d", argCount)
+		args = append(args, *filter.MinAmount)
+		argCount++
+	}
+	if filter.MaxAmount != nil {
+		query += fmt.Sprintf(" AND amount <= $%d", argCount)
+		args = append(args, *filter.MaxAmount)
+		argCount++
+	}
+	if filter.Category != nil {
+		query += fmt.Sprintf(" AND (category = $%d OR id IN (SELECT transaction_id FROM transaction_splits WHERE category = $%[1]d))", argCount)
+		args = append(args, *filter.Category)
+		argCount++
+	}
+	if filter.Description != nil {
+		query += fmt.Sprintf(` AND description ILIKE '%%' || $%d || '%%'`, argCount)
+		args = append(args, likeEscaper.Replace(*filter.Description))
+		argCount++
+	}
+	if filter.Sign != nil {
+		switch *filter.Sign {
+		case service.SignIncome:
+			query += " AND amount > 0"
+		case service.SignExpense:
+			query += " AND amount < 0"
+		}
+	}
+
+	// Keyset pagination: the transaction ID breaks ties so the order is total.
+	column, direction, op := "date", "DESC", "<"
+	switch filter.Sort {
+	case service.SortDateAsc:
+		direction, op = "ASC", ">"
+	case service.SortAmountDesc:
+		column = "amount"
+	case service.SortAmountAsc:
+		column, direction, op = "amount", "ASC", ">"
+	}
+	if filter.After != nil {
+		query += fmt.Sprintf(" AND (%s, id) %s ($%d, $%d)", column, op, argCount, argCount+1)
+		if column == "amount" {
+			args = append(args, filter.After.Amount)
+		} else {
+			args = append(args, filter.After.Date)
+		}
+		args = append(args, filter.After.ID)
+		argCount += 2
+	}
 
-	query += " ORDER BY date DESC"
+	query += fmt.Sprintf(" ORDER BY %s %s, id %[2]s", column, direction)
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argCount)
+		args = append(args, filter.Limit)
+	}
 
 	rows, err := r.getDB(ctx).Query(ctx, query, args...)
 	if err != nil {
@@ -213,48 +577,99 @@ func (r *psqlRepo) ListTransactions(ctx context.Context, filter service.Transact
 	var res []service.Transaction
 	for rows.Next() {
 		var t service.Transaction
-		if err := rows.Scan(&t.ID, &t.PeriodID, &t.EnvelopeID, &t.Category, &t.Amount, &t.Description, &t.Date); err != nil {
+		if err := scanTransaction(rows, &t); err != nil {
 			return nil, err
 		}
 		res = append(res, t)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.loadSplits(ctx, res); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
 func (r *psqlRepo) GetTransaction(ctx context.Context, id uuid.UUID) (*service.Transaction, error) {
-	query := `SELECT id, financial_period_id, envelope_id, category, amount, description, date FROM transactions WHERE id = $1`
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + transactionColumns + ` FROM transactions WHERE id = $1 AND household_id = $2 AND deleted_at IS NULL`
 	t := &service.Transaction{}
-	err := r.getDB(ctx).QueryRow(ctx, query, id).Scan(&t.ID, &t.PeriodID, &t.EnvelopeID, &t.Category, &t.Amount, &t.Description, &t.Date)
+	err = scanTransaction(r.getDB(ctx).QueryRow(ctx, query, id, householdID), t)
 	if err == pgx.ErrNoRows {
 		return nil, service.ErrNotFound
 	}
-	return t, err
+	if err != nil {
+		return nil, err
+	}
+	txs := []service.Transaction{*t}
+	if err := r.loadSplits(ctx, txs); err != nil {
+		return nil, err
+	}
+	return &txs[0], nil
+}
+
+func (r *psqlRepo) TransactionExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return false, err
+	}
+	var found bool
+	query := `SELECT EXISTS (SELECT 1 FROM transactions WHERE id = $1 AND household_id = $2)`
+	err = r.getDB(ctx).QueryRow(ctx, query, id, householdID).Scan(&found)
+	return found, err
 }
 
-func (r *psqlRepo) DeleteTransaction(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM transactions WHERE id = $1`
-	result, err := r.getDB(ctx).Exec(ctx, query, id)
+// DeleteTransaction moves the transaction to the trash.
+func (r *psqlRepo) DeleteTransaction(ctx context.Context, id uuid.UUID, version int64) error {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE transactions SET deleted_at = NOW(), version = version + 1
+              WHERE id = $1 AND household_id = $2 AND deleted_at IS NULL AND version = $3`
+	result, err := r.getDB(ctx).Exec(ctx, query, id, householdID, version)
 	if err != nil {
 		return err
 	}
 	if result.RowsAffected() == 0 {
-		return service.ErrNotFound
+		return r.versionMiss(ctx, transactionExists, id, householdID)
 	}
 	return nil
 }
 
 func (r *psqlRepo) GetPeriodStats(ctx context.Context, periodID uuid.UUID) ([]service.EnvelopeStat, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `
 		SELECT 
 			e.id, 
 			e.name,
-			COALESCE(SUM(CASE WHEN t.amount > 0 THEN t.amount ELSE 0 END), 0) as allocated,
-			COALESCE(SUM(CASE WHEN t.amount < 0 THEN ABS(t.amount) ELSE 0 END), 0) as spent
+			e.rollover_policy,
+			COALESCE(SUM(CASE WHEN t.amount > 0 OR t.transfer_id IS NOT NULL THEN t.amount ELSE 0 END), 0) as allocated,
+			COALESCE(SUM(CASE WHEN t.amount < 0 AND t.transfer_id IS NULL THEN ABS(t.amount) ELSE 0 END), 0) as spent
 		FROM envelopes e
-		LEFT JOIN transactions t ON e.id = t.envelope_id AND t.financial_period_id = $1
-		GROUP BY e.id, e.name
+		LEFT JOIN (
+			-- Split transactions are accounted for by their lines
+			SELECT tr.envelope_id, tr.amount, tr.transfer_id
+			FROM transactions tr
+			WHERE tr.financial_period_id = $1 AND tr.household_id = $2 AND tr.deleted_at IS NULL
+				AND NOT EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = tr.id)
+			UNION ALL
+			SELECT s.envelope_id, s.amount, tr.transfer_id
+			FROM transaction_splits s
+			JOIN transactions tr ON tr.id = s.transaction_id
+			WHERE tr.financial_period_id = $1 AND tr.household_id = $2 AND tr.deleted_at IS NULL
+		) t ON e.id = t.envelope_id
+		WHERE e.household_id = $2 AND e.deleted_at IS NULL
+		GROUP BY e.id, e.name, e.rollover_policy
 	`
-	rows, err := r.getDB(ctx).Query(ctx, query, periodID)
+	rows, err := r.getDB(ctx).Query(ctx, query, periodID, householdID)
 	if err != nil {
 		return nil, err
 	}
@@ -263,11 +678,101 @@ func (r *psqlRepo) GetPeriodStats(ctx context.Context, periodID uuid.UUID) ([]se
 	var stats []service.EnvelopeStat
 	for rows.Next() {
 		var stat service.EnvelopeStat
-		if err := rows.Scan(&stat.Envelope.ID, &stat.Envelope.Name, &stat.Allocated, &stat.Spent); err != nil {
+		if err := rows.Scan(&stat.Envelope.ID, &stat.Envelope.Name, &stat.Envelope.RolloverPolicy, &stat.Allocated, &stat.Spent); err != nil {
 			return nil, err
 		}
 		stat.Remaining = stat.Allocated - stat.Spent
 		stats = append(stats, stat)
 	}
-	return stats, nil
+	return stats, rows.Err()
+}
+
+func (r *psqlRepo) ListEnvelopeFlows(ctx context.Context, before time.Time) ([]service.EnvelopeFlow, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		SELECT p.id, t.envelope_id, SUM(t.amount)
+		FROM financial_periods p
+		JOIN (
+			-- Split transactions are accounted for by their lines
+			SELECT tr.financial_period_id, tr.envelope_id, tr.amount
+			FROM transactions tr
+			WHERE tr.household_id = $2 AND tr.deleted_at IS NULL
+				AND NOT EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = tr.id)
+			UNION ALL
+			SELECT tr.financial_period_id, s.envelope_id, s.amount
+			FROM transaction_splits s
+			JOIN transactions tr ON tr.id = s.transaction_id
+			WHERE tr.household_id = $2 AND tr.deleted_at IS NULL
+		) t ON t.financial_period_id = p.id
+		WHERE p.household_id = $2 AND p.start_dt < $1
+		GROUP BY p.start_dt, p.id, t.envelope_id
+		ORDER BY p.start_dt
+	`
+	rows, err := r.getDB(ctx).Query(ctx, query, before, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var flows []service.EnvelopeFlow
+	for rows.Next() {
+		var f service.EnvelopeFlow
+		if err := rows.Scan(&f.PeriodID, &f.EnvelopeID, &f.Net); err != nil {
+			return nil, err
+		}
+		flows = append(flows, f)
+	}
+	return flows, rows.Err()
+}
+
+func (r *psqlRepo) GetMemberStats(ctx context.Context, periodID uuid.UUID) ([]service.MemberSpending, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		WITH totals AS (
+			SELECT
+				created_by,
+				SUM(CASE WHEN amount < 0 THEN -amount ELSE 0 END) AS spent,
+				SUM(CASE WHEN amount > 0 THEN amount ELSE 0 END) AS income,
+				COUNT(*) AS transaction_count
+			FROM transactions
+			WHERE financial_period_id = $1 AND household_id = $2 AND transfer_id IS NULL AND deleted_at IS NULL
+			GROUP BY created_by
+		)
+		SELECT u.id, u.name, COALESCE(t.spent, 0), COALESCE(t.income, 0), COALESCE(t.transaction_count, 0), FALSE
+		FROM users u
+		JOIN household_members m ON m.user_id = u.id AND m.household_id = $2
+		LEFT JOIN totals t ON t.created_by = u.id
+		UNION ALL
+		SELECT NULL, '', spent, income, transaction_count, FALSE
+		FROM totals
+		WHERE created_by IS NULL
+		UNION ALL
+		SELECT NULL, '', SUM(spent), SUM(income), SUM(transaction_count), TRUE
+		FROM totals t
+		WHERE created_by IS NOT NULL
+			AND NOT EXISTS (SELECT 1 FROM household_members m WHERE m.household_id = $2 AND m.user_id = t.created_by)
+		HAVING COUNT(*) > 0
+		ORDER BY 3 DESC, 2
+	`
+	rows, err := r.getDB(ctx).Query(ctx, query, periodID, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []service.MemberSpending
+	for rows.Next() {
+		var m service.MemberSpending
+		if err := rows.Scan(&m.UserID, &m.UserName, &m.Spent, &m.Income, &m.TransactionCount, &m.FormerMember); err != nil {
+			return nil, err
+		}
+		res = append(res, m)
+	}
+	return res, nil
 }
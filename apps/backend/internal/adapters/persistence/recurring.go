@@ -0,0 +1,132 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const recurringTransactionColumns = `id, envelope_id, category, amount, description, schedule_kind, day_of_month, interval_weeks, anchor_date, version`
+
+const recurringTransactionExists = `SELECT 1 FROM recurring_transactions WHERE id = $1 AND household_id = $2`
+
+func scanRecurringTransaction(row pgx.Row, rt *service.RecurringTransaction) error {
+	var (
+		dayOfMonth, intervalWeeks *int
+		anchorDate                *time.Time
+	)
+	if err := row.Scan(&rt.ID, &rt.EnvelopeID, &rt.Category, &rt.Amount, &rt.Description,
+		&rt.Schedule.Kind, &dayOfMonth, &intervalWeeks, &anchorDate, &rt.Version); err != nil {
+		return err
+	}
+	if dayOfMonth != nil {
+		rt.Schedule.DayOfMonth = *dayOfMonth
+	}
+	if intervalWeeks != nil {
+		rt.Schedule.IntervalWeeks = *intervalWeeks
+	}
+	if anchorDate != nil {
+		rt.Schedule.AnchorDate = *anchorDate
+	}
+	return nil
+}
+
+func (r *psqlRepo) SaveRecurringTransaction(ctx context.Context, rt *service.RecurringTransaction) error {
+	// Only the schedule fields relevant to the schedule kind are persisted, the rest are stored as NULL.
+	var (
+		dayOfMonth, intervalWeeks *int
+		anchorDate                *time.Time
+	)
+	switch rt.Schedule.Kind {
+	case service.RecurrenceMonthly:
+		dayOfMonth = &rt.Schedule.DayOfMonth
+	case service.RecurrenceWeekly:
+		intervalWeeks = &rt.Schedule.IntervalWeeks
+		anchorDate = &rt.Schedule.AnchorDate
+	}
+
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	version := rt.Version + 1
+	query := `INSERT INTO recurring_transactions (` + recurringTransactionColumns + `, household_id)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+              ON CONFLICT (id) DO UPDATE SET
+                envelope_id = EXCLUDED.envelope_id,
+                category = EXCLUDED.category,
+                amount = EXCLUDED.amount,
+                description = EXCLUDED.description,
+                schedule_kind = EXCLUDED.schedule_kind,
+                day_of_month = EXCLUDED.day_of_month,
+                interval_weeks = EXCLUDED.interval_weeks,
+                anchor_date = EXCLUDED.anchor_date,
+                version = EXCLUDED.version
+              WHERE recurring_transactions.household_id = EXCLUDED.household_id AND recurring_transactions.version = EXCLUDED.version - 1`
+	result, err := r.getDB(ctx).Exec(ctx, query, rt.ID, rt.EnvelopeID, rt.Category, rt.Amount, rt.Description,
+		rt.Schedule.Kind, dayOfMonth, intervalWeeks, anchorDate, version, householdID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return r.versionMiss(ctx, recurringTransactionExists, rt.ID, householdID)
+	}
+	rt.Version = version
+	return nil
+}
+
+func (r *psqlRepo) GetRecurringTransaction(ctx context.Context, id uuid.UUID) (*service.RecurringTransaction, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + recurringTransactionColumns + ` FROM recurring_transactions WHERE id = $1 AND household_id = $2`
+	rt := &service.RecurringTransaction{}
+	err = scanRecurringTransaction(r.getDB(ctx).QueryRow(ctx, query, id, householdID), rt)
+	if err == pgx.ErrNoRows {
+		return nil, service.ErrNotFound
+	}
+	return rt, err
+}
+
+func (r *psqlRepo) ListRecurringTransactions(ctx context.Context) ([]service.RecurringTransaction, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + recurringTransactionColumns + ` FROM recurring_transactions WHERE household_id = $1 ORDER BY description`
+	rows, err := r.getDB(ctx).Query(ctx, query, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []service.RecurringTransaction
+	for rows.Next() {
+		var rt service.RecurringTransaction
+		if err := scanRecurringTransaction(rows, &rt); err != nil {
+			return nil, err
+		}
+		res = append(res, rt)
+	}
+	return res, nil
+}
+
+func (r *psqlRepo) DeleteRecurringTransaction(ctx context.Context, id uuid.UUID, version int64) error {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	query := `DELETE FROM recurring_transactions WHERE id = $1 AND household_id = $2 AND version = $3`
+	result, err := r.getDB(ctx).Exec(ctx, query, id, householdID, version)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return r.versionMiss(ctx, recurringTransactionExists, id, householdID)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const ruleColumns = `id, name, priority, description_pattern, pattern_type, min_amount, max_amount, weekdays, category, envelope_id, version`
+
+const ruleExists = `SELECT 1 FROM rules WHERE id = $1 AND household_id = $2`
+
+func scanRule(row pgx.Row, r *service.Rule) error {
+	var (
+		pattern, category *string
+		weekdays          []int16
+	)
+	if err := row.Scan(&r.ID, &r.Name, &r.Priority, &pattern, &r.PatternType,
+		&r.MinAmount, &r.MaxAmount, &weekdays, &category, &r.EnvelopeID, &r.Version); err != nil {
+		return err
+	}
+	if pattern != nil {
+		r.DescriptionPattern = *pattern
+	}
+	if category != nil {
+		r.Category = *category
+	}
+	r.Weekdays = make([]time.Weekday, len(weekdays))
+	for i, wd := range weekdays {
+		r.Weekdays[i] = time.Weekday(wd)
+	}
+	return nil
+}
+
+// nullIfEmpty stores empty optional strings as NULL.
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func (r *psqlRepo) SaveRule(ctx context.Context, rule *service.Rule) error {
+	weekdays := make([]int16, len(rule.Weekdays))
+	for i, wd := range rule.Weekdays {
+		weekdays[i] = int16(wd)
+	}
+
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	version := rule.Version + 1
+	query := `INSERT INTO rules (` + ruleColumns + `, household_id)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+              ON CONFLICT (id) DO UPDATE SET
+                name = EXCLUDED.name,
+                priority = EXCLUDED.priority,
+                description_pattern = EXCLUDED.description_pattern,
+                pattern_type = EXCLUDED.pattern_type,
+                min_amount = EXCLUDED.min_amount,
+                max_amount = EXCLUDED.max_amount,
+                weekdays = EXCLUDED.weekdays,
+                category = EXCLUDED.category,
+                envelope_id = EXCLUDED.envelope_id,
+                version = EXCLUDED.version
+              WHERE rules.household_id = EXCLUDED.household_id AND rules.version = EXCLUDED.version - 1`
+	result, err := r.getDB(ctx).Exec(ctx, query, rule.ID, rule.Name, rule.Priority, nullIfEmpty(rule.DescriptionPattern), rule.PatternType,
+		rule.MinAmount, rule.MaxAmount, weekdays, nullIfEmpty(rule.Category), rule.EnvelopeID, version, householdID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return r.versionMiss(ctx, ruleExists, rule.ID, householdID)
+	}
+	rule.Version = version
+	return nil
+}
+
+func (r *psqlRepo) GetRule(ctx context.Context, id uuid.UUID) (*service.Rule, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + ruleColumns + ` FROM rules WHERE id = $1 AND household_id = $2`
+	rule := &service.Rule{}
+	err = scanRule(r.getDB(ctx).QueryRow(ctx, query, id, householdID), rule)
+	if err == pgx.ErrNoRows {
+		return nil, service.ErrNotFound
+	}
+	return rule, err
+}
+
+func (r *psqlRepo) ListRules(ctx context.Context) ([]service.Rule, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + ruleColumns + ` FROM rules WHERE household_id = $1 ORDER BY priority, name`
+	rows, err := r.getDB(ctx).Query(ctx, query, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []service.Rule
+	for rows.Next() {
+		var rule service.Rule
+		if err := scanRule(rows, &rule); err != nil {
+			return nil, err
+		}
+		res = append(res, rule)
+	}
+	return res, nil
+}
+
+func (r *psqlRepo) DeleteRule(ctx context.Context, id uuid.UUID, version int64) error {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	query := `DELETE FROM rules WHERE id = $1 AND household_id = $2 AND version = $3`
+	result, err := r.getDB(ctx).Exec(ctx, query, id, householdID, version)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return r.versionMiss(ctx, ruleExists, id, householdID)
+	}
+	return nil
+}
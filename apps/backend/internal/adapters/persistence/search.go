@@ -0,0 +1,62 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+// SearchTransactions ranks transactions by full-text relevance of their descriptions,
+// falling back to trigram similarity so that partial words match as well.
+// Both sides are folded by search_fold, which makes matching case, script and diacritic insensitive.
+func (r *psqlRepo) SearchTransactions(ctx context.Context, query string, limit int) ([]service.TransactionMatch, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sql := `
+		WITH q AS (
+			SELECT websearch_to_tsquery('simple', search_fold($1)) AS tsq, search_fold($1) AS txt
+		)
+		SELECT ` + transactionColumns + `,
+			ts_rank(to_tsvector('simple', search_fold(description)), q.tsq)
+				+ word_similarity(q.txt, search_fold(description)) AS rank
+		FROM transactions, q
+		WHERE household_id = $3 AND deleted_at IS NULL
+			AND (to_tsvector('simple', search_fold(description)) @@ q.tsq
+				OR q.txt <% search_fold(description))
+		ORDER BY rank DESC, date DESC, id
+		LIMIT $2
+	`
+	rows, err := r.getDB(ctx).Query(ctx, sql, query, limit, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		txs   []service.Transaction
+		ranks []float64
+	)
+	for rows.Next() {
+		var t service.Transaction
+		var rank float64
+		if err := rows.Scan(&t.ID, &t.PeriodID, &t.EnvelopeID, &t.Category, &t.Amount, &t.Description, &t.Date, &t.TransferID, &t.ExternalRef, &t.CreatedBy, &t.UpdatedBy, &t.DeletedAt, &t.Version, &rank); err != nil {
+			return nil, err
+		}
+		txs = append(txs, t)
+		ranks = append(ranks, rank)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.loadSplits(ctx, txs); err != nil {
+		return nil, err
+	}
+
+	res := make([]service.TransactionMatch, len(txs))
+	for i := range txs {
+		res[i] = service.TransactionMatch{Transaction: txs[i], Rank: ranks[i]}
+	}
+	return res, nil
+}
@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const accessTokenColumns = `id, user_id, name, token_hash, scope, created_at, expires_at, last_used_at, revoked_at`
+
+func scanAccessToken(row pgx.Row, t *service.AccessToken) error {
+	return row.Scan(&t.ID, &t.UserID, &t.Name, &t.TokenHash, &t.Scope, &t.CreatedAt, &t.ExpiresAt, &t.LastUsedAt, &t.RevokedAt)
+}
+
+func (r *psqlRepo) SaveAccessToken(ctx context.Context, t *service.AccessToken) error {
+	query := `INSERT INTO personal_access_tokens (` + accessTokenColumns + `)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+              ON CONFLICT (id) DO UPDATE SET last_used_at = EXCLUDED.last_used_at, revoked_at = EXCLUDED.revoked_at`
+	_, err := r.getDB(ctx).Exec(ctx, query, t.ID, t.UserID, t.Name, t.TokenHash, t.Scope, t.CreatedAt, t.ExpiresAt, t.LastUsedAt, t.RevokedAt)
+	return err
+}
+
+func (r *psqlRepo) GetAccessToken(ctx context.Context, id uuid.UUID) (*service.AccessToken, error) {
+	query := `SELECT ` + accessTokenColumns + ` FROM personal_access_tokens WHERE id = $1`
+	t := &service.AccessToken{}
+	err := scanAccessToken(r.getDB(ctx).QueryRow(ctx, query, id), t)
+	if err == pgx.ErrNoRows {
+		return nil, service.ErrNotFound
+	}
+	return t, err
+}
+
+func (r *psqlRepo) GetAccessTokenByHash(ctx context.Context, tokenHash string) (*service.AccessToken, error) {
+	query := `SELECT ` + accessTokenColumns + ` FROM personal_access_tokens WHERE token_hash = $1`
+	t := &service.AccessToken{}
+	err := scanAccessToken(r.getDB(ctx).QueryRow(ctx, query, tokenHash), t)
+	if err == pgx.ErrNoRows {
+		return nil, service.ErrNotFound
+	}
+	return t, err
+}
+
+// ListAccessTokens lists the tokens of the user that have not been revoked, newest first.
+func (r *psqlRepo) ListAccessTokens(ctx context.Context, userID uuid.UUID) ([]service.AccessToken, error) {
+	query := `SELECT ` + accessTokenColumns + ` FROM personal_access_tokens
+              WHERE user_id = $1 AND revoked_at IS NULL ORDER BY created_at DESC`
+	rows, err := r.getDB(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []service.AccessToken
+	for rows.Next() {
+		var t service.AccessToken
+		if err := scanAccessToken(rows, &t); err != nil {
+			return nil, err
+		}
+		res = append(res, t)
+	}
+	return res, rows.Err()
+}
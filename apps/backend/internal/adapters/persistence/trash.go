@@ -0,0 +1,174 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ListDeletedTransactions lists the transactions in the trash, most recently deleted first.
+func (r *psqlRepo) ListDeletedTransactions(ctx context.Context) ([]service.Transaction, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + transactionColumns + ` FROM transactions
+              WHERE household_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at DESC, id`
+	rows, err := r.getDB(ctx).Query(ctx, query, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []service.Transaction
+	for rows.Next() {
+		var t service.Transaction
+		if err := scanTransaction(rows, &t); err != nil {
+			return nil, err
+		}
+		res = append(res, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.loadSplits(ctx, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RestoreTransaction takes the transaction out of the trash.
+func (r *psqlRepo) RestoreTransaction(ctx context.Context, id uuid.UUID) error {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE transactions SET deleted_at = NULL, version = version + 1 WHERE id = $1 AND household_id = $2 AND deleted_at IS NOT NULL`
+	result, err := r.getDB(ctx).Exec(ctx, query, id, householdID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+	return nil
+}
+
+// ListDeletedEnvelopes lists the envelopes in the trash, most recently deleted first.
+func (r *psqlRepo) ListDeletedEnvelopes(ctx context.Context) ([]service.Envelope, error) {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + envelopeColumns + ` FROM envelopes
+              WHERE household_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at DESC, id`
+	rows, err := r.getDB(ctx).Query(ctx, query, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []service.Envelope
+	for rows.Next() {
+		var e service.Envelope
+		if err := scanEnvelope(rows, &e); err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+	return res, rows.Err()
+}
+
+// RestoreEnvelope takes the envelope out of the trash.
+func (r *psqlRepo) RestoreEnvelope(ctx context.Context, id uuid.UUID) error {
+	householdID, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE envelopes SET deleted_at = NULL, version = version + 1 WHERE id = $1 AND household_id = $2 AND deleted_at IS NOT NULL`
+	result, err := r.getDB(ctx).Exec(ctx, query, id, householdID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+	return nil
+}
+
+// householdRow scans the household ID a row starts with, followed by the columns scanned by the wrapped scanner.
+type householdRow struct {
+	pgx.Row
+	householdID *uuid.UUID
+}
+
+func (r householdRow) Scan(dest ...any) error {
+	return r.Row.Scan(append([]any{r.householdID}, dest...)...)
+}
+
+// PurgeDeleted permanently deletes what was moved to the trash before the given time, in every household,
+// and returns what it deleted. Envelopes still used by transactions in the trash are kept until those are purged as well.
+func (r *psqlRepo) PurgeDeleted(ctx context.Context, before time.Time) ([]service.PurgedItem, error) {
+	db := r.getDB(ctx)
+	// Transactions are read before they are deleted, as their split lines go with them.
+	query := `SELECT household_id, ` + transactionColumns + ` FROM transactions WHERE deleted_at < $1 FOR UPDATE`
+	rows, err := db.Query(ctx, query, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		txs        []service.Transaction
+		households []uuid.UUID
+	)
+	for rows.Next() {
+		var t service.Transaction
+		var householdID uuid.UUID
+		if err := scanTransaction(householdRow{rows, &householdID}, &t); err != nil {
+			return nil, err
+		}
+		txs = append(txs, t)
+		households = append(households, householdID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.loadSplits(ctx, txs); err != nil {
+		return nil, err
+	}
+
+	var purged []service.PurgedItem
+	if len(txs) > 0 {
+		ids := make([]uuid.UUID, len(txs))
+		for i, t := range txs {
+			ids[i] = t.ID
+			purged = append(purged, service.PurgedItem{HouseholdID: households[i], Entity: service.AuditTransaction, ID: t.ID, Item: t})
+		}
+		if _, err := db.Exec(ctx, `DELETE FROM transactions WHERE id = ANY($1)`, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	query = `DELETE FROM envelopes e WHERE e.deleted_at < $1
+              AND NOT EXISTS (SELECT 1 FROM transactions WHERE envelope_id = e.id)
+              AND NOT EXISTS (SELECT 1 FROM transaction_splits WHERE envelope_id = e.id)
+              RETURNING household_id, ` + envelopeColumns
+	rows, err = db.Query(ctx, query, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var e service.Envelope
+		var householdID uuid.UUID
+		if err := scanEnvelope(householdRow{rows, &householdID}, &e); err != nil {
+			return nil, err
+		}
+		purged = append(purged, service.PurgedItem{HouseholdID: householdID, Entity: service.AuditEnvelope, ID: e.ID, Item: e})
+	}
+	return purged, rows.Err()
+}
@@ -0,0 +1,86 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+	"github.com/google/uuid"
+)
+
+func TestTrash(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "trash")
+
+	if err := savepoint(t, f.ctx, func(ctx context.Context) error { return r.DeleteEnvelope(ctx, f.envelope.ID, f.envelope.Version) }); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("deleting an envelope in use: expected ErrConflict, got %v", err)
+	}
+
+	if err := r.DeleteTransaction(f.ctx, f.transaction.ID, f.transaction.Version); err != nil {
+		t.Fatalf("failed to delete transaction: %v", err)
+	}
+	if _, err := r.GetTransaction(f.ctx, f.transaction.ID); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("deleted transaction: expected ErrNotFound, got %v", err)
+	}
+	if txs, _ := r.ListTransactions(f.ctx, service.TransactionFilter{}); len(txs) != 0 {
+		t.Errorf("deleted transaction is still listed")
+	}
+	deleted, err := r.ListDeletedTransactions(f.ctx)
+	if err != nil || len(deleted) != 1 || deleted[0].ID != f.transaction.ID || deleted[0].DeletedAt == nil {
+		t.Fatalf("expected the transaction in the trash, got %v, %v", deleted, err)
+	}
+	if err := r.DeleteTransaction(f.ctx, f.transaction.ID, deleted[0].Version); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("deleting twice: expected ErrNotFound, got %v", err)
+	}
+
+	if err := r.RestoreTransaction(f.ctx, f.transaction.ID); err != nil {
+		t.Fatalf("failed to restore transaction: %v", err)
+	}
+	restored, err := r.GetTransaction(f.ctx, f.transaction.ID)
+	if err != nil {
+		t.Fatalf("restored transaction: unexpected error %v", err)
+	}
+	if err := r.RestoreTransaction(f.ctx, f.transaction.ID); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("restoring a live transaction: expected ErrNotFound, got %v", err)
+	}
+
+	if err := r.DeleteTransaction(f.ctx, f.transaction.ID, restored.Version); err != nil {
+		t.Fatalf("failed to delete transaction: %v", err)
+	}
+	purged, err := r.PurgeDeleted(ctx, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to purge: %v", err)
+	}
+	householdID, _ := service.HouseholdIDFromContext(f.ctx)
+	found := false
+	for _, item := range purged {
+		if item.ID == f.transaction.ID {
+			tx, ok := item.Item.(service.Transaction)
+			found = ok && item.Entity == service.AuditTransaction && tx.Amount == f.transaction.Amount &&
+				item.HouseholdID == householdID
+		}
+	}
+	if !found {
+		t.Errorf("expected the purged transaction reported with its household, got %+v", purged)
+	}
+	if deleted, _ := r.ListDeletedTransactions(f.ctx); len(deleted) != 0 {
+		t.Errorf("expected an empty trash after purging, got %d transactions", len(deleted))
+	}
+}
+
+func TestTransactionExistsInTrash(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "transaction-exists")
+
+	if err := r.DeleteTransaction(f.ctx, f.transaction.ID, f.transaction.Version); err != nil {
+		t.Fatalf("DeleteTransaction: %v", err)
+	}
+	if found, err := r.TransactionExists(f.ctx, f.transaction.ID); err != nil || !found {
+		t.Errorf("trashed transaction: expected to exist, got %v, %v", found, err)
+	}
+	if found, err := r.TransactionExists(f.ctx, uuid.New()); err != nil || found {
+		t.Errorf("unknown transaction: expected not to exist, got %v, %v", found, err)
+	}
+}
@@ -0,0 +1,57 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ChaPerx64/dobby/apps/backend/internal/service"
+)
+
+func TestVersions(t *testing.T) {
+	r, ctx := testTx(t)
+	f := newHouseholdFixture(t, r, ctx, "versions")
+
+	if f.transaction.Version != 1 {
+		t.Fatalf("expected a new transaction at version 1, got %d", f.transaction.Version)
+	}
+	stale := f.transaction
+	updated := f.transaction
+	updated.Amount = -2000
+	if err := r.SaveTransaction(f.ctx, &updated); err != nil {
+		t.Fatalf("failed to update transaction: %v", err)
+	}
+	if updated.Version != 2 {
+		t.Errorf("expected version 2 after an update, got %d", updated.Version)
+	}
+
+	stale.Amount = -3000
+	if err := savepoint(t, f.ctx, func(ctx context.Context) error { return r.SaveTransaction(ctx, &stale) }); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("saving a stale version: expected ErrConflict, got %v", err)
+	}
+	if err := savepoint(t, f.ctx, func(ctx context.Context) error {
+		return r.DeleteTransaction(ctx, f.transaction.ID, f.transaction.Version)
+	}); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("deleting a stale version: expected ErrConflict, got %v", err)
+	}
+
+	rule := f.rule
+	rule.Name = "renamed"
+	if err := r.SaveRule(f.ctx, &rule); err != nil {
+		t.Fatalf("failed to update rule: %v", err)
+	}
+	if err := savepoint(t, f.ctx, func(ctx context.Context) error { return r.DeleteRule(ctx, f.rule.ID, f.rule.Version) }); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("deleting a stale rule: expected ErrConflict, got %v", err)
+	}
+	if err := r.DeleteRule(f.ctx, rule.ID, rule.Version); err != nil {
+		t.Errorf("deleting the current rule: unexpected error %v", err)
+	}
+
+	got, err := r.GetTransaction(f.ctx, f.transaction.ID)
+	if err != nil {
+		t.Fatalf("GetTransaction: %v", err)
+	}
+	if got.Amount != updated.Amount || got.Version != updated.Version {
+		t.Errorf("expected the first update to stick, got %+v", got)
+	}
+}
@@ -5,17 +5,23 @@ import (
 	"log/slog"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	OIDCAuthority           string
-	OIDCBackendClientID     string
-	OIDCBackendClientSecret string
-	BackendPort             string
-	AllowedOrigins          []string
-	DatabaseURL             string
+	OIDCAuthority             string
+	OIDCBackendClientID       string
+	OIDCBackendClientSecret   string
+	OIDCTokenVerification     string        // "introspection" or "jwt", see .env.sample
+	OIDCAudience              string        // Required JWT audience, defaults to the backend client ID
+	OIDCIntrospectionCacheTTL time.Duration // Upper bound for caching active tokens, zero disables the cache
+	BackendPort               string
+	AllowedOrigins            []string
+	DatabaseURL               string
+	TrashRetention            time.Duration // How long deleted items can be restored, zero keeps them forever
+	PeriodGaps                string        // "allow" or "reject", see .env.sample
 }
 
 func Load() Config {
@@ -25,12 +31,17 @@ func Load() Config {
 		slog.Warn("Error loading .env file. Parsing from environment")
 	}
 	return Config{
-		OIDCAuthority:           requireEnv("OIDC_AUTHORITY"),
-		OIDCBackendClientID:     requireEnv("OIDC_BACKEND_CLIENT_ID"),
-		OIDCBackendClientSecret: requireEnv("OIDC_BACKEND_CLIENT_SECRET"),
-		BackendPort:             requireEnv("BACKEND_PORT"),
-		AllowedOrigins:          getEnvAsSlice("ALLOWED_ORIGINS", []string{"*"}),
-		DatabaseURL:             requireEnv("DATABASE_URL"),
+		OIDCAuthority:             requireEnv("OIDC_AUTHORITY"),
+		OIDCBackendClientID:       requireEnv("OIDC_BACKEND_CLIENT_ID"),
+		OIDCBackendClientSecret:   requireEnv("OIDC_BACKEND_CLIENT_SECRET"),
+		OIDCTokenVerification:     getEnv("OIDC_TOKEN_VERIFICATION", "introspection"),
+		OIDCAudience:              os.Getenv("OIDC_AUDIENCE"),
+		OIDCIntrospectionCacheTTL: getEnvAsDuration("OIDC_INTROSPECTION_CACHE_TTL", 5*time.Minute),
+		BackendPort:               requireEnv("BACKEND_PORT"),
+		AllowedOrigins:            getEnvAsSlice("ALLOWED_ORIGINS", []string{"*"}),
+		DatabaseURL:               requireEnv("DATABASE_URL"),
+		TrashRetention:            getEnvAsDuration("TRASH_RETENTION", 30*24*time.Hour),
+		PeriodGaps:                getEnv("PERIOD_GAPS", "allow"),
 	}
 }
 
@@ -42,6 +53,25 @@ func requireEnv(key string) string {
 	return value
 }
 
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
+	valStr, ok := os.LookupEnv(key)
+	if !ok || valStr == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(valStr)
+	if err != nil {
+		log.Fatalf("environment variable %s must be a duration like 5m: %v", key, err)
+	}
+	return d
+}
+
 func getEnvAsSlice(key string, fallback []string) []string {
 	valStr, ok := os.LookupEnv(key)
 	if !ok {
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 500
+)
+
+// AuditEntity is the kind of entity an audit entry is about.
+type AuditEntity string
+
+const (
+	AuditPeriod               AuditEntity = "period"
+	AuditEnvelope             AuditEntity = "envelope"
+	AuditTransaction          AuditEntity = "transaction"
+	AuditRecurringTransaction AuditEntity = "recurring_transaction"
+	AuditRule                 AuditEntity = "rule"
+	AuditMember               AuditEntity = "member"
+	AuditInvitation           AuditEntity = "invitation"
+	AuditAccessToken          AuditEntity = "access_token"
+	AuditPeriodSchedule       AuditEntity = "period_schedule" // Identified by the household ID
+	AuditHolidays             AuditEntity = "holidays"        // Imported holidays, identified by the household ID
+)
+
+// AuditAction tells how an entity changed.
+type AuditAction string
+
+const (
+	AuditCreate AuditAction = "create"
+	AuditUpdate AuditAction = "update"
+	AuditDelete AuditAction = "delete"
+)
+
+// AuditEntry records a single change to household data.
+type AuditEntry struct {
+	ID       uuid.UUID
+	ActorID  *uuid.UUID // Nil for changes not made by a user
+	At       time.Time
+	Entity   AuditEntity
+	EntityID uuid.UUID
+	Action   AuditAction
+	Before   json.RawMessage // Nil on create
+	After    json.RawMessage // Nil on delete
+}
+
+type AuditFilter struct {
+	Entity   *AuditEntity
+	EntityID *uuid.UUID
+	ActorID  *uuid.UUID
+	From     *time.Time // Inclusive
+	To       *time.Time // Inclusive
+	Limit    int        // Defaults to 100, at most 500
+}
+
+// audit appends a record of a change to the audit log of the household. It must be called
+// inside the transaction making the change, so the change and its record commit together.
+// Pass a nil before on create and a nil after on delete.
+func (s *dobbyFinancier) audit(ctx context.Context, entity AuditEntity, id uuid.UUID, before, after any) error {
+	e := &AuditEntry{
+		ID:       uuid.New(),
+		At:       time.Now(),
+		Entity:   entity,
+		EntityID: id,
+		Action:   AuditUpdate,
+	}
+	if actor, ok := UserIDFromContext(ctx); ok {
+		e.ActorID = &actor
+	}
+
+	var err error
+	if e.Before, err = snapshot(before); err != nil {
+		return err
+	}
+	if e.After, err = snapshot(after); err != nil {
+		return err
+	}
+	switch {
+	case e.Before == nil:
+		e.Action = AuditCreate
+	case e.After == nil:
+		e.Action = AuditDelete
+	case bytes.Equal(e.Before, e.After):
+		return nil
+	}
+	return s.repo.SaveAuditEntry(ctx, e)
+}
+
+func snapshot(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot %T: %w", v, err)
+	}
+	return b, nil
+}
+
+// ListAuditEntries lists the audit log of the household, newest first.
+func (s *dobbyFinancier) ListAuditEntries(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultAuditLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxAuditLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrValidation, maxAuditLimit)
+	}
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, fmt.Errorf("%w: from must not be after to", ErrValidation)
+	}
+	return s.repo.ListAuditEntries(ctx, filter)
+}
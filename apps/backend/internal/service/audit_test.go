@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// auditRepo records audit entries in memory; other Repository methods are not used.
+type auditRepo struct {
+	Repository
+	entries []AuditEntry
+}
+
+func (r *auditRepo) SaveAuditEntry(ctx context.Context, e *AuditEntry) error {
+	r.entries = append(r.entries, *e)
+	return nil
+}
+
+func TestAudit(t *testing.T) {
+	repo := &auditRepo{}
+	s := &dobbyFinancier{repo: repo}
+	actor := uuid.New()
+	ctx := WithUserID(context.Background(), actor)
+
+	before := Envelope{ID: uuid.New(), Name: "Groceries"}
+	after := before
+	after.Name = "Food"
+	steps := []struct {
+		before, after any
+		want          AuditAction
+	}{
+		{nil, before, AuditCreate},
+		{before, after, AuditUpdate},
+		{after, after, ""},
+		{after, nil, AuditDelete},
+	}
+	for _, step := range steps {
+		if err := s.audit(ctx, AuditEnvelope, before.ID, step.before, step.after); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(repo.entries) != 3 {
+		t.Fatalf("expected 3 entries, unchanged snapshots skipped, got %d", len(repo.entries))
+	}
+	for i, want := range []AuditAction{AuditCreate, AuditUpdate, AuditDelete} {
+		e := repo.entries[i]
+		if e.Action != want || e.EntityID != before.ID || e.ActorID == nil || *e.ActorID != actor {
+			t.Errorf("entry %d: got %s of %s by %v, want %s of %s by %s", i, e.Action, e.EntityID, e.ActorID, want, before.ID, actor)
+		}
+	}
+	if repo.entries[0].Before != nil || repo.entries[2].After != nil {
+		t.Errorf("expected no before on create and no after on delete")
+	}
+}
+
+func TestAuditOmitsSecrets(t *testing.T) {
+	repo := &auditRepo{}
+	s := &dobbyFinancier{repo: repo}
+	token := &AccessToken{ID: uuid.New(), Token: "dobby_pat_secret", TokenHash: "secrethash"}
+
+	if err := s.audit(context.Background(), AuditAccessToken, token.ID, nil, token); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if snap := string(repo.entries[0].After); strings.Contains(snap, "secret") {
+		t.Errorf("snapshot leaks the token: %s", snap)
+	}
+}
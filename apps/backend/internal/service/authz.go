@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Action is something a household member may be permitted to do.
+type Action int
+
+const (
+	ActionRead          Action = iota // View any household data
+	ActionRecord                      // Record, edit and delete transactions; contributors only in granted envelopes
+	ActionBudget                      // Manage periods, envelopes, transfers, imports, rules and recurring transactions
+	ActionManageMembers               // Invite members, change their roles and envelope grants
+	ActionManageTokens                // Create and revoke own access tokens, never with an access token
+	ActionJoinHousehold               // Join a household by invitation, never with an access token; needs no membership
+)
+
+// authorizer decides what the authenticated user may do in the household of the context.
+// Every FinanceService method consults it before touching household data.
+type authorizer struct {
+	repo Repository
+}
+
+// Authorize fails with ErrForbidden unless the authenticated user may perform action.
+// For ActionRecord, envelopeIDs are the envelopes the transaction touches.
+func (a *authorizer) Authorize(ctx context.Context, action Action, envelopeIDs ...uuid.UUID) error {
+	if scope, ok := TokenScopeFromContext(ctx); ok && !scope.allows(action) {
+		return fmt.Errorf("%w: %s access token does not allow this", ErrForbidden, scope)
+	}
+	if action == ActionJoinHousehold {
+		return nil
+	}
+	m, err := a.member(ctx)
+	if err != nil {
+		return err
+	}
+	if !m.can(action, envelopeIDs) {
+		return fmt.Errorf("%w: %s role does not allow this", ErrForbidden, m.Role)
+	}
+	return nil
+}
+
+// member returns the membership of the authenticated user in the context household.
+func (a *authorizer) member(ctx context.Context) (*Member, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", ErrForbidden)
+	}
+	m, err := a.repo.GetMember(ctx, userID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("%w: not a member of this household", ErrForbidden)
+	}
+	return m, err
+}
+
+func (m *Member) can(action Action, envelopeIDs []uuid.UUID) bool {
+	if action == ActionManageTokens {
+		return true
+	}
+	switch m.Role {
+	case RoleOwner:
+		return true
+	case RoleEditor:
+		return action != ActionManageMembers
+	case RoleContributor:
+		if action == ActionRead {
+			return true
+		}
+		if action != ActionRecord {
+			return false
+		}
+		for _, id := range envelopeIDs {
+			if !m.granted(id) {
+				return false
+			}
+		}
+		return true
+	case RoleViewer:
+		return action == ActionRead
+	}
+	return false
+}
+
+func (scope TokenScope) allows(action Action) bool {
+	switch scope {
+	case ScopeRead:
+		return action == ActionRead
+	case ScopeWrite:
+		return action != ActionManageTokens && action != ActionJoinHousehold
+	}
+	return false
+}
+
+func (m *Member) granted(envelopeID uuid.UUID) bool {
+	for _, id := range m.EnvelopeIDs {
+		if id == envelopeID {
+			return true
+		}
+	}
+	return false
+}
+
+// envelopeIDs returns the distinct envelopes the given transactions touch.
+func envelopeIDs(txs ...Transaction) []uuid.UUID {
+	var res []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, t := range txs {
+		for _, line := range t.Lines() {
+			if !seen[line.EnvelopeID] {
+				seen[line.EnvelopeID] = true
+				res = append(res, line.EnvelopeID)
+			}
+		}
+	}
+	return res
+}
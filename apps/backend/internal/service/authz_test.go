@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestMemberCan(t *testing.T) {
+	own, other := uuid.New(), uuid.New()
+
+	tests := []struct {
+		role      Role
+		action    Action
+		envelopes []uuid.UUID
+		want      bool
+	}{
+		{RoleOwner, ActionManageMembers, nil, true},
+		{RoleEditor, ActionBudget, nil, true},
+		{RoleEditor, ActionRecord, []uuid.UUID{other}, true},
+		{RoleEditor, ActionManageMembers, nil, false},
+		{RoleContributor, ActionRead, nil, true},
+		{RoleContributor, ActionRecord, []uuid.UUID{own}, true},
+		{RoleContributor, ActionRecord, []uuid.UUID{own, other}, false},
+		{RoleContributor, ActionBudget, nil, false},
+		{RoleViewer, ActionRead, nil, true},
+		{RoleViewer, ActionRecord, []uuid.UUID{own}, false},
+		{Role("admin"), ActionRead, nil, false},
+	}
+	for _, tt := range tests {
+		m := &Member{Role: tt.role, EnvelopeIDs: []uuid.UUID{own}}
+		if got := m.can(tt.action, tt.envelopes); got != tt.want {
+			t.Errorf("%s can %d on %v = %v, want %v", tt.role, tt.action, tt.envelopes, got, tt.want)
+		}
+	}
+}
+
+func TestTokenScopeAllows(t *testing.T) {
+	tests := []struct {
+		scope  TokenScope
+		action Action
+		want   bool
+	}{
+		{ScopeRead, ActionRead, true},
+		{ScopeRead, ActionRecord, false},
+		{ScopeWrite, ActionBudget, true},
+		{ScopeWrite, ActionManageMembers, true},
+		{ScopeWrite, ActionManageTokens, false},
+		{ScopeRead, ActionJoinHousehold, false},
+		{ScopeWrite, ActionJoinHousehold, false},
+	}
+	for _, tt := range tests {
+		if got := tt.scope.allows(tt.action); got != tt.want {
+			t.Errorf("%s allows %d = %v, want %v", tt.scope, tt.action, got, tt.want)
+		}
+	}
+}
+
+func TestEnvelopeIDs(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	split := Transaction{EnvelopeID: a, Splits: []TransactionSplit{{EnvelopeID: a}, {EnvelopeID: b}}}
+	moved := Transaction{EnvelopeID: b}
+
+	got := envelopeIDs(split, moved)
+	if len(got) != 2 || got[0] != a || got[1] != b {
+		t.Errorf("expected [%v %v], got %v", a, b, got)
+	}
+}
+
+func TestCheckOwnerRemains(t *testing.T) {
+	owner, editor := uuid.New(), uuid.New()
+	members := []Member{
+		{User: User{ID: owner}, Role: RoleOwner},
+		{User: User{ID: editor}, Role: RoleEditor},
+	}
+
+	if err := checkOwnerRemains(members, owner, RoleEditor); !errors.Is(err, ErrConflict) {
+		t.Errorf("demoting the last owner: expected ErrConflict, got %v", err)
+	}
+	if err := checkOwnerRemains(members, owner, ""); !errors.Is(err, ErrConflict) {
+		t.Errorf("last owner leaving: expected ErrConflict, got %v", err)
+	}
+	if err := checkOwnerRemains(members, editor, RoleViewer); err != nil {
+		t.Errorf("changing a non-owner: unexpected error %v", err)
+	}
+	if err := checkOwnerRemains(members, editor, RoleOwner); err != nil {
+		t.Errorf("promoting a second owner: unexpected error %v", err)
+	}
+	if err := checkOwnerRemains(members[:1], owner, ""); err != nil {
+		t.Errorf("sole member leaving: unexpected error %v", err)
+	}
+}
+
+func TestJoinHouseholdRejectsAccessTokens(t *testing.T) {
+	s := &dobbyFinancier{authz: &authorizer{}}
+	ctx := WithUserID(context.Background(), uuid.New())
+
+	for _, scope := range []TokenScope{ScopeRead, ScopeWrite} {
+		if _, err := s.JoinHousehold(WithTokenScope(ctx, scope), "invitation"); !errors.Is(err, ErrForbidden) {
+			t.Errorf("%s access token: expected ErrForbidden, got %v", scope, err)
+		}
+	}
+}
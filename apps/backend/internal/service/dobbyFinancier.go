@@ -3,69 +3,101 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type dobbyFinancier struct {
-	repo      Repository
-	txManager TransactionManager
+	repo       Repository
+	txManager  TransactionManager
+	authz      *authorizer
+	periodGaps PeriodGapPolicy
 }
 
-func NewDobbyFinancier(repo Repository, txManager TransactionManager) FinanceService {
+func NewDobbyFinancier(repo Repository, txManager TransactionManager, periodGaps PeriodGapPolicy) FinanceService {
 	return &dobbyFinancier{
-		repo:      repo,
-		txManager: txManager,
+		repo:       repo,
+		txManager:  txManager,
+		authz:      &authorizer{repo: repo},
+		periodGaps: periodGaps,
 	}
 }
 
+// checkVersion fails with ErrPreconditionFailed unless version is zero or the current version of an entity.
+func checkVersion(current, version int64) error {
+	if version != 0 && version != current {
+		return fmt.Errorf("%w: version %d was replaced by version %d", ErrPreconditionFailed, version, current)
+	}
+	return nil
+}
+
 func (s *dobbyFinancier) CreatePeriod(ctx context.Context, start, end *time.Time) (*Period, error) {
-	if start == nil {
-		startTime, err := calculateNextPeriodStartTime(time.Now().AddDate(0, -1, 0))
-		if err != nil {
-			return nil, err
-		}
-		start = &startTime
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
 	}
-	if end == nil {
-		endTime, err := calculateNextPeriodStartTime(time.Now())
+	request := struct{ Start, End *time.Time }{start, end}
+	return idempotent(ctx, s, "create_period", request, func(ctx context.Context) (*Period, error) {
+		return s.createPeriod(ctx, start, end, s.periodGaps == PeriodGapsReject)
+	})
+}
+
+// createPeriod creates a period, with dates left open following the period schedule of the household,
+// by default the scheduled period around now. It must not overlap another one,
+// and with rejectGaps it must not leave time uncovered between it and its neighbours either.
+func (s *dobbyFinancier) createPeriod(ctx context.Context, start, end *time.Time, rejectGaps bool) (*Period, error) {
+	aroundNow := start == nil && end == nil
+	if start == nil || end == nil {
+		scheduledStart, scheduledEnd, err := s.scheduledPeriod(ctx, start, end)
 		if err != nil {
 			return nil, err
 		}
-		end = &endTime
+		start, end = &scheduledStart, &scheduledEnd
 	}
 	p := &Period{
 		ID:        uuid.New(),
 		StartDate: *start,
 		EndDate:   *end,
 	}
-	if err := s.repo.SavePeriod(ctx, p); err != nil {
+	periods, err := s.repo.ListPeriods(ctx)
+	if err != nil {
 		return nil, err
 	}
-	return p, nil
-}
-
-func calculateNextPeriodStartTime(referenceTime time.Time) (time.Time, error) {
-	y, m, _ := referenceTime.Date()
-	nextPeriodStart := time.Date(y, time.Month(m+1), 5, 0, 0, 0, 0, &time.Location{})
-	weekday := nextPeriodStart.Weekday()
-	moveByDays := 0
-	if weekday > 5 {
-		moveByDays = int(weekday) - 5
+	if aroundNow {
+		fitBetweenPeriods(p, periods)
 	}
-	if moveByDays == 0 {
-		return nextPeriodStart, nil
+	if err := validatePeriod(*p, periods, rejectGaps); err != nil {
+		return nil, err
 	}
-	return nextPeriodStart.AddDate(0, 0, -moveByDays), nil
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SavePeriod(ctx, p); err != nil {
+			return err
+		}
+		if err := s.audit(ctx, AuditPeriod, p.ID, nil, p); err != nil {
+			return err
+		}
+		return s.materializeRecurring(ctx, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
 func (s *dobbyFinancier) GetCurrentPeriod(ctx context.Context) (*PeriodSummary, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
 	p, err := s.repo.GetCurrentPeriod(ctx)
 	if errors.Is(err, ErrNotFound) {
 		slog.Warn("Failed to get current period, creating a new one", "error", err)
-		p, err = s.CreatePeriod(ctx, nil, nil)
+		// Any member may trigger this, the new period is not a decision of theirs.
+		// Nor is a gap it may leave, so the gap policy does not stop it.
+		p, err = s.createPeriod(ctx, nil, nil, false)
 		if err != nil {
 			return nil, err
 		}
@@ -76,7 +108,17 @@ func (s *dobbyFinancier) GetCurrentPeriod(ctx context.Context) (*PeriodSummary,
 	return s.GetPeriodSummary(ctx, p.ID)
 }
 
+func (s *dobbyFinancier) GetPeriod(ctx context.Context, id uuid.UUID) (*Period, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPeriod(ctx, id)
+}
+
 func (s *dobbyFinancier) GetPeriodSummary(ctx context.Context, id uuid.UUID) (*PeriodSummary, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
 	period, err := s.repo.GetPeriod(ctx, id)
 	if err != nil {
 		return nil, err
@@ -87,6 +129,29 @@ func (s *dobbyFinancier) GetPeriodSummary(ctx context.Context, id uuid.UUID) (*P
 		return nil, err
 	}
 
+	flows, err := s.repo.ListEnvelopeFlows(ctx, period.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	carried := carriedOverBalances(flows, stats)
+
+	for i := range stats {
+		stats[i].CarriedOver = carried[stats[i].Envelope.ID]
+		stats[i].Remaining = stats[i].CarriedOver + stats[i].Allocated - stats[i].Spent
+	}
+
+	previous, err := s.previousPeriods(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+	projected, err := s.forecast(ctx, period, previous, stats)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stats {
+		stats[i].ProjectedEndingBalance = projected[stats[i].Envelope.ID]
+	}
+
 	summary := &PeriodSummary{
 		Period:        *period,
 		EnvelopeStats: stats,
@@ -95,36 +160,201 @@ func (s *dobbyFinancier) GetPeriodSummary(ctx context.Context, id uuid.UUID) (*P
 	for _, stat := range stats {
 		summary.TotalBudget += stat.Allocated
 		summary.TotalSpent += stat.Spent
+		summary.TotalCarriedOver += stat.CarriedOver
+		summary.ProjectedEndingBalance += stat.ProjectedEndingBalance
 	}
 
-	summary.TotalRemaining = summary.TotalBudget - summary.TotalSpent
+	summary.TotalRemaining = summary.TotalCarriedOver + summary.TotalBudget - summary.TotalSpent
 
 	return summary, nil
 }
 
+// previousPeriods returns all periods starting before the given one, in chronological order.
+func (s *dobbyFinancier) previousPeriods(ctx context.Context, period *Period) ([]Period, error) {
+	periods, err := s.repo.ListPeriods(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous []Period
+	for _, p := range periods {
+		if p.ID != period.ID && p.StartDate.Before(period.StartDate) {
+			previous = append(previous, p)
+		}
+	}
+	sort.Slice(previous, func(i, j int) bool {
+		return previous[i].StartDate.Before(previous[j].StartDate)
+	})
+	return previous, nil
+}
+
+// carriedOverBalances folds the flows of previous periods, earliest first, into the balance each
+// envelope of stats carries into the next period according to its RolloverPolicy. A period without
+// flow leaves the balance carried through it unchanged under every policy, so such periods need no flow.
+func carriedOverBalances(flows []EnvelopeFlow, stats []EnvelopeStat) map[uuid.UUID]int64 {
+	policies := make(map[uuid.UUID]RolloverPolicy, len(stats))
+	for _, stat := range stats {
+		policies[stat.Envelope.ID] = stat.Envelope.RolloverPolicy
+	}
+	balances := make(map[uuid.UUID]int64)
+	for _, f := range flows {
+		if policy, ok := policies[f.EnvelopeID]; ok {
+			balances[f.EnvelopeID] = policy.Apply(balances[f.EnvelopeID] + f.Net)
+		}
+	}
+	return balances
+}
+
 func (s *dobbyFinancier) ListPeriods(ctx context.Context) ([]Period, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
 	return s.repo.ListPeriods(ctx)
 }
 
-func (s *dobbyFinancier) UpdatePeriod(ctx context.Context, id uuid.UUID, defaultEnvelopeID *uuid.UUID) (*PeriodSummary, error) {
-	p, err := s.repo.GetPeriod(ctx, id)
+func (s *dobbyFinancier) UpdatePeriod(ctx context.Context, p Period) (*PeriodSummary, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	existing, err := s.repo.GetPeriod(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(existing.Version, p.Version); err != nil {
+		return nil, err
+	}
+	p.Version = existing.Version
+	periods, err := s.repo.ListPeriods(ctx)
 	if err != nil {
 		return nil, err
 	}
-	p.DefaultEnvelopeID = defaultEnvelopeID
-	if err := s.repo.SavePeriod(ctx, p); err != nil {
+	if err := validatePeriod(p, periods, s.periodGaps == PeriodGapsReject); err != nil {
+		return nil, err
+	}
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if !p.StartDate.Equal(existing.StartDate) || !p.EndDate.Equal(existing.EndDate) {
+			if err := s.checkPeriodKeepsTransactions(ctx, p); err != nil {
+				return err
+			}
+		}
+		if err := s.repo.SavePeriod(ctx, &p); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditPeriod, p.ID, existing, p)
+	})
+	if err != nil {
 		return nil, err
 	}
-	return s.GetPeriodSummary(ctx, id)
+	return s.GetPeriodSummary(ctx, p.ID)
+}
+
+// checkPeriodKeepsTransactions checks that new dates of a period still contain all of its transactions,
+// including those in the trash, which would otherwise stay in a period that no longer covers them.
+func (s *dobbyFinancier) checkPeriodKeepsTransactions(ctx context.Context, p Period) error {
+	txs, err := s.repo.ListTransactions(ctx, TransactionFilter{PeriodID: &p.ID, IncludeDeleted: true})
+	if err != nil {
+		return err
+	}
+	outside := 0
+	for _, t := range txs {
+		if !p.Contains(t.Date) {
+			outside++
+		}
+	}
+	if outside > 0 {
+		return fmt.Errorf("%w: %d transactions of the period are dated outside the new dates", ErrConflict, outside)
+	}
+	return nil
+}
+
+func (s *dobbyFinancier) DeletePeriod(ctx context.Context, id uuid.UUID, version int64) error {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return err
+	}
+	p, err := s.repo.GetPeriod(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(p.Version, version); err != nil {
+		return err
+	}
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.DeletePeriod(ctx, id, p.Version); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditPeriod, id, p, nil)
+	})
+}
+
+// periodForDate returns the first period containing date, or nil if there is none.
+func periodForDate(periods []Period, date time.Time) *Period {
+	for i := range periods {
+		if periods[i].Contains(date) {
+			return &periods[i]
+		}
+	}
+	return nil
 }
 
 func (s *dobbyFinancier) RecordTransaction(ctx context.Context, t Transaction) (*Transaction, error) {
+	return idempotent(ctx, s, "record_transaction", t, func(ctx context.Context) (*Transaction, error) {
+		return s.recordTransaction(ctx, t)
+	})
+}
+
+func (s *dobbyFinancier) recordTransaction(ctx context.Context, t Transaction) (*Transaction, error) {
 	if t.ID == uuid.Nil {
 		t.ID = uuid.New()
 	}
+	if t.Date.IsZero() {
+		t.Date = time.Now()
+	}
+
+	var period *Period
+	if t.PeriodID == uuid.Nil {
+		periods, err := s.repo.ListPeriods(ctx)
+		if err != nil {
+			return nil, err
+		}
+		period = periodForDate(periods, t.Date)
+		if period == nil {
+			return nil, fmt.Errorf("%w: no period found for transaction date", ErrValidation)
+		}
+		t.PeriodID = period.ID
+	} else {
+		p, err := s.repo.GetPeriod(ctx, t.PeriodID)
+		if err != nil {
+			return nil, err
+		}
+		period = p
+	}
+
+	if err := validateSplits(&t); err != nil {
+		return nil, err
+	}
+	if t.Category == "" || t.EnvelopeID == uuid.Nil {
+		rs, err := s.loadRules(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := categorize(rs, &t, period); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkEnvelopes(ctx, t); err != nil {
+		return nil, err
+	}
+
+	if err := s.authz.Authorize(ctx, ActionRecord, envelopeIDs(t)...); err != nil {
+		return nil, err
+	}
+	stampCreated(ctx, &t)
 
 	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
-		return s.repo.SaveTransaction(ctx, &t)
+		if err := s.repo.SaveTransaction(ctx, &t); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditTransaction, t.ID, nil, t)
 	})
 
 	if err != nil {
@@ -133,22 +363,92 @@ func (s *dobbyFinancier) RecordTransaction(ctx context.Context, t Transaction) (
 	return &t, nil
 }
 
-func (s *dobbyFinancier) ListTransactions(ctx context.Context, filter TransactionFilter) ([]Transaction, error) {
-	return s.repo.ListTransactions(ctx, filter)
+// validateSplits checks that split lines add up to the transaction amount
+// and points the transaction envelope to the first line.
+func validateSplits(t *Transaction) error {
+	if len(t.Splits) == 0 {
+		return nil
+	}
+	if t.TransferID != nil {
+		return fmt.Errorf("%w: transfers cannot be split", ErrValidation)
+	}
+	if len(t.Splits) < 2 {
+		return fmt.Errorf("%w: a split transaction needs at least two lines", ErrValidation)
+	}
+
+	var sum int64
+	for i, line := range t.Splits {
+		if line.Category == "" {
+			t.Splits[i].Category = t.Category
+		}
+		if line.EnvelopeID == uuid.Nil {
+			return fmt.Errorf("%w: every split line needs an envelope", ErrValidation)
+		}
+		if line.Amount == 0 {
+			return fmt.Errorf("%w: split line amount must not be zero", ErrValidation)
+		}
+		sum += line.Amount
+	}
+	if sum != t.Amount {
+		return fmt.Errorf("%w: split lines sum up to %d instead of %d", ErrValidation, sum, t.Amount)
+	}
+
+	t.EnvelopeID = t.Splits[0].EnvelopeID
+	return nil
+}
+
+// checkEnvelopes checks that the envelopes the transaction lines go to exist outside the trash.
+// Spending in a trashed envelope would drop out of the period summaries and keep the envelope from being purged.
+func (s *dobbyFinancier) checkEnvelopes(ctx context.Context, t Transaction) error {
+	for _, id := range envelopeIDs(t) {
+		if _, err := s.repo.GetEnvelope(ctx, id); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return fmt.Errorf("%w: envelope %s does not exist", ErrValidation, id)
+			}
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *dobbyFinancier) GetTransaction(ctx context.Context, id uuid.UUID) (*Transaction, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
 	return s.repo.GetTransaction(ctx, id)
 }
 
 func (s *dobbyFinancier) UpdateTransaction(ctx context.Context, t Transaction) (*Transaction, error) {
-	_, err := s.repo.GetTransaction(ctx, t.ID)
+	existing, err := s.repo.GetTransaction(ctx, t.ID)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkVersion(existing.Version, t.Version); err != nil {
+		return nil, err
+	}
+	t.Version = existing.Version
+	// Transfer links are managed by TransferFunds only.
+	t.TransferID = existing.TransferID
+	t.CreatedBy = existing.CreatedBy
+	stampUpdated(ctx, &t)
+	if err := validateSplits(&t); err != nil {
+		return nil, err
+	}
+	if err := s.checkEnvelopes(ctx, t); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeTransactionChange(ctx, *existing, t); err != nil {
+		return nil, err
+	}
 
 	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
-		return s.repo.SaveTransaction(ctx, &t)
+		if t.TransferID != nil {
+			return s.saveTransferLeg(ctx, existing, &t)
+		}
+		if err := s.repo.SaveTransaction(ctx, &t); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditTransaction, t.ID, existing, t)
 	})
 	if err != nil {
 		return nil, err
@@ -156,25 +456,138 @@ func (s *dobbyFinancier) UpdateTransaction(ctx context.Context, t Transaction) (
 	return &t, nil
 }
 
-func (s *dobbyFinancier) DeleteTransaction(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteTransaction(ctx, id)
+// authorizeTransactionChange checks that the authenticated user may change transactions in
+// their previous and new state. Transfer legs move money between envelopes and are budget decisions.
+func (s *dobbyFinancier) authorizeTransactionChange(ctx context.Context, txs ...Transaction) error {
+	if txs[0].TransferID != nil {
+		return s.authz.Authorize(ctx, ActionBudget)
+	}
+	return s.authz.Authorize(ctx, ActionRecord, envelopeIDs(txs...)...)
 }
 
-func (s *dobbyFinancier) CreateEnvelope(ctx context.Context, name string) (*Envelope, error) {
-	e := &Envelope{
-		ID:   uuid.New(),
-		Name: name,
+func (s *dobbyFinancier) DeleteTransaction(ctx context.Context, id uuid.UUID, version int64) error {
+	t, err := s.repo.GetTransaction(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(t.Version, version); err != nil {
+		return err
 	}
-	if err := s.repo.SaveEnvelope(ctx, e); err != nil {
+	if err := s.authorizeTransactionChange(ctx, *t); err != nil {
+		return err
+	}
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		legs := []Transaction{*t}
+		if t.TransferID != nil {
+			// Deleting either leg of a transfer removes the whole transfer.
+			legs, err = s.repo.ListTransactions(ctx, TransactionFilter{TransferID: t.TransferID})
+			if err != nil {
+				return err
+			}
+		}
+		for _, leg := range legs {
+			if err := s.repo.DeleteTransaction(ctx, leg.ID, leg.Version); err != nil {
+				return err
+			}
+			if err := s.audit(ctx, AuditTransaction, leg.ID, leg, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *dobbyFinancier) CreateEnvelope(ctx context.Context, e Envelope) (*Envelope, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	return idempotent(ctx, s, "create_envelope", e, func(ctx context.Context) (*Envelope, error) {
+		e.ID = uuid.New()
+		if e.RolloverPolicy == "" {
+			e.RolloverPolicy = RolloverReset
+		}
+		if err := validateEnvelope(e); err != nil {
+			return nil, err
+		}
+		err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+			if err := s.repo.SaveEnvelope(ctx, &e); err != nil {
+				return err
+			}
+			return s.audit(ctx, AuditEnvelope, e.ID, nil, e)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &e, nil
+	})
+}
+
+func validateEnvelope(e Envelope) error {
+	if strings.TrimSpace(e.Name) == "" {
+		return fmt.Errorf("%w: envelope name must not be empty", ErrValidation)
+	}
+	if !e.RolloverPolicy.Valid() {
+		return fmt.Errorf("%w: unknown rollover policy %q", ErrValidation, e.RolloverPolicy)
+	}
+	return nil
+}
+
+func (s *dobbyFinancier) GetEnvelope(ctx context.Context, id uuid.UUID) (*Envelope, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
 		return nil, err
 	}
-	return e, nil
+	return s.repo.GetEnvelope(ctx, id)
 }
 
 func (s *dobbyFinancier) ListEnvelopes(ctx context.Context) ([]Envelope, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
 	return s.repo.ListEnvelopes(ctx)
 }
 
-func (s *dobbyFinancier) DeleteEnvelope(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteEnvelope(ctx, id)
+func (s *dobbyFinancier) UpdateEnvelope(ctx context.Context, e Envelope) (*Envelope, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	existing, err := s.repo.GetEnvelope(ctx, e.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(existing.Version, e.Version); err != nil {
+		return nil, err
+	}
+	e.Version = existing.Version
+	if err := validateEnvelope(e); err != nil {
+		return nil, err
+	}
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveEnvelope(ctx, &e); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditEnvelope, e.ID, existing, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (s *dobbyFinancier) DeleteEnvelope(ctx context.Context, id uuid.UUID, version int64) error {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return err
+	}
+	e, err := s.repo.GetEnvelope(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(e.Version, version); err != nil {
+		return err
+	}
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.DeleteEnvelope(ctx, id, e.Version); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditEnvelope, id, e, nil)
+	})
 }
@@ -0,0 +1,284 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestValidateSplits(t *testing.T) {
+	food, home := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		tx      Transaction
+		wantErr bool
+	}{
+		{
+			name: "regular transaction",
+			tx:   Transaction{EnvelopeID: food, Amount: -1000},
+		},
+		{
+			name: "lines sum up to amount",
+			tx: Transaction{Amount: -1000, Category: "groceries", Splits: []TransactionSplit{
+				{EnvelopeID: food, Amount: -700},
+				{EnvelopeID: home, Amount: -300, Category: "cleaning"},
+			}},
+		},
+		{
+			name: "lines do not sum up to amount",
+			tx: Transaction{Amount: -1000, Splits: []TransactionSplit{
+				{EnvelopeID: food, Amount: -700},
+				{EnvelopeID: home, Amount: -200},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "single line",
+			tx:      Transaction{Amount: -1000, Splits: []TransactionSplit{{EnvelopeID: food, Amount: -1000}}},
+			wantErr: true,
+		},
+		{
+			name: "line without envelope",
+			tx: Transaction{Amount: -1000, Splits: []TransactionSplit{
+				{EnvelopeID: food, Amount: -700},
+				{Amount: -300},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := tt.tx
+			err := validateSplits(&tx)
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Fatalf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(tx.Splits) == 0 {
+				return
+			}
+			if tx.EnvelopeID != tx.Splits[0].EnvelopeID {
+				t.Errorf("envelope = %v, want first line envelope %v", tx.EnvelopeID, tx.Splits[0].EnvelopeID)
+			}
+			if tx.Splits[0].Category != "groceries" || tx.Splits[1].Category != "cleaning" {
+				t.Errorf("unexpected line categories: %+v", tx.Splits)
+			}
+		})
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	if err := checkVersion(3, 0); err != nil {
+		t.Errorf("no version: unexpected error %v", err)
+	}
+	if err := checkVersion(3, 3); err != nil {
+		t.Errorf("current version: unexpected error %v", err)
+	}
+	if err := checkVersion(3, 2); !errors.Is(err, ErrPreconditionFailed) {
+		t.Errorf("stale version: expected ErrPreconditionFailed, got %v", err)
+	}
+}
+
+func TestUnknownEnvelopeNotFound(t *testing.T) {
+	s, ctx := newMemService(newMemRepo())
+	id := uuid.New()
+
+	if _, err := s.GetEnvelope(ctx, id); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetEnvelope: expected ErrNotFound, got %v", err)
+	}
+	if _, err := s.UpdateEnvelope(ctx, Envelope{ID: id, Name: "Groceries", RolloverPolicy: RolloverReset}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("UpdateEnvelope: expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUpdateEnvelope(t *testing.T) {
+	repo := newMemRepo()
+	s, ctx := newMemService(repo)
+	e := Envelope{ID: uuid.New(), Name: "Food", RolloverPolicy: RolloverReset}
+	if err := repo.SaveEnvelope(ctx, &e); err != nil {
+		t.Fatalf("fixture: %v", err)
+	}
+
+	if _, err := s.UpdateEnvelope(ctx, Envelope{ID: e.ID, Name: "  ", RolloverPolicy: RolloverReset}); !errors.Is(err, ErrValidation) {
+		t.Errorf("blank name: expected ErrValidation, got %v", err)
+	}
+	if _, err := s.UpdateEnvelope(ctx, Envelope{ID: e.ID, Name: "Groceries", RolloverPolicy: "keep"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("unknown policy: expected ErrValidation, got %v", err)
+	}
+	if _, err := s.UpdateEnvelope(ctx, Envelope{ID: e.ID, Name: "Groceries", RolloverPolicy: RolloverCarryAll}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := repo.envelopes[e.ID]; got.Name != "Groceries" || got.RolloverPolicy != RolloverCarryAll {
+		t.Errorf("expected the envelope renamed and carrying all over, got %+v", got)
+	}
+}
+
+func TestTransactionsRejectTrashedEnvelopes(t *testing.T) {
+	repo := newMemRepo()
+	s, ctx := newMemService(repo)
+	period := Period{ID: uuid.New(), StartDate: date(2026, 3, 5), EndDate: date(2026, 4, 5)}
+	groceries := Envelope{ID: uuid.New(), Name: "Groceries", RolloverPolicy: RolloverReset}
+	trashed := Envelope{ID: uuid.New(), Name: "Old", RolloverPolicy: RolloverReset}
+	for _, err := range []error{
+		repo.SavePeriod(ctx, &period),
+		repo.SaveEnvelope(ctx, &groceries),
+		repo.SaveEnvelope(ctx, &trashed),
+	} {
+		if err != nil {
+			t.Fatalf("fixture: %v", err)
+		}
+	}
+	now := time.Now()
+	trashed.DeletedAt = &now
+	repo.envelopes[trashed.ID] = trashed
+
+	if _, err := s.RecordTransaction(ctx, Transaction{PeriodID: period.ID, EnvelopeID: trashed.ID, Amount: -1000, Date: date(2026, 3, 6)}); !errors.Is(err, ErrValidation) {
+		t.Errorf("record in trashed envelope: expected ErrValidation, got %v", err)
+	}
+	split := Transaction{PeriodID: period.ID, Amount: -1000, Date: date(2026, 3, 6), Splits: []TransactionSplit{
+		{EnvelopeID: groceries.ID, Amount: -600},
+		{EnvelopeID: trashed.ID, Amount: -400},
+	}}
+	if _, err := s.RecordTransaction(ctx, split); !errors.Is(err, ErrValidation) {
+		t.Errorf("record split line in trashed envelope: expected ErrValidation, got %v", err)
+	}
+	if len(repo.transactions) != 0 {
+		t.Fatalf("expected nothing recorded, got %d transactions", len(repo.transactions))
+	}
+
+	tx, err := s.RecordTransaction(ctx, Transaction{PeriodID: period.ID, EnvelopeID: groceries.ID, Amount: -1000, Date: date(2026, 3, 6)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	moved := *tx
+	moved.EnvelopeID = trashed.ID
+	if _, err := s.UpdateTransaction(ctx, moved); !errors.Is(err, ErrValidation) {
+		t.Errorf("move to trashed envelope: expected ErrValidation, got %v", err)
+	}
+	if repo.transactions[tx.ID].EnvelopeID != groceries.ID {
+		t.Errorf("expected the transaction left in its envelope")
+	}
+}
+
+func TestDeletePeriod(t *testing.T) {
+	repo := newMemRepo()
+	s, ctx := newMemService(repo)
+	period := Period{ID: uuid.New(), StartDate: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 4, 5, 0, 0, 0, 0, time.UTC)}
+	if err := repo.SavePeriod(ctx, &period); err != nil {
+		t.Fatalf("fixture: %v", err)
+	}
+	tx := Transaction{ID: uuid.New(), PeriodID: period.ID, EnvelopeID: uuid.New(), Amount: -1000, Date: period.StartDate}
+	if err := repo.SaveTransaction(ctx, &tx); err != nil {
+		t.Fatalf("fixture: %v", err)
+	}
+
+	if err := s.DeletePeriod(ctx, uuid.New(), 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("unknown period: expected ErrNotFound, got %v", err)
+	}
+	if err := s.DeletePeriod(ctx, period.ID, period.Version+1); !errors.Is(err, ErrPreconditionFailed) {
+		t.Errorf("stale version: expected ErrPreconditionFailed, got %v", err)
+	}
+	if err := s.DeletePeriod(ctx, period.ID, period.Version); !errors.Is(err, ErrConflict) {
+		t.Errorf("period with transactions: expected ErrConflict, got %v", err)
+	}
+	if err := repo.DeleteTransaction(ctx, tx.ID, tx.Version); err != nil {
+		t.Fatalf("fixture: %v", err)
+	}
+	if err := s.DeletePeriod(ctx, period.ID, period.Version); err != nil {
+		t.Fatalf("period with trashed transactions only: unexpected error %v", err)
+	}
+	if _, ok := repo.periods[period.ID]; ok || len(repo.audit) != 1 {
+		t.Errorf("expected the period deleted and audited, got %d audit entries", len(repo.audit))
+	}
+}
+
+func TestCarriedOverBalances(t *testing.T) {
+	tests := []struct {
+		policy RolloverPolicy
+		nets   []int64 // Net flow of each previous period, earliest first
+		want   int64
+	}{
+		{RolloverReset, []int64{5000, -2000}, 0},
+		{RolloverCarryAll, []int64{5000, -2000}, 3000},
+		{RolloverCarryAll, []int64{1000, -3000}, -2000},
+		{RolloverCarryPositive, []int64{1000, -3000}, 0},
+		// A deficit is dropped when its period closes, so it does not eat into the next surplus.
+		{RolloverCarryPositive, []int64{-3000, 1000}, 1000},
+		{RolloverCarryPositive, []int64{1000, 500}, 1500},
+	}
+	for _, tt := range tests {
+		envelope := Envelope{ID: uuid.New(), RolloverPolicy: tt.policy}
+		var flows []EnvelopeFlow
+		for _, net := range tt.nets {
+			flows = append(flows, EnvelopeFlow{PeriodID: uuid.New(), EnvelopeID: envelope.ID, Net: net})
+		}
+		// Flows of envelopes no longer around are ignored.
+		flows = append(flows, EnvelopeFlow{PeriodID: uuid.New(), EnvelopeID: uuid.New(), Net: 100})
+
+		got := carriedOverBalances(flows, []EnvelopeStat{{Envelope: envelope}})
+		if len(got) > 1 || got[envelope.ID] != tt.want {
+			t.Errorf("%s over %v: expected %d, got %v", tt.policy, tt.nets, tt.want, got)
+		}
+	}
+}
+
+func TestGetPeriodSummaryCarriesOver(t *testing.T) {
+	repo := newMemRepo()
+	s, ctx := newMemService(repo)
+	envelopes := map[RolloverPolicy]*Envelope{}
+	for _, policy := range []RolloverPolicy{RolloverReset, RolloverCarryPositive, RolloverCarryAll} {
+		e := &Envelope{ID: uuid.New(), Name: string(policy), RolloverPolicy: policy}
+		envelopes[policy] = e
+		if err := repo.SaveEnvelope(ctx, e); err != nil {
+			t.Fatalf("fixture: %v", err)
+		}
+	}
+	var periods []Period
+	for i := range 3 {
+		p := Period{ID: uuid.New(), StartDate: date(2026, time.Month(1+i), 5), EndDate: date(2026, time.Month(2+i), 5)}
+		if err := repo.SavePeriod(ctx, &p); err != nil {
+			t.Fatalf("fixture: %v", err)
+		}
+		periods = append(periods, p)
+	}
+	record := func(p Period, e *Envelope, amount int64) {
+		tx := Transaction{ID: uuid.New(), PeriodID: p.ID, EnvelopeID: e.ID, Amount: amount, Date: p.StartDate}
+		if err := repo.SaveTransaction(ctx, &tx); err != nil {
+			t.Fatalf("fixture: %v", err)
+		}
+	}
+	// Every envelope gets 1000 and overspends by 500 in the first period, then saves 2000 in the second.
+	for _, e := range envelopes {
+		record(periods[0], e, 1000)
+		record(periods[0], e, -1500)
+		record(periods[1], e, 2000)
+	}
+	record(periods[2], envelopes[RolloverCarryAll], 700)
+
+	summary, err := s.GetPeriodSummary(ctx, periods[2].ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[uuid.UUID]int64{
+		envelopes[RolloverReset].ID:         0,
+		envelopes[RolloverCarryPositive].ID: 2000,
+		envelopes[RolloverCarryAll].ID:      1500,
+	}
+	for _, stat := range summary.EnvelopeStats {
+		if stat.CarriedOver != want[stat.Envelope.ID] {
+			t.Errorf("%s: expected %d carried over, got %d", stat.Envelope.RolloverPolicy, want[stat.Envelope.ID], stat.CarriedOver)
+		}
+	}
+	if summary.TotalCarriedOver != 3500 || summary.TotalRemaining != 4200 {
+		t.Errorf("expected 3500 carried over and 4200 remaining in total, got %d and %d", summary.TotalCarriedOver, summary.TotalRemaining)
+	}
+}
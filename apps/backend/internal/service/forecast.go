@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// forecastHistoryPeriods is the number of most recent previous periods used to estimate spending habits.
+const forecastHistoryPeriods = 3
+
+// periodTransactions groups a period with all of its transactions.
+type periodTransactions struct {
+	Period       Period
+	Transactions []Transaction
+}
+
+// forecastInput holds everything needed to project the ending balances of a period.
+type forecastInput struct {
+	Now          time.Time
+	Period       Period
+	Stats        []EnvelopeStat // Remaining must already include carried over balances
+	Transactions []Transaction  // All transactions of Period
+	Recurring    []RecurringTransaction
+	History      []periodTransactions // Previous periods used to estimate the daily spend rate
+}
+
+// forecast gathers the data for projectEndingBalances and returns the projected
+// ending balance of every envelope in the period.
+func (s *dobbyFinancier) forecast(ctx context.Context, period *Period, previous []Period, stats []EnvelopeStat) (map[uuid.UUID]int64, error) {
+	txs, err := s.repo.ListTransactions(ctx, TransactionFilter{PeriodID: &period.ID})
+	if err != nil {
+		return nil, err
+	}
+	recurring, err := s.repo.ListRecurringTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []periodTransactions
+	for _, p := range previous[max(len(previous)-forecastHistoryPeriods, 0):] {
+		ptxs, err := s.repo.ListTransactions(ctx, TransactionFilter{PeriodID: &p.ID})
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, periodTransactions{Period: p, Transactions: ptxs})
+	}
+
+	return projectEndingBalances(forecastInput{
+		Now:          time.Now(),
+		Period:       *period,
+		Stats:        stats,
+		Transactions: txs,
+		Recurring:    recurring,
+		History:      history,
+	}), nil
+}
+
+// projectEndingBalances projects each envelope's balance at the end of the period.
+//
+// Transactions already recorded (including future-dated ones) are part of Remaining. On top of that:
+//   - recurring occurrences still ahead of Now that have not been materialised yet are added;
+//   - discretionary spending (expenses that are neither recurring nor transfers) is extrapolated
+//     over the rest of the period, using a daily rate that blends the rate observed so far with the
+//     average rate of previous periods. The further into the period, the more the current rate weighs.
+func projectEndingBalances(in forecastInput) map[uuid.UUID]int64 {
+	res := make(map[uuid.UUID]int64, len(in.Stats))
+	for _, stat := range in.Stats {
+		res[stat.Envelope.ID] = stat.Remaining
+	}
+
+	total := days(in.Period.EndDate.Sub(in.Period.StartDate))
+	if total <= 0 || !in.Now.Before(in.Period.EndDate) {
+		return res
+	}
+	now := in.Now
+	if now.Before(in.Period.StartDate) {
+		now = in.Period.StartDate
+	}
+	elapsed := days(now.Sub(in.Period.StartDate))
+	left := total - elapsed
+
+	// Known recurring items that are still to come.
+	recorded := make(map[uuid.UUID]bool, len(in.Transactions))
+	for _, t := range in.Transactions {
+		recorded[t.ID] = true
+	}
+	for _, rt := range in.Recurring {
+		for _, d := range rt.Schedule.Occurrences(now, in.Period.EndDate) {
+			if _, ok := res[rt.EnvelopeID]; ok && in.Period.Contains(d) && !recorded[occurrenceID(rt.ID, d)] {
+				res[rt.EnvelopeID] += rt.Amount
+			}
+		}
+	}
+
+	// Daily discretionary spend rate observed so far in this period.
+	currentRate := make(map[uuid.UUID]float64)
+	if elapsed > 0 {
+		for envID, spent := range discretionarySpend(in.Transactions, in.Recurring, in.Period, now) {
+			currentRate[envID] = float64(spent) / elapsed
+		}
+	}
+
+	// Average daily discretionary spend rate of previous periods.
+	historicRate := make(map[uuid.UUID]float64)
+	historyCount := 0
+	for _, h := range in.History {
+		hDays := days(h.Period.EndDate.Sub(h.Period.StartDate))
+		if hDays <= 0 {
+			continue
+		}
+		historyCount++
+		for envID, spent := range discretionarySpend(h.Transactions, in.Recurring, h.Period, h.Period.EndDate) {
+			historicRate[envID] += float64(spent) / hDays
+		}
+	}
+
+	currentWeight := elapsed / total
+	if historyCount == 0 {
+		currentWeight = 1
+	}
+	for envID := range res {
+		rate := currentWeight * currentRate[envID]
+		if historyCount > 0 {
+			rate += (1 - currentWeight) * historicRate[envID] / float64(historyCount)
+		}
+		res[envID] -= int64(rate * left)
+	}
+	return res
+}
+
+// discretionarySpend sums, per envelope, expenses dated up to until that were neither
+// materialised from a recurring transaction nor part of a transfer. Returned amounts are positive.
+func discretionarySpend(txs []Transaction, recurring []RecurringTransaction, period Period, until time.Time) map[uuid.UUID]int64 {
+	generated := make(map[uuid.UUID]bool)
+	for _, rt := range recurring {
+		for _, d := range rt.Schedule.Occurrences(period.StartDate, period.EndDate) {
+			generated[occurrenceID(rt.ID, d)] = true
+		}
+	}
+
+	res := make(map[uuid.UUID]int64)
+	for _, t := range txs {
+		if t.TransferID != nil || generated[t.ID] || t.Date.After(until) {
+			continue
+		}
+		for _, line := range t.Lines() {
+			if line.Amount < 0 {
+				res[line.EnvelopeID] -= line.Amount
+			}
+		}
+	}
+	return res
+}
+
+func days(d time.Duration) float64 {
+	return d.Hours() / 24
+}
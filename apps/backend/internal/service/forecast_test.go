@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestProjectEndingBalances(t *testing.T) {
+	envID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	period := Period{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		StartDate: date(2026, time.March, 1),
+		EndDate:   date(2026, time.March, 31),
+	}
+	rent := RecurringTransaction{
+		ID:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		EnvelopeID: envID,
+		Amount:     -50000,
+		Schedule:   RecurrenceSchedule{Kind: RecurrenceMonthly, DayOfMonth: 25},
+	}
+	stats := []EnvelopeStat{{
+		Envelope:  Envelope{ID: envID},
+		Allocated: 100000,
+		Spent:     10000,
+		Remaining: 90000,
+	}}
+	// 10000 spent over the first 10 days, rent for the 25th not materialised yet.
+	txs := []Transaction{
+		{ID: uuid.New(), EnvelopeID: envID, Amount: 100000, Date: date(2026, time.March, 1)},
+		{ID: uuid.New(), EnvelopeID: envID, Amount: -10000, Date: date(2026, time.March, 5)},
+	}
+
+	t.Run("current velocity only", func(t *testing.T) {
+		got := projectEndingBalances(forecastInput{
+			Now:          date(2026, time.March, 11),
+			Period:       period,
+			Stats:        stats,
+			Transactions: txs,
+			Recurring:    []RecurringTransaction{rent},
+		})
+		// 90000 remaining - 50000 rent - 1000/day * 20 days left
+		if want := int64(20000); got[envID] != want {
+			t.Errorf("expected %d, got %d", want, got[envID])
+		}
+	})
+
+	t.Run("blended with history", func(t *testing.T) {
+		previous := Period{StartDate: date(2026, time.January, 30), EndDate: date(2026, time.March, 1)}
+		got := projectEndingBalances(forecastInput{
+			Now:          date(2026, time.March, 11),
+			Period:       period,
+			Stats:        stats,
+			Transactions: txs,
+			Recurring:    []RecurringTransaction{rent},
+			History: []periodTransactions{{
+				Period: previous,
+				Transactions: []Transaction{
+					{ID: uuid.New(), EnvelopeID: envID, Amount: -60000, Date: date(2026, time.February, 10)},
+				},
+			}},
+		})
+		// Rate = 1/3 * 1000/day + 2/3 * 2000/day, over 20 days left.
+		if want := int64(90000 - 50000 - 33333); got[envID] != want {
+			t.Errorf("expected %d, got %d", want, got[envID])
+		}
+	})
+
+	t.Run("finished period keeps remaining", func(t *testing.T) {
+		got := projectEndingBalances(forecastInput{
+			Now:          date(2026, time.April, 2),
+			Period:       period,
+			Stats:        stats,
+			Transactions: txs,
+			Recurring:    []RecurringTransaction{rent},
+		})
+		if got[envID] != 90000 {
+			t.Errorf("expected 90000, got %d", got[envID])
+		}
+	})
+}
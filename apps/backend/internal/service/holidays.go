@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Holiday is a public holiday, on which periods do not start.
+type Holiday struct {
+	Date   time.Time // At midnight UTC, only the date matters
+	Name   string
+	Yearly bool // Recurs on the same date every year
+}
+
+// on reports whether the holiday falls on the date of d, in the location of d.
+func (h Holiday) on(d time.Time) bool {
+	y, m, day := d.Date()
+	hy, hm, hd := h.Date.Date()
+	return hm == m && hd == day && (h.Yearly || hy == y)
+}
+
+// HolidayCalendar tells which days are public holidays.
+type HolidayCalendar interface {
+	// Holidays lists the holidays in the year, by date.
+	Holidays(year int) []Holiday
+}
+
+// isHoliday reports whether the date of d is a holiday in the calendar, which may be nil.
+func isHoliday(cal HolidayCalendar, d time.Time) bool {
+	if cal == nil {
+		return false
+	}
+	for _, h := range cal.Holidays(d.Year()) {
+		if h.on(d) {
+			return true
+		}
+	}
+	return false
+}
+
+// holidayCalendars combines calendars, a day being a holiday if it is one in any of them.
+type holidayCalendars []HolidayCalendar
+
+func (cals holidayCalendars) Holidays(year int) []Holiday {
+	var res []Holiday
+	for _, cal := range cals {
+		res = append(res, cal.Holidays(year)...)
+	}
+	sortHolidays(res)
+	return res
+}
+
+// importedHolidays is a calendar of holidays imported from an iCalendar file.
+type importedHolidays []Holiday
+
+func (hs importedHolidays) Holidays(year int) []Holiday {
+	var res []Holiday
+	for _, h := range hs {
+		switch {
+		case h.Yearly:
+			_, m, d := h.Date.Date()
+			if m == time.February && d == 29 && !isLeapYear(year) {
+				continue
+			}
+			res = append(res, Holiday{Date: utcDate(year, m, d), Name: h.Name, Yearly: true})
+		case h.Date.Year() == year:
+			res = append(res, h)
+		}
+	}
+	sortHolidays(res)
+	return res
+}
+
+// countryCalendar is the built-in calendar of national public holidays of a country,
+// identified by its ISO 3166-1 alpha-2 code.
+type countryCalendar string
+
+func (c countryCalendar) Holidays(year int) []Holiday {
+	holidays, ok := countryHolidays[string(c)]
+	if !ok {
+		return nil
+	}
+	res := holidays(year)
+	sortHolidays(res)
+	return res
+}
+
+// countryHolidays lists the holidays of a year for each country with a built-in calendar.
+// Holidays falling on a weekend are listed on the day they are observed, where there is one.
+var countryHolidays = map[string]func(year int) []Holiday{
+	"DE": germanHolidays,
+	"FR": frenchHolidays,
+	"GB": britishHolidays,
+	"US": americanHolidays,
+}
+
+// holidayCountries returns the codes of the countries with a built-in calendar.
+func holidayCountries() []string {
+	codes := make([]string, 0, len(countryHolidays))
+	for code := range countryHolidays {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+func validateHolidayCountry(country string) error {
+	if _, ok := countryHolidays[country]; !ok && country != "" {
+		return fmt.Errorf("%w: no holiday calendar for country %q, expected one of %s", ErrValidation,
+			country, strings.Join(holidayCountries(), ", "))
+	}
+	return nil
+}
+
+// germanHolidays are the nationwide holidays of Germany. Holidays of single states are not included.
+func germanHolidays(year int) []Holiday {
+	easter := easterSunday(year)
+	return []Holiday{
+		{Date: utcDate(year, time.January, 1), Name: "Neujahr"},
+		{Date: easter.AddDate(0, 0, -2), Name: "Karfreitag"},
+		{Date: easter.AddDate(0, 0, 1), Name: "Ostermontag"},
+		{Date: utcDate(year, time.May, 1), Name: "Tag der Arbeit"},
+		{Date: easter.AddDate(0, 0, 39), Name: "Christi Himmelfahrt"},
+		{Date: easter.AddDate(0, 0, 50), Name: "Pfingstmontag"},
+		{Date: utcDate(year, time.October, 3), Name: "Tag der Deutschen Einheit"},
+		{Date: utcDate(year, time.December, 25), Name: "1. Weihnachtstag"},
+		{Date: utcDate(year, time.December, 26), Name: "2. Weihnachtstag"},
+	}
+}
+
+// frenchHolidays are the holidays of metropolitan France, except those of Alsace-Moselle.
+func frenchHolidays(year int) []Holiday {
+	easter := easterSunday(year)
+	return []Holiday{
+		{Date: utcDate(year, time.January, 1), Name: "Jour de l'an"},
+		{Date: easter.AddDate(0, 0, 1), Name: "Lundi de Pâques"},
+		{Date: utcDate(year, time.May, 1), Name: "Fête du Travail"},
+		{Date: utcDate(year, time.May, 8), Name: "Victoire 1945"},
+		{Date: easter.AddDate(0, 0, 39), Name: "Ascension"},
+		{Date: easter.AddDate(0, 0, 50), Name: "Lundi de Pentecôte"},
+		{Date: utcDate(year, time.July, 14), Name: "Fête nationale"},
+		{Date: utcDate(year, time.August, 15), Name: "Assomption"},
+		{Date: utcDate(year, time.November, 1), Name: "Toussaint"},
+		{Date: utcDate(year, time.November, 11), Name: "Armistice 1918"},
+		{Date: utcDate(year, time.December, 25), Name: "Noël"},
+	}
+}
+
+// britishHolidays are the bank holidays of England and Wales. Holidays on a weekend are
+// substituted by the next weekday that is not a holiday already.
+func britishHolidays(year int) []Holiday {
+	easter := easterSunday(year)
+	holidays := []Holiday{
+		{Date: easter.AddDate(0, 0, -2), Name: "Good Friday"},
+		{Date: easter.AddDate(0, 0, 1), Name: "Easter Monday"},
+		{Date: nthWeekday(year, time.May, time.Monday, 1), Name: "Early May bank holiday"},
+		{Date: nthWeekday(year, time.May, time.Monday, -1), Name: "Spring bank holiday"},
+		{Date: nthWeekday(year, time.August, time.Monday, -1), Name: "Summer bank holiday"},
+	}
+	for _, h := range []Holiday{
+		{Date: utcDate(year, time.January, 1), Name: "New Year's Day"},
+		{Date: utcDate(year, time.December, 25), Name: "Christmas Day"},
+		{Date: utcDate(year, time.December, 26), Name: "Boxing Day"},
+	} {
+		for isWeekend(h.Date) || slices.ContainsFunc(holidays, func(o Holiday) bool { return o.Date.Equal(h.Date) }) {
+			h.Date = h.Date.AddDate(0, 0, 1)
+		}
+		holidays = append(holidays, h)
+	}
+	return holidays
+}
+
+// americanHolidays are the federal holidays of the United States. Holidays on a Saturday are
+// observed on the Friday before, those on a Sunday on the Monday after.
+func americanHolidays(year int) []Holiday {
+	holidays := []Holiday{
+		{Date: nthWeekday(year, time.January, time.Monday, 3), Name: "Martin Luther King Jr. Day"},
+		{Date: nthWeekday(year, time.February, time.Monday, 3), Name: "Washington's Birthday"},
+		{Date: nthWeekday(year, time.May, time.Monday, -1), Name: "Memorial Day"},
+		{Date: nthWeekday(year, time.September, time.Monday, 1), Name: "Labor Day"},
+		{Date: nthWeekday(year, time.October, time.Monday, 2), Name: "Columbus Day"},
+		{Date: nthWeekday(year, time.November, time.Thursday, 4), Name: "Thanksgiving Day"},
+	}
+	// New Year's Day of the next year is observed on December 31 when it falls on a Saturday.
+	for _, h := range []Holiday{
+		{Date: utcDate(year, time.January, 1), Name: "New Year's Day"},
+		{Date: utcDate(year, time.June, 19), Name: "Juneteenth National Independence Day"},
+		{Date: utcDate(year, time.July, 4), Name: "Independence Day"},
+		{Date: utcDate(year, time.November, 11), Name: "Veterans Day"},
+		{Date: utcDate(year, time.December, 25), Name: "Christmas Day"},
+		{Date: utcDate(year+1, time.January, 1), Name: "New Year's Day"},
+	} {
+		switch h.Date.Weekday() {
+		case time.Saturday:
+			h.Date = h.Date.AddDate(0, 0, -1)
+		case time.Sunday:
+			h.Date = h.Date.AddDate(0, 0, 1)
+		}
+		if h.Date.Year() == year {
+			holidays = append(holidays, h)
+		}
+	}
+	return holidays
+}
+
+// easterSunday computes the date of Easter Sunday in the Gregorian calendar.
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return utcDate(year, time.Month(month), day)
+}
+
+// nthWeekday returns the nth given weekday of the month, counting from the end of the month if n is negative.
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	if n < 0 {
+		last := utcDate(year, month+1, 0)
+		return last.AddDate(0, 0, -int(last.Weekday()-weekday+7)%7+7*(n+1))
+	}
+	first := utcDate(year, month, 1)
+	return first.AddDate(0, 0, int(weekday-first.Weekday()+7)%7+7*(n-1))
+}
+
+func utcDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func isWeekend(d time.Time) bool {
+	return d.Weekday() == time.Saturday || d.Weekday() == time.Sunday
+}
+
+func sortHolidays(hs []Holiday) {
+	slices.SortStableFunc(hs, func(a, b Holiday) int { return a.Date.Compare(b.Date) })
+}
+
+// ListHolidays lists the holidays periods of the household do not start on in the year, by date:
+// those of the built-in calendar chosen in the period schedule and the imported ones.
+func (s *dobbyFinancier) ListHolidays(ctx context.Context, year int) ([]Holiday, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	sch, err := s.periodSchedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cal, err := s.holidayCalendar(ctx, sch.HolidayCountry)
+	if err != nil {
+		return nil, err
+	}
+	res := cal.Holidays(year)
+	if res == nil {
+		res = []Holiday{}
+	}
+	return res, nil
+}
+
+// ImportHolidays replaces the holidays imported into the household by those of an iCalendar file.
+// Every day an event covers is a holiday, events recurring yearly recur as holidays.
+func (s *dobbyFinancier) ImportHolidays(ctx context.Context, content []byte) ([]Holiday, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	householdID, ok := HouseholdIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no household", ErrNotFound)
+	}
+	holidays, err := parseICalendar(content)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.repo.ListHolidays(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.ReplaceHolidays(ctx, holidays); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditHolidays, householdID, existing, holidays)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return holidays, nil
+}
+
+// holidayCalendar returns the calendar period starts are moved off: the built-in one of the country,
+// if any, along with the holidays imported into the household.
+func (s *dobbyFinancier) holidayCalendar(ctx context.Context, country string) (HolidayCalendar, error) {
+	imported, err := s.repo.ListHolidays(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cals := holidayCalendars{importedHolidays(imported)}
+	if country != "" {
+		cals = append(cals, countryCalendar(country))
+	}
+	return cals, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxHolidayDays caps the days a single event may cover, so a malformed end date cannot blow up an import.
+const maxHolidayDays = 31
+
+// parseICalendar reads the holidays out of an iCalendar (RFC 5545) file, by date. Each day an all-day
+// event covers is a holiday, an event with a time is a holiday on its start date. Events may recur
+// yearly on their date; other recurrence rules are rejected.
+func parseICalendar(content []byte) ([]Holiday, error) {
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	// Long lines are folded by continuing them on lines starting with a space or tab.
+	text = strings.NewReplacer("\n ", "", "\n\t", "").Replace(text)
+	lines := strings.Split(text, "\n")
+	if !strings.EqualFold(strings.TrimSpace(strings.TrimPrefix(lines[0], "\ufeff")), "BEGIN:VCALENDAR") {
+		return nil, fmt.Errorf("%w: content is not an iCalendar file", ErrValidation)
+	}
+
+	var (
+		holidays []Holiday
+		seen     = make(map[time.Time]bool)
+		event    map[string]string
+	)
+	for i, line := range lines {
+		name, value, ok := strings.Cut(strings.TrimRight(line, " \t"), ":")
+		if !ok {
+			continue
+		}
+		name, _, _ = strings.Cut(strings.ToUpper(name), ";") // Parameters such as VALUE=DATE are not needed
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			event = make(map[string]string)
+		case name == "END" && strings.EqualFold(value, "VEVENT") && event != nil:
+			days, err := eventHolidays(event)
+			if err != nil {
+				return nil, fmt.Errorf("%w: event ending on line %d: %v", ErrValidation, i+1, err)
+			}
+			for _, h := range days {
+				if !seen[h.Date] {
+					seen[h.Date] = true
+					holidays = append(holidays, h)
+				}
+			}
+			event = nil
+		case event != nil:
+			event[name] = value
+		}
+	}
+	sortHolidays(holidays)
+	if holidays == nil {
+		holidays = []Holiday{}
+	}
+	return holidays, nil
+}
+
+// eventHolidays turns the properties of a VEVENT into the holidays it covers.
+func eventHolidays(event map[string]string) ([]Holiday, error) {
+	start, err := parseICalendarDate(event["DTSTART"])
+	if err != nil {
+		return nil, err
+	}
+	yearly, err := yearlyRule(event["RRULE"])
+	if err != nil {
+		return nil, err
+	}
+	days := 1
+	// The end of an all-day event is exclusive. Events with a time count for their start date only.
+	if end := event["DTEND"]; len(end) == len("20060102") {
+		endDate, err := parseICalendarDate(end)
+		if err != nil {
+			return nil, err
+		}
+		days = int(endDate.Sub(start).Hours() / 24)
+		if days < 1 || days > maxHolidayDays {
+			return nil, fmt.Errorf("event must cover 1 to %d days", maxHolidayDays)
+		}
+	}
+
+	name := unescapeICalendarText(event["SUMMARY"])
+	res := make([]Holiday, days)
+	for i := range res {
+		res[i] = Holiday{Date: start.AddDate(0, 0, i), Name: name, Yearly: yearly}
+	}
+	return res, nil
+}
+
+// parseICalendarDate parses the date of a DATE or DATE-TIME value such as "20261225" or "20261225T000000Z".
+func parseICalendarDate(s string) (time.Time, error) {
+	if len(s) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+	d, err := time.Parse("20060102", s[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+	return d, nil
+}
+
+// yearlyRule reports whether a recurrence rule repeats every year on the same date.
+// An empty rule does not repeat.
+func yearlyRule(rule string) (bool, error) {
+	if rule == "" {
+		return false, nil
+	}
+	yearly := false
+	for _, part := range strings.Split(strings.ToUpper(rule), ";") {
+		switch {
+		case part == "FREQ=YEARLY":
+			yearly = true
+		case part == "INTERVAL=1", strings.HasPrefix(part, "WKST="):
+		default:
+			return false, fmt.Errorf("unsupported recurrence rule %q, only yearly ones are", rule)
+		}
+	}
+	if !yearly {
+		return false, fmt.Errorf("unsupported recurrence rule %q, only yearly ones are", rule)
+	}
+	return true, nil
+}
+
+var icalendarTextReplacer = strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`)
+
+func unescapeICalendarText(s string) string {
+	return strings.TrimSpace(icalendarTextReplacer.Replace(s))
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEasterSunday(t *testing.T) {
+	for year, want := range map[int]time.Time{
+		2024: date(2024, time.March, 31),
+		2025: date(2025, time.April, 20),
+		2026: date(2026, time.April, 5),
+		2038: date(2038, time.April, 25),
+	} {
+		if got := easterSunday(year); !got.Equal(want) {
+			t.Errorf("%d: expected %s, got %s", year, want.Format(time.DateOnly), got.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestCountryCalendar(t *testing.T) {
+	tests := []struct {
+		country string
+		day     time.Time
+		want    bool
+	}{
+		{"DE", date(2026, time.May, 14), true}, // Ascension Day
+		{"DE", date(2026, time.July, 14), false},
+		{"FR", date(2026, time.July, 14), true},
+		{"GB", date(2021, time.December, 27), true}, // Christmas Day on a Saturday, substituted
+		{"GB", date(2021, time.December, 28), true}, // Boxing Day on a Sunday, substituted
+		{"GB", date(2026, time.May, 25), true},      // Spring bank holiday
+		{"US", date(2021, time.December, 31), true}, // New Year's Day 2022 on a Saturday
+		{"US", date(2027, time.June, 18), true},     // Juneteenth on a Saturday
+		{"US", date(2026, time.November, 26), true}, // Thanksgiving Day
+		{"US", date(2026, time.November, 27), false},
+		{"XX", date(2026, time.January, 1), false},
+	}
+	for _, tt := range tests {
+		if got := isHoliday(countryCalendar(tt.country), tt.day); got != tt.want {
+			t.Errorf("%s on %s: expected holiday %v, got %v", tt.country, tt.day.Format(time.DateOnly), tt.want, got)
+		}
+	}
+}
+
+func TestImportedHolidaysRecurYearly(t *testing.T) {
+	cal := importedHolidays{
+		{Date: date(2020, time.February, 29), Name: "Leap day", Yearly: true},
+		{Date: date(2020, time.June, 1), Name: "Company day", Yearly: true},
+		{Date: date(2026, time.March, 3), Name: "Once"},
+	}
+	if !isHoliday(cal, date(2028, time.February, 29)) || !isHoliday(cal, date(2026, time.June, 1)) {
+		t.Error("expected yearly holidays to recur")
+	}
+	if got := cal.Holidays(2027); len(got) != 1 || got[0].Name != "Company day" {
+		t.Errorf("2027: expected just the company day, got %v", got)
+	}
+	if isHoliday(cal, date(2027, time.March, 3)) {
+		t.Error("expected a one-off holiday not to recur")
+	}
+}
+
+func TestParseICalendar(t *testing.T) {
+	ics := "\ufeffBEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;VALUE=DATE:20261224\r\n" +
+		"DTEND;VALUE=DATE:20261227\r\n" +
+		"SUMMARY:Christmas\\, with a long\r\n" +
+		"  folded name\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART:20260601T090000Z\r\n" +
+		"DTEND:20260601T170000Z\r\n" +
+		"RRULE:FREQ=YEARLY\r\n" +
+		"SUMMARY:Company day\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	got, err := parseICalendar([]byte(ics))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []Holiday{
+		{Date: date(2026, time.June, 1), Name: "Company day", Yearly: true},
+		{Date: date(2026, time.December, 24), Name: "Christmas, with a long folded name"},
+		{Date: date(2026, time.December, 25), Name: "Christmas, with a long folded name"},
+		{Date: date(2026, time.December, 26), Name: "Christmas, with a long folded name"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d holidays, got %v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("holiday %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+
+	for name, content := range map[string]string{
+		"not a calendar":    "Date,Name\n2026-12-25,Christmas\n",
+		"monthly event":     "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20260101\nRRULE:FREQ=MONTHLY\nEND:VEVENT\nEND:VCALENDAR\n",
+		"missing start":     "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:Someday\nEND:VEVENT\nEND:VCALENDAR\n",
+		"ends before start": "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20260105\nDTEND:20260101\nEND:VEVENT\nEND:VCALENDAR\n",
+	} {
+		if _, err := parseICalendar([]byte(content)); !errors.Is(err, ErrValidation) {
+			t.Errorf("%s: expected ErrValidation, got %v", name, err)
+		}
+	}
+}
+
+func TestPeriodAroundSkipsHolidays(t *testing.T) {
+	// The 5th of April 2026 is a Sunday, the Friday before it Good Friday.
+	start, end, err := defaultPeriodSchedule.periodAround(date(2026, time.April, 10), countryCalendar("DE"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !start.Equal(date(2026, time.April, 2)) || !end.Equal(date(2026, time.May, 5)) {
+		t.Errorf("expected 2026-04-02 to 2026-05-05, got %s to %s", start.Format(time.DateOnly), end.Format(time.DateOnly))
+	}
+}
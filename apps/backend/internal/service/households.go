@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const invitationTTL = 7 * 24 * time.Hour
+
+type householdIDKey struct{}
+
+// WithHouseholdID returns a context scoped to the given household. Repository
+// methods only ever see data of the household carried by the context.
+func WithHouseholdID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, householdIDKey{}, id)
+}
+
+// HouseholdIDFromContext returns the household the context is scoped to, if any.
+func HouseholdIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(householdIDKey{}).(uuid.UUID)
+	return id, ok
+}
+
+// GetUserHouseholdID returns the household the user belongs to.
+func (s *dobbyFinancier) GetUserHouseholdID(ctx context.Context, userID uuid.UUID) (uuid.UUID, error) {
+	return s.repo.GetUserHouseholdID(ctx, userID)
+}
+
+// createHousehold sets up a new household with u as its owner. The first user to get one
+// claims the household holding data from before tenancy instead.
+func (s *dobbyFinancier) createHousehold(ctx context.Context, u *User) error {
+	claimed, err := s.repo.ClaimHousehold(ctx)
+	if err == nil {
+		slog.Info("User claimed the household created before tenancy", "user", u.ID, "household", claimed)
+		return s.repo.SaveMember(ctx, claimed, &Member{User: *u, Role: RoleOwner})
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	h := Household{ID: uuid.New(), Name: u.Name + "'s household"}
+	if err := s.repo.SaveHousehold(ctx, &h); err != nil {
+		return err
+	}
+	return s.repo.SaveMember(ctx, h.ID, &Member{User: *u, Role: RoleOwner})
+}
+
+// GetHousehold returns the household of the authenticated user together with its members.
+func (s *dobbyFinancier) GetHousehold(ctx context.Context) (*Household, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	householdID, ok := HouseholdIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no household", ErrNotFound)
+	}
+	return s.getHousehold(ctx, householdID)
+}
+
+func (s *dobbyFinancier) getHousehold(ctx context.Context, id uuid.UUID) (*Household, error) {
+	h, err := s.repo.GetHousehold(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.repo.ListMembers(WithHouseholdID(ctx, id))
+	if err != nil {
+		return nil, err
+	}
+	h.Members = members
+	return h, nil
+}
+
+// CreateInvitation issues a one-time code other users can join the household of the authenticated user with.
+// Joining users get the given role, editor if empty.
+func (s *dobbyFinancier) CreateInvitation(ctx context.Context, role Role) (*HouseholdInvitation, error) {
+	if err := s.authz.Authorize(ctx, ActionManageMembers); err != nil {
+		return nil, err
+	}
+	householdID, ok := HouseholdIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no household", ErrNotFound)
+	}
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", ErrValidation)
+	}
+	if role == "" {
+		role = RoleEditor
+	}
+	if !role.Valid() {
+		return nil, fmt.Errorf("%w: unknown role %q", ErrValidation, role)
+	}
+
+	secret := make([]byte, 18)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	code := base64.RawURLEncoding.EncodeToString(secret)
+
+	inv := &HouseholdInvitation{
+		ID:          uuid.New(),
+		HouseholdID: householdID,
+		Code:        code,
+		CodeHash:    hashInvitationCode(code),
+		Role:        role,
+		CreatedBy:   userID,
+		ExpiresAt:   time.Now().Add(invitationTTL),
+	}
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveInvitation(ctx, inv); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditInvitation, inv.ID, nil, inv)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// JoinHousehold moves the authenticated user into the household the invitation code belongs to.
+// The user leaves their previous household, whose data stays with its remaining members.
+// The last owner cannot leave a household others are still members of.
+func (s *dobbyFinancier) JoinHousehold(ctx context.Context, code string) (*Household, error) {
+	if err := s.authz.Authorize(ctx, ActionJoinHousehold); err != nil {
+		return nil, err
+	}
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", ErrValidation)
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, fmt.Errorf("%w: invitation code is required", ErrValidation)
+	}
+
+	var householdID uuid.UUID
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		inv, err := s.repo.GetInvitationByCodeHash(ctx, hashInvitationCode(code))
+		if errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("%w: unknown invitation code", ErrValidation)
+		}
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if inv.AcceptedBy != nil {
+			return fmt.Errorf("%w: invitation has already been used", ErrConflict)
+		}
+		if now.After(inv.ExpiresAt) {
+			return fmt.Errorf("%w: invitation has expired", ErrValidation)
+		}
+		if current, ok := HouseholdIDFromContext(ctx); ok {
+			if current == inv.HouseholdID {
+				return fmt.Errorf("%w: already a member of this household", ErrConflict)
+			}
+			members, err := s.repo.ListMembers(ctx)
+			if err != nil {
+				return err
+			}
+			if err := checkOwnerRemains(members, userID, ""); err != nil {
+				return err
+			}
+			for _, m := range members {
+				if m.ID != userID {
+					continue
+				}
+				if err := s.audit(ctx, AuditMember, userID, m, nil); err != nil {
+					return err
+				}
+			}
+		}
+
+		before := *inv
+		inv.AcceptedBy = &userID
+		inv.AcceptedAt = &now
+		if err := s.repo.SaveInvitation(ctx, inv); err != nil {
+			return err
+		}
+		member := &Member{User: User{ID: userID}, Role: inv.Role}
+		if err := s.repo.SaveMember(ctx, inv.HouseholdID, member); err != nil {
+			return err
+		}
+		householdID = inv.HouseholdID
+
+		// The invitation and the new membership belong to the household being joined.
+		ctx = WithHouseholdID(ctx, householdID)
+		if err := s.audit(ctx, AuditInvitation, inv.ID, before, inv); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditMember, userID, nil, member)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.getHousehold(ctx, householdID)
+}
+
+// UpdateMember changes the role and envelope grants of a member of the household.
+func (s *dobbyFinancier) UpdateMember(ctx context.Context, m Member) (*Member, error) {
+	if err := s.authz.Authorize(ctx, ActionManageMembers); err != nil {
+		return nil, err
+	}
+	householdID, ok := HouseholdIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no household", ErrNotFound)
+	}
+	if !m.Role.Valid() {
+		return nil, fmt.Errorf("%w: unknown role %q", ErrValidation, m.Role)
+	}
+
+	var updated *Member
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		members, err := s.repo.ListMembers(ctx)
+		if err != nil {
+			return err
+		}
+		for i := range members {
+			if members[i].ID == m.ID {
+				updated = &members[i]
+			}
+		}
+		if updated == nil {
+			return ErrNotFound
+		}
+		if err := checkOwnerRemains(members, m.ID, m.Role); err != nil {
+			return err
+		}
+		for _, id := range m.EnvelopeIDs {
+			if _, err := s.repo.GetEnvelope(ctx, id); err != nil {
+				if errors.Is(err, ErrNotFound) {
+					return fmt.Errorf("%w: envelope %s does not exist", ErrValidation, id)
+				}
+				return err
+			}
+		}
+		before := *updated
+		updated.Role = m.Role
+		updated.EnvelopeIDs = m.EnvelopeIDs
+		if err := s.repo.SaveMember(ctx, householdID, updated); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditMember, m.ID, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// checkOwnerRemains fails if giving userID the role newRole, or removing them when empty,
+// would leave the other members without an owner.
+func checkOwnerRemains(members []Member, userID uuid.UUID, newRole Role) error {
+	others := false
+	for _, m := range members {
+		if m.ID == userID {
+			continue
+		}
+		if m.Role == RoleOwner {
+			return nil
+		}
+		others = true
+	}
+	if newRole == RoleOwner || !others && newRole == "" {
+		return nil
+	}
+	return fmt.Errorf("%w: the household needs at least one owner", ErrConflict)
+}
+
+func hashInvitationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// idempotencyWindow is how long the result of a request is replayed to retries with the same key.
+	idempotencyWindow       = 24 * time.Hour
+	maxIdempotencyKeyLength = 255
+)
+
+type idempotencyKeyKey struct{}
+
+// WithIdempotencyKey returns a context of a request the client may retry with the same key.
+// Creating an entity in such a context happens once, retries get the original result back.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key of the request, if any.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyKey{}).(string)
+	return key, ok
+}
+
+// IdempotentRequest records a request made with an idempotency key by a member of the household.
+type IdempotentRequest struct {
+	Key         string
+	Operation   string
+	RequestHash string
+	Response    json.RawMessage // Nil while the request is in progress
+	CreatedAt   time.Time
+}
+
+// idempotent runs create once per idempotency key of the authenticated user, returning the result of
+// the first run to retries. A retry for another operation or with a different request fails with
+// ErrIdempotencyKeyReused. The key is reserved, create runs and its result is recorded in one
+// transaction, so a key is never left reserved without a result: retries arriving meanwhile wait for
+// it, and a failed or interrupted run releases the key for the client to try again.
+// Without an idempotency key in ctx, create simply runs.
+func idempotent[T any](ctx context.Context, s *dobbyFinancier, operation string, request any, create func(ctx context.Context) (*T, error)) (*T, error) {
+	key, ok := IdempotencyKeyFromContext(ctx)
+	if !ok {
+		return create(ctx)
+	}
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return nil, fmt.Errorf("%w: idempotency key must be 1 to %d characters", ErrValidation, maxIdempotencyKeyLength)
+	}
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", ErrForbidden)
+	}
+	hash, err := requestHash(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *T
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		req := &IdempotentRequest{Key: key, Operation: operation, RequestHash: hash, CreatedAt: now}
+		reserved, err := s.repo.ReserveIdempotencyKey(ctx, userID, req, now.Add(-idempotencyWindow))
+		if err != nil {
+			return err
+		}
+		if !reserved {
+			res, err = replay[T](ctx, s, userID, req)
+			return err
+		}
+
+		if res, err = create(ctx); err != nil {
+			return err
+		}
+		response, err := json.Marshal(res)
+		if err != nil {
+			return fmt.Errorf("failed to record response of %s: %w", operation, err)
+		}
+		return s.repo.CompleteIdempotencyKey(ctx, userID, key, response)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// replay returns the result recorded for an earlier request with the key of req.
+func replay[T any](ctx context.Context, s *dobbyFinancier, userID uuid.UUID, req *IdempotentRequest) (*T, error) {
+	first, err := s.repo.GetIdempotencyKey(ctx, userID, req.Key)
+	if errors.Is(err, ErrNotFound) {
+		// The first request expired and was forgotten in the meantime.
+		return nil, fmt.Errorf("%w: request with idempotency key %q was not completed, retry it", ErrConflict, req.Key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if first.Operation != req.Operation || first.RequestHash != req.RequestHash {
+		return nil, fmt.Errorf("%w: idempotency key %q was used for a different request", ErrIdempotencyKeyReused, req.Key)
+	}
+	if first.Response == nil {
+		return nil, fmt.Errorf("%w: request with idempotency key %q is still in progress", ErrConflict, req.Key)
+	}
+	var res T
+	if err := json.Unmarshal(first.Response, &res); err != nil {
+		return nil, fmt.Errorf("failed to replay response of %s: %w", req.Operation, err)
+	}
+	return &res, nil
+}
+
+// requestHash fingerprints a request, so that retries can be told apart from reuse of their key.
+func requestHash(request any) (string, error) {
+	b, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash request: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// idempotencyRepo keeps idempotent requests in memory; other Repository methods are not used.
+type idempotencyRepo struct {
+	Repository
+	requests    map[string]*IdempotentRequest
+	completeErr error
+}
+
+func (r *idempotencyRepo) ReserveIdempotencyKey(ctx context.Context, userID uuid.UUID, req *IdempotentRequest, since time.Time) (bool, error) {
+	if first, ok := r.requests[req.Key]; ok && !first.CreatedAt.Before(since) {
+		return false, nil
+	}
+	reserved := *req
+	r.requests[req.Key] = &reserved
+	return true, nil
+}
+
+func (r *idempotencyRepo) GetIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (*IdempotentRequest, error) {
+	req, ok := r.requests[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return req, nil
+}
+
+func (r *idempotencyRepo) CompleteIdempotencyKey(ctx context.Context, userID uuid.UUID, key string, response []byte) error {
+	if r.completeErr != nil {
+		return r.completeErr
+	}
+	r.requests[key].Response = response
+	return nil
+}
+
+// WithTx rolls the requests back when fn fails, like a transaction of the database would.
+func (r *idempotencyRepo) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	saved := map[string]IdempotentRequest{}
+	for key, req := range r.requests {
+		saved[key] = *req
+	}
+	if err := fn(ctx); err != nil {
+		clear(r.requests)
+		for key, req := range saved {
+			r.requests[key] = &req
+		}
+		return err
+	}
+	return nil
+}
+
+func newIdempotencyService() *dobbyFinancier {
+	repo := &idempotencyRepo{requests: map[string]*IdempotentRequest{}}
+	return &dobbyFinancier{repo: repo, txManager: repo}
+}
+
+func TestIdempotent(t *testing.T) {
+	s := newIdempotencyService()
+	ctx := WithIdempotencyKey(WithUserID(context.Background(), uuid.New()), "retry-me")
+
+	runs := 0
+	create := func(ctx context.Context) (*Envelope, error) {
+		runs++
+		return &Envelope{ID: uuid.New(), Name: "Groceries", Version: 1}, nil
+	}
+	request := Envelope{Name: "Groceries"}
+
+	first, err := idempotent(ctx, s, "create_envelope", request, create)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	retried, err := idempotent(ctx, s, "create_envelope", request, create)
+	if err != nil {
+		t.Fatalf("retry: unexpected error %v", err)
+	}
+	if runs != 1 || *retried != *first {
+		t.Errorf("expected the retry to replay %+v without running, got %+v after %d runs", first, retried, runs)
+	}
+
+	if _, err := idempotent(ctx, s, "create_envelope", Envelope{Name: "Rent"}, create); !errors.Is(err, ErrIdempotencyKeyReused) {
+		t.Errorf("different request: expected ErrIdempotencyKeyReused, got %v", err)
+	}
+	if _, err := idempotent(ctx, s, "create_period", request, create); !errors.Is(err, ErrIdempotencyKeyReused) {
+		t.Errorf("different operation: expected ErrIdempotencyKeyReused, got %v", err)
+	}
+
+	if _, err := idempotent(context.Background(), s, "create_envelope", request, create); err != nil || runs != 2 {
+		t.Errorf("without a key: expected another run, got %d runs, %v", runs, err)
+	}
+}
+
+func TestIdempotentReleasesFailedRequests(t *testing.T) {
+	s := newIdempotencyService()
+	ctx := WithIdempotencyKey(WithUserID(context.Background(), uuid.New()), "retry-me")
+
+	fail := func(ctx context.Context) (*Envelope, error) { return nil, ErrValidation }
+	if _, err := idempotent(ctx, s, "create_envelope", Envelope{}, fail); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation, got %v", err)
+	}
+	succeed := func(ctx context.Context) (*Envelope, error) { return &Envelope{Name: "Groceries"}, nil }
+	if _, err := idempotent(ctx, s, "create_envelope", Envelope{Name: "Groceries"}, succeed); err != nil {
+		t.Errorf("fixed request with the same key: unexpected error %v", err)
+	}
+}
+
+func TestIdempotentReleasesUnrecordedRequests(t *testing.T) {
+	s := newIdempotencyService()
+	repo := s.repo.(*idempotencyRepo)
+	ctx := WithIdempotencyKey(WithUserID(context.Background(), uuid.New()), "retry-me")
+
+	runs := 0
+	create := func(ctx context.Context) (*Envelope, error) {
+		runs++
+		return &Envelope{Name: "Groceries"}, nil
+	}
+	repo.completeErr = errors.New("connection lost")
+	if _, err := idempotent(ctx, s, "create_envelope", Envelope{Name: "Groceries"}, create); !errors.Is(err, repo.completeErr) {
+		t.Fatalf("expected the recording error, got %v", err)
+	}
+	if _, ok := repo.requests["retry-me"]; ok {
+		t.Fatalf("expected the key released with the rolled back request")
+	}
+
+	repo.completeErr = nil
+	if _, err := idempotent(ctx, s, "create_envelope", Envelope{Name: "Groceries"}, create); err != nil || runs != 2 {
+		t.Errorf("retry: expected another run, got %d runs, %v", runs, err)
+	}
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImportFormat identifies the format of an imported bank statement.
+type ImportFormat string
+
+const (
+	ImportFormatCSV     ImportFormat = "csv"
+	ImportFormatOFX     ImportFormat = "ofx" // Also covers QFX, which is OFX with Quicken-specific extensions
+	ImportFormatCAMT053 ImportFormat = "camt053"
+)
+
+// ImportRequest describes a bank statement to import into the ledger.
+type ImportRequest struct {
+	Format     ImportFormat
+	Content    []byte
+	CSV        CSVMapping // Only used with ImportFormatCSV
+	EnvelopeID uuid.UUID  // Envelope imported transactions are recorded in. When empty, it is set by rules or the period default.
+	Category   string     // Analytics tag applied to imported transactions. When empty, it is set by rules.
+	Commit     bool       // When false, the import is only previewed and nothing is saved
+}
+
+// ImportRowStatus tells what happens (or happened) to a single statement row.
+type ImportRowStatus string
+
+const (
+	ImportRowNew       ImportRowStatus = "new"       // Row is (or will be, on commit) recorded as a new transaction
+	ImportRowDuplicate ImportRowStatus = "duplicate" // Row matches an already recorded transaction and is skipped
+	ImportRowInvalid   ImportRowStatus = "invalid"   // Row could not be parsed or placed in a period and is skipped
+)
+
+// ImportRow is the outcome of importing a single statement row.
+type ImportRow struct {
+	Line        int // Line (or entry) number in the source statement, starting from 1
+	Status      ImportRowStatus
+	Transaction Transaction // Empty for invalid rows
+	Error       string      // Set for invalid rows only
+}
+
+// ImportResult is the outcome of an import, either previewed or committed.
+type ImportResult struct {
+	Committed bool
+	Rows      []ImportRow
+}
+
+// Count returns the number of rows with the given status.
+func (r *ImportResult) Count(status ImportRowStatus) int {
+	n := 0
+	for _, row := range r.Rows {
+		if row.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// statementEntry is a single movement parsed from a bank statement, before it is matched to the ledger.
+type statementEntry struct {
+	Line        int
+	Date        time.Time
+	Amount      int64 // Cents, negative for expenses
+	Description string
+	ExternalRef string // Bank-assigned unique reference (OFX FITID, CAMT AcctSvcrRef), if the format has one
+	Err         error  // Set when the entry could not be parsed
+}
+
+func (s *dobbyFinancier) ImportTransactions(ctx context.Context, req ImportRequest) (*ImportResult, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	if req.EnvelopeID != uuid.Nil {
+		if _, err := s.repo.GetEnvelope(ctx, req.EnvelopeID); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		entries []statementEntry
+		err     error
+	)
+	switch req.Format {
+	case ImportFormatCSV:
+		entries, err = parseCSVStatement(req.Content, req.CSV)
+	case ImportFormatOFX:
+		entries, err = parseOFXStatement(req.Content)
+	case ImportFormatCAMT053:
+		entries, err = parseCAMT053Statement(req.Content)
+	default:
+		err = fmt.Errorf("%w: unsupported import format %q", ErrValidation, req.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	periods, err := s.repo.ListPeriods(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := s.loadRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ImportResult{Rows: make([]ImportRow, 0, len(entries))}
+	existing := make(map[uuid.UUID]map[string]int) // Period ID -> duplicate key -> unmatched recorded transactions
+	seenRefs := make(map[string]bool)              // External references already present in this statement
+	for _, e := range entries {
+		row := ImportRow{Line: e.Line}
+		if e.Err != nil {
+			row.Status = ImportRowInvalid
+			row.Error = e.Err.Error()
+			res.Rows = append(res.Rows, row)
+			continue
+		}
+
+		period := periodForDate(periods, e.Date)
+		if period == nil {
+			row.Status = ImportRowInvalid
+			row.Error = "no period found for transaction date"
+			res.Rows = append(res.Rows, row)
+			continue
+		}
+
+		row.Transaction = Transaction{
+			ID:          uuid.New(),
+			PeriodID:    period.ID,
+			EnvelopeID:  req.EnvelopeID,
+			Amount:      e.Amount,
+			Description: e.Description,
+			Date:        e.Date,
+			Category:    req.Category,
+		}
+		err := categorize(rs, &row.Transaction, period)
+		if err == nil {
+			err = s.checkEnvelopes(ctx, row.Transaction)
+		}
+		if errors.Is(err, ErrValidation) {
+			row.Status = ImportRowInvalid
+			row.Error = err.Error()
+			row.Transaction = Transaction{}
+			res.Rows = append(res.Rows, row)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if e.ExternalRef != "" {
+			ref := e.ExternalRef
+			row.Transaction.ExternalRef = &ref
+
+			dup, err := s.hasExternalRef(ctx, ref)
+			if err != nil {
+				return nil, err
+			}
+			if dup || seenRefs[ref] {
+				row.Status = ImportRowDuplicate
+				res.Rows = append(res.Rows, row)
+				continue
+			}
+			seenRefs[ref] = true
+		}
+
+		keys, ok := existing[period.ID]
+		if !ok {
+			keys, err = s.duplicateKeys(ctx, period.ID)
+			if err != nil {
+				return nil, err
+			}
+			existing[period.ID] = keys
+		}
+		// Each recorded transaction can only absorb one imported row, so that
+		// legitimately repeated purchases (two coffees on the same day) are kept.
+		key := duplicateKey(row.Transaction)
+		if keys[key] > 0 {
+			keys[key]--
+			row.Status = ImportRowDuplicate
+		} else {
+			row.Status = ImportRowNew
+		}
+		res.Rows = append(res.Rows, row)
+	}
+
+	if !req.Commit {
+		return res, nil
+	}
+
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		for i := range res.Rows {
+			if res.Rows[i].Status != ImportRowNew {
+				continue
+			}
+			t := &res.Rows[i].Transaction
+			stampCreated(ctx, t)
+			if err := s.repo.SaveTransaction(ctx, t); err != nil {
+				return err
+			}
+			if err := s.audit(ctx, AuditTransaction, t.ID, nil, t); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Committed = true
+	return res, nil
+}
+
+// hasExternalRef reports whether a transaction with the given external reference is already recorded.
+func (s *dobbyFinancier) hasExternalRef(ctx context.Context, ref string) (bool, error) {
+	// Transactions in the trash count too: they were deleted on purpose and still hold the reference.
+	txs, err := s.repo.ListTransactions(ctx, TransactionFilter{ExternalRef: &ref, IncludeDeleted: true})
+	if err != nil {
+		return false, err
+	}
+	return len(txs) > 0, nil
+}
+
+// duplicateKeys counts recorded transactions of a period by their duplicate key.
+// Transactions with an external reference are left out, as they are matched by reference instead.
+func (s *dobbyFinancier) duplicateKeys(ctx context.Context, periodID uuid.UUID) (map[string]int, error) {
+	txs, err := s.repo.ListTransactions(ctx, TransactionFilter{PeriodID: &periodID})
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]int, len(txs))
+	for _, t := range txs {
+		if t.ExternalRef == nil {
+			keys[duplicateKey(t)]++
+		}
+	}
+	return keys, nil
+}
+
+// duplicateKey identifies transactions that most likely describe the same bank movement.
+func duplicateKey(t Transaction) string {
+	desc := strings.Join(strings.Fields(strings.ToLower(t.Description)), " ")
+	return fmt.Sprintf("%s|%d|%s", t.Date.Format(time.DateOnly), t.Amount, desc)
+}
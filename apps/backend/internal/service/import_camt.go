@@ -0,0 +1,111 @@
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// camtDocument maps the parts of an ISO 20022 CAMT.053 bank-to-customer statement needed for import.
+// Namespaces are ignored, so any camt.053.001.xx version is accepted.
+type camtDocument struct {
+	Statements []struct {
+		Entries []camtEntry `xml:"Ntry"`
+	} `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtEntry struct {
+	Amount      string `xml:"Amt"`
+	CreditDebit string `xml:"CdtDbtInd"`
+	Status      struct {
+		Value string `xml:",chardata"`
+		Code  string `xml:"Cd"` // camt.053.001.08 and later
+	} `xml:"Sts"`
+	BookingDate struct {
+		Date     string `xml:"Dt"`
+		DateTime string `xml:"DtTm"`
+	} `xml:"BookgDt"`
+	AcctSvcrRef    string `xml:"AcctSvcrRef"`
+	AdditionalInfo string `xml:"AddtlNtryInf"`
+	Details        []struct {
+		Unstructured []string `xml:"RmtInf>Ustrd"`
+		Creditor     string   `xml:"RltdPties>Cdtr>Nm"`
+		Debtor       string   `xml:"RltdPties>Dbtr>Nm"`
+	} `xml:"NtryDtls>TxDtls"`
+}
+
+// parseCAMT053Statement reads statement entries out of a CAMT.053 XML statement.
+// Entries that are not booked yet are reported as invalid.
+func parseCAMT053Statement(content []byte) ([]statementEntry, error) {
+	var doc camtDocument
+	if err := xml.NewDecoder(bytes.NewReader(content)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse CAMT.053 statement: %v", ErrValidation, err)
+	}
+
+	var entries []statementEntry
+	for _, stmt := range doc.Statements {
+		for _, n := range stmt.Entries {
+			e := statementEntry{
+				Line:        len(entries) + 1,
+				ExternalRef: strings.TrimSpace(n.AcctSvcrRef),
+				Description: n.description(),
+			}
+			e.Date, e.Amount, e.Err = n.parse()
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+func (n camtEntry) parse() (time.Time, int64, error) {
+	if status := strings.TrimSpace(n.Status.Code + n.Status.Value); status != "" && status != "BOOK" {
+		return time.Time{}, 0, fmt.Errorf("entry status is %s, only booked entries are imported", status)
+	}
+
+	// Only the date part of DtTm ("2026-03-05T10:15:00+01:00") is relevant.
+	d := strings.TrimSpace(n.BookingDate.Date)
+	if d == "" {
+		d = strings.TrimSpace(n.BookingDate.DateTime)
+	}
+	if len(d) < len(time.DateOnly) {
+		return time.Time{}, 0, fmt.Errorf("invalid booking date %q", d)
+	}
+	date, err := time.Parse(time.DateOnly, d[:len(time.DateOnly)])
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("invalid booking date %q", d)
+	}
+
+	amount, err := parseCents(strings.TrimSpace(n.Amount), '.')
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	switch strings.TrimSpace(n.CreditDebit) {
+	case "DBIT":
+		amount = -abs(amount)
+	case "CRDT":
+		amount = abs(amount)
+	default:
+		return time.Time{}, 0, fmt.Errorf("invalid credit/debit indicator %q", n.CreditDebit)
+	}
+	return date, amount, nil
+}
+
+func (n camtEntry) description() string {
+	var parts []string
+	for _, d := range n.Details {
+		counterparty := d.Creditor
+		if strings.TrimSpace(n.CreditDebit) == "CRDT" {
+			counterparty = d.Debtor
+		}
+		parts = append(parts, strings.TrimSpace(counterparty))
+		for _, u := range d.Unstructured {
+			parts = append(parts, strings.TrimSpace(u))
+		}
+	}
+	if desc := joinNonEmpty(parts...); desc != "" {
+		return desc
+	}
+	return strings.TrimSpace(n.AdditionalInfo)
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignConvention tells how a CSV statement expresses income and expenses.
+type SignConvention string
+
+const (
+	SignSigned      SignConvention = "signed"       // Amount column is negative for expenses
+	SignInverted    SignConvention = "inverted"     // Amount column is positive for expenses
+	SignDebitCredit SignConvention = "debit_credit" // Expenses and income are in separate, unsigned columns
+)
+
+// CSVMapping describes how to read transactions out of a CSV bank statement.
+// Columns are referenced by their header name.
+type CSVMapping struct {
+	Delimiter         rune
+	SkipRows          int // Lines to skip before the header row
+	DateColumn        string
+	DateFormat        string // e.g. "DD.MM.YYYY", see csvDateLayout
+	AmountColumn      string // Used with SignSigned and SignInverted
+	DebitColumn       string // Used with SignDebitCredit
+	CreditColumn      string // Used with SignDebitCredit
+	DescriptionColumn string
+	DecimalSeparator  rune // '.' or ','
+	SignConvention    SignConvention
+}
+
+func (m *CSVMapping) setDefaults() {
+	if m.Delimiter == 0 {
+		m.Delimiter = ','
+	}
+	if m.DateFormat == "" {
+		m.DateFormat = "YYYY-MM-DD"
+	}
+	if m.DecimalSeparator == 0 {
+		m.DecimalSeparator = '.'
+	}
+	if m.SignConvention == "" {
+		m.SignConvention = SignSigned
+	}
+}
+
+func (m CSVMapping) validate() error {
+	if m.DateColumn == "" {
+		return fmt.Errorf("%w: date column is required", ErrValidation)
+	}
+	if m.DecimalSeparator != '.' && m.DecimalSeparator != ',' {
+		return fmt.Errorf("%w: decimal separator must be '.' or ','", ErrValidation)
+	}
+	switch m.SignConvention {
+	case SignSigned, SignInverted:
+		if m.AmountColumn == "" {
+			return fmt.Errorf("%w: amount column is required", ErrValidation)
+		}
+	case SignDebitCredit:
+		if m.DebitColumn == "" || m.CreditColumn == "" {
+			return fmt.Errorf("%w: debit and credit columns are required", ErrValidation)
+		}
+	default:
+		return fmt.Errorf("%w: unknown sign convention %q", ErrValidation, m.SignConvention)
+	}
+	return nil
+}
+
+// csvDateLayout converts a human date format (YYYY, YY, MM, DD, HH, mm, ss) into a Go time layout.
+var csvDateLayout = strings.NewReplacer(
+	"YYYY", "2006",
+	"YY", "06",
+	"MM", "01",
+	"DD", "02",
+	"HH", "15",
+	"mm", "04",
+	"ss", "05",
+)
+
+// parseCSVStatement reads statement entries out of CSV content. Errors in individual rows are
+// reported on the entries, while malformed content or mapping fails the whole parse.
+func parseCSVStatement(content []byte, m CSVMapping) ([]statementEntry, error) {
+	m.setDefaults()
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+
+	r := csv.NewReader(bytes.NewReader(content))
+	r.Comma = m.Delimiter
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	line := 0
+	for range m.SkipRows {
+		if _, err := r.Read(); err != nil {
+			return nil, fmt.Errorf("%w: statement has fewer than %d lines", ErrValidation, m.SkipRows)
+		}
+		line++
+	}
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read CSV header: %v", ErrValidation, err)
+	}
+	line++
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+	for _, name := range []string{m.DateColumn, m.AmountColumn, m.DebitColumn, m.CreditColumn, m.DescriptionColumn} {
+		if _, ok := columns[name]; name != "" && !ok {
+			return nil, fmt.Errorf("%w: column %q not found in CSV header", ErrValidation, name)
+		}
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if name == "" || !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	layout := csvDateLayout.Replace(m.DateFormat)
+	var entries []statementEntry
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			entries = append(entries, statementEntry{Line: line, Err: err})
+			continue
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+
+		e := statementEntry{Line: line, Description: field(record, m.DescriptionColumn)}
+		e.Date, e.Err = time.Parse(layout, field(record, m.DateColumn))
+		if e.Err == nil {
+			e.Amount, e.Err = csvAmount(record, field, m)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func csvAmount(record []string, field func([]string, string) string, m CSVMapping) (int64, error) {
+	switch m.SignConvention {
+	case SignDebitCredit:
+		debit, credit := field(record, m.DebitColumn), field(record, m.CreditColumn)
+		var amount int64
+		if debit != "" {
+			d, err := parseCents(debit, m.DecimalSeparator)
+			if err != nil {
+				return 0, err
+			}
+			amount -= abs(d)
+		}
+		if credit != "" {
+			c, err := parseCents(credit, m.DecimalSeparator)
+			if err != nil {
+				return 0, err
+			}
+			amount += abs(c)
+		}
+		return amount, nil
+	case SignInverted:
+		amount, err := parseCents(field(record, m.AmountColumn), m.DecimalSeparator)
+		return -amount, err
+	default:
+		return parseCents(field(record, m.AmountColumn), m.DecimalSeparator)
+	}
+}
+
+// parseCents parses a decimal money amount such as "-1.234,56" or "(12.30)" into cents.
+// Whatever separator is not the decimal one is treated as a thousands separator.
+func parseCents(s string, decimalSep rune) (int64, error) {
+	orig := s
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9', r == '-', r == '+', r == '(', r == ')', r == decimalSep:
+			return r
+		default:
+			return -1 // Thousands separators, spaces and currency symbols
+		}
+	}, s)
+
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+	if strings.HasPrefix(s, "-") {
+		negative = !negative
+		s = s[1:]
+	} else {
+		s = strings.TrimPrefix(s, "+")
+	}
+
+	if s == "" {
+		return 0, fmt.Errorf("invalid amount %q", orig)
+	}
+
+	whole, frac, _ := strings.Cut(s, string(decimalSep))
+	if trimmed := strings.TrimRight(frac, "0"); len(trimmed) > 2 {
+		return 0, fmt.Errorf("invalid amount %q: more than 2 decimal places", orig)
+	}
+	frac = (frac + "00")[:2]
+	if whole == "" {
+		whole = "0"
+	}
+
+	cents, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", orig)
+	}
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+func isBlankRecord(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCents(t *testing.T) {
+	tests := []struct {
+		in      string
+		sep     rune
+		want    int64
+		wantErr bool
+	}{
+		{in: "12.34", sep: '.', want: 1234},
+		{in: "-1,234.5", sep: '.', want: -123450},
+		{in: "1.234,56 RSD", sep: ',', want: 123456},
+		{in: "(12.30)", sep: '.', want: -1230},
+		{in: "7", sep: '.', want: 700},
+		{in: "1.5000", sep: '.', want: 150},
+		{in: "1.234", sep: '.', wantErr: true},
+		{in: "", sep: '.', wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseCents(tt.in, tt.sep)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseCents(%q): expected error, got %d", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseCents(%q): unexpected error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseCents(%q): expected %d, got %d", tt.in, tt.want, got)
+		}
+	}
+}
+
+func TestParseCSVStatement(t *testing.T) {
+	content := "Account statement\n" +
+		"Datum;Opis;Zaduzenje;Odobrenje\n" +
+		"05.03.2026;APOTEKA BENU;1.250,00;\n" +
+		"06.03.2026;SALARY;;150.000,00\n" +
+		";;;\n" +
+		"bad date;SHOP;10,00;\n"
+
+	entries, err := parseCSVStatement([]byte(content), CSVMapping{
+		Delimiter:         ';',
+		SkipRows:          1,
+		DateColumn:        "Datum",
+		DateFormat:        "DD.MM.YYYY",
+		DebitColumn:       "Zaduzenje",
+		CreditColumn:      "Odobrenje",
+		DescriptionColumn: "Opis",
+		DecimalSeparator:  ',',
+		SignConvention:    SignDebitCredit,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+
+	if e := entries[0]; e.Err != nil || e.Amount != -125000 || e.Description != "APOTEKA BENU" || !e.Date.Equal(date(2026, time.March, 5)) || e.Line != 3 {
+		t.Errorf("unexpected first entry: %+v", e)
+	}
+	if e := entries[1]; e.Err != nil || e.Amount != 15000000 {
+		t.Errorf("unexpected second entry: %+v", e)
+	}
+	if e := entries[2]; e.Err == nil || e.Line != 6 {
+		t.Errorf("expected an error on line 6, got %+v", e)
+	}
+}
+
+func TestParseCSVStatementUnknownColumn(t *testing.T) {
+	_, err := parseCSVStatement([]byte("date,amount\n"), CSVMapping{DateColumn: "date", AmountColumn: "sum"})
+	if err == nil {
+		t.Fatal("expected an error for a missing column")
+	}
+}
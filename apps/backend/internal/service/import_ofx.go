@@ -0,0 +1,72 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ofxTransactionRe = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+	ofxFieldRe       = regexp.MustCompile(`(?i)<([A-Z0-9.]+)>([^<\r\n]*)`)
+)
+
+// parseOFXStatement reads statement entries out of an OFX or QFX statement.
+// Both SGML (OFX 1.x, unclosed leaf elements) and XML (OFX 2.x) flavours are supported.
+func parseOFXStatement(content []byte) ([]statementEntry, error) {
+	matches := ofxTransactionRe.FindAllSubmatch(content, -1)
+	if len(matches) == 0 && !strings.Contains(strings.ToUpper(string(content)), "<OFX>") {
+		return nil, fmt.Errorf("%w: content is not an OFX statement", ErrValidation)
+	}
+
+	entries := make([]statementEntry, 0, len(matches))
+	for i, m := range matches {
+		fields := make(map[string]string)
+		for _, f := range ofxFieldRe.FindAllSubmatch(m[1], -1) {
+			fields[strings.ToUpper(string(f[1]))] = strings.TrimSpace(string(f[2]))
+		}
+
+		e := statementEntry{
+			Line:        i + 1,
+			ExternalRef: fields["FITID"],
+			Description: joinNonEmpty(fields["NAME"], fields["MEMO"]),
+		}
+		e.Date, e.Err = parseOFXDate(fields["DTPOSTED"])
+		if e.Err == nil {
+			e.Amount, e.Err = parseCents(fields["TRNAMT"], ofxDecimalSeparator(fields["TRNAMT"]))
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// parseOFXDate parses the date part of an OFX datetime such as "20260305120000.000[+1:CET]".
+func parseOFXDate(s string) (time.Time, error) {
+	if len(s) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+	d, err := time.Parse("20060102", s[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+	return d, nil
+}
+
+// ofxDecimalSeparator guesses the decimal separator, as some banks use ',' despite the OFX specification.
+func ofxDecimalSeparator(amount string) rune {
+	if strings.Contains(amount, ",") && !strings.Contains(amount, ".") {
+		return ','
+	}
+	return '.'
+}
+
+func joinNonEmpty(parts ...string) string {
+	var res []string
+	for _, p := range parts {
+		if p != "" && (len(res) == 0 || res[len(res)-1] != p) {
+			res = append(res, p)
+		}
+	}
+	return strings.Join(res, " ")
+}
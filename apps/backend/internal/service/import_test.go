@@ -0,0 +1,200 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestParseOFXStatement(t *testing.T) {
+	content := `OFXHEADER:100
+DATA:OFXSGML
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20260305120000.000[+1:CET]
+<TRNAMT>-12.50
+<FITID>2026030500001
+<NAME>APOTEKA BENU
+<MEMO>Card payment
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20260306
+<TRNAMT>1500,00
+<FITID>2026030600002
+<NAME>SALARY
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+	entries, err := parseOFXStatement([]byte(content))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if e := entries[0]; e.Err != nil || e.Amount != -1250 || e.ExternalRef != "2026030500001" ||
+		e.Description != "APOTEKA BENU Card payment" || !e.Date.Equal(date(2026, time.March, 5)) {
+		t.Errorf("unexpected first entry: %+v", e)
+	}
+	if e := entries[1]; e.Err != nil || e.Amount != 150000 || e.ExternalRef != "2026030600002" {
+		t.Errorf("unexpected second entry: %+v", e)
+	}
+}
+
+func TestParseCAMT053Statement(t *testing.T) {
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Ntry>
+        <Amt Ccy="RSD">1250.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>2026-03-05</Dt></BookgDt>
+        <AcctSvcrRef>REF-1</AcctSvcrRef>
+        <NtryDtls><TxDtls>
+          <RltdPties><Cdtr><Nm>APOTEKA BENU</Nm></Cdtr></RltdPties>
+          <RmtInf><Ustrd>Receipt 42</Ustrd></RmtInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="RSD">10.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts>PDNG</Sts>
+        <BookgDt><DtTm>2026-03-06T10:00:00+01:00</DtTm></BookgDt>
+        <AddtlNtryInf>Pending card payment</AddtlNtryInf>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`
+
+	entries, err := parseCAMT053Statement([]byte(content))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if e := entries[0]; e.Err != nil || e.Amount != -125000 || e.ExternalRef != "REF-1" ||
+		e.Description != "APOTEKA BENU Receipt 42" || !e.Date.Equal(date(2026, time.March, 5)) {
+		t.Errorf("unexpected first entry: %+v", e)
+	}
+	if e := entries[1]; e.Err == nil || e.Line != 2 {
+		t.Errorf("expected pending entry to be reported as invalid, got %+v", e)
+	}
+}
+
+func TestImportTransactions(t *testing.T) {
+	repo := newMemRepo()
+	s, ctx := newMemService(repo)
+	groceries := Envelope{ID: uuid.New(), Name: "Groceries", RolloverPolicy: RolloverReset}
+	fallback := Envelope{ID: uuid.New(), Name: "Everything else", RolloverPolicy: RolloverReset}
+	period := Period{ID: uuid.New(), StartDate: date(2026, 3, 5), EndDate: date(2026, 4, 5), DefaultEnvelopeID: &fallback.ID}
+	coffee := Transaction{ID: uuid.New(), PeriodID: period.ID, EnvelopeID: fallback.ID, Amount: -450, Description: "Coffee", Date: date(2026, 3, 6)}
+	for _, err := range []error{
+		repo.SaveEnvelope(ctx, &groceries),
+		repo.SaveEnvelope(ctx, &fallback),
+		repo.SavePeriod(ctx, &period),
+		repo.SaveTransaction(ctx, &coffee),
+	} {
+		if err != nil {
+			t.Fatalf("fixture: %v", err)
+		}
+	}
+	repo.rules = []Rule{{Name: "market", DescriptionPattern: "market", Category: "groceries", EnvelopeID: &groceries.ID}}
+
+	req := ImportRequest{
+		Format: ImportFormatCSV,
+		Content: []byte("date,description,amount\n" +
+			"2026-03-06,coffee ,-4.50\n" + // Matches the recorded coffee
+			"2026-03-06,Coffee,-4.50\n" + // A second coffee that day
+			"2026-03-07,Green market,-20.00\n" +
+			"2026-03-08,Pharmacy,-12.00\n" +
+			"2026-05-01,Later,-1.00\n"),
+		CSV: CSVMapping{DateColumn: "date", DescriptionColumn: "description", AmountColumn: "amount"},
+	}
+	wantStatus := []ImportRowStatus{ImportRowDuplicate, ImportRowNew, ImportRowNew, ImportRowNew, ImportRowInvalid}
+
+	preview, err := s.ImportTransactions(ctx, req)
+	if err != nil {
+		t.Fatalf("preview: unexpected error: %v", err)
+	}
+	if preview.Committed || len(preview.Rows) != len(wantStatus) {
+		t.Fatalf("preview: expected %d uncommitted rows, got %+v", len(wantStatus), preview)
+	}
+	for i, row := range preview.Rows {
+		if row.Status != wantStatus[i] {
+			t.Errorf("row %d: expected %s, got %s (%s)", row.Line, wantStatus[i], row.Status, row.Error)
+		}
+	}
+	if got := preview.Rows[2].Transaction; got.EnvelopeID != groceries.ID || got.Category != "groceries" {
+		t.Errorf("expected the market row categorised by rule, got %q in %v", got.Category, got.EnvelopeID)
+	}
+	if got := preview.Rows[3].Transaction; got.EnvelopeID != fallback.ID {
+		t.Errorf("expected the pharmacy row in the period default envelope, got %v", got.EnvelopeID)
+	}
+	if preview.Rows[4].Error == "" {
+		t.Errorf("expected the row outside any period to tell why it is invalid")
+	}
+	if len(repo.transactions) != 1 || len(repo.audit) != 0 {
+		t.Fatalf("preview: expected nothing saved, got %d transactions", len(repo.transactions))
+	}
+
+	req.Commit = true
+	committed, err := s.ImportTransactions(ctx, req)
+	if err != nil {
+		t.Fatalf("commit: unexpected error: %v", err)
+	}
+	if !committed.Committed || committed.Count(ImportRowNew) != 3 || len(repo.transactions) != 4 || len(repo.audit) != 3 {
+		t.Fatalf("commit: expected 3 recorded and audited rows, got %+v with %d transactions", committed, len(repo.transactions))
+	}
+
+	// Importing the same statement again finds every row already recorded.
+	again, err := s.ImportTransactions(ctx, req)
+	if err != nil {
+		t.Fatalf("re-import: unexpected error: %v", err)
+	}
+	if again.Count(ImportRowDuplicate) != 4 || again.Count(ImportRowNew) != 0 || len(repo.transactions) != 4 {
+		t.Errorf("re-import: expected 4 duplicates and nothing recorded, got %+v", again.Rows)
+	}
+}
+
+func TestImportTransactionsWithoutEnvelope(t *testing.T) {
+	repo := newMemRepo()
+	s, ctx := newMemService(repo)
+	trashed := Envelope{ID: uuid.New(), Name: "Old", RolloverPolicy: RolloverReset}
+	for _, err := range []error{
+		repo.SaveEnvelope(ctx, &trashed),
+		repo.SavePeriod(ctx, &Period{ID: uuid.New(), StartDate: date(2026, 3, 5), EndDate: date(2026, 4, 5)}),
+	} {
+		if err != nil {
+			t.Fatalf("fixture: %v", err)
+		}
+	}
+	now := time.Now()
+	trashed.DeletedAt = &now
+	repo.envelopes[trashed.ID] = trashed
+	repo.rules = []Rule{{Name: "old", DescriptionPattern: "old", EnvelopeID: &trashed.ID}}
+
+	res, err := s.ImportTransactions(ctx, ImportRequest{
+		Format:  ImportFormatCSV,
+		Content: []byte("date,description,amount\n2026-03-06,Pharmacy,-12.00\n2026-03-07,Old habit,-3.00\n"),
+		CSV:     CSVMapping{DateColumn: "date", DescriptionColumn: "description", AmountColumn: "amount"},
+		Commit:  true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Neither a rule nor a period default envelope places the first row, the rule of the second goes to the trash.
+	if res.Count(ImportRowInvalid) != 2 || len(repo.transactions) != 0 {
+		t.Errorf("expected both rows invalid and nothing recorded, got %+v", res.Rows)
+	}
+}
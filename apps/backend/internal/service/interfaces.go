@@ -9,61 +9,203 @@ import (
 )
 
 var (
-	ErrNotFound          = errors.New("resource not found")
-	ErrValidation        = errors.New("validation error")
-	ErrPeriodOverlap     = errors.New("period dates overlap with existing period")
-	ErrInsufficientFunds = errors.New("insufficient funds")
-	ErrConflict          = errors.New("resource conflict")
+	ErrNotFound             = errors.New("resource not found")
+	ErrValidation           = errors.New("validation error")
+	ErrPeriodOverlap        = errors.New("period dates overlap with existing period")
+	ErrInsufficientFunds    = errors.New("insufficient funds")
+	ErrConflict             = errors.New("resource conflict")
+	ErrForbidden            = errors.New("operation not permitted")
+	ErrPreconditionFailed   = errors.New("resource version is not current")
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused")
 )
 
+// FinanceService is the application's use cases. Updates and deletes of versioned entities take
+// the version the change is based on and fail with ErrPreconditionFailed unless it is current;
+// zero skips the check. A change racing another one fails with ErrConflict instead.
 type FinanceService interface {
 	// Period Operations
 	CreatePeriod(ctx context.Context, start, end *time.Time) (*Period, error)
 	GetCurrentPeriod(ctx context.Context) (*PeriodSummary, error)
+	GetPeriod(ctx context.Context, id uuid.UUID) (*Period, error)
 	GetPeriodSummary(ctx context.Context, id uuid.UUID) (*PeriodSummary, error)
 	ListPeriods(ctx context.Context) ([]Period, error)
-	UpdatePeriod(ctx context.Context, id uuid.UUID, defaultEnvelopeID *uuid.UUID) (*PeriodSummary, error)
+	ListPeriodGaps(ctx context.Context) ([]PeriodGap, error)
+	UpdatePeriod(ctx context.Context, p Period) (*PeriodSummary, error)
+	GetPeriodSchedule(ctx context.Context) (*PeriodSchedule, error)
+	UpdatePeriodSchedule(ctx context.Context, sch PeriodSchedule) (*PeriodSchedule, error)
+	ListHolidays(ctx context.Context, year int) ([]Holiday, error)
+	ImportHolidays(ctx context.Context, content []byte) ([]Holiday, error)
+	DeletePeriod(ctx context.Context, id uuid.UUID, version int64) error
 
 	// Transaction Operations
 	RecordTransaction(ctx context.Context, t Transaction) (*Transaction, error)
-	ListTransactions(ctx context.Context, filter TransactionFilter) ([]Transaction, error)
+	ListTransactions(ctx context.Context, filter TransactionFilter, cursor string) (*TransactionPage, error)
+	SearchTransactions(ctx context.Context, query string, limit int) ([]SearchResult, error)
 	GetTransaction(ctx context.Context, id uuid.UUID) (*Transaction, error)
 	UpdateTransaction(ctx context.Context, t Transaction) (*Transaction, error)
-	DeleteTransaction(ctx context.Context, id uuid.UUID) error
+	DeleteTransaction(ctx context.Context, id uuid.UUID, version int64) error
+	TransferFunds(ctx context.Context, from, to uuid.UUID, amount int64, periodID uuid.UUID) (*Transfer, error)
+	ImportTransactions(ctx context.Context, req ImportRequest) (*ImportResult, error)
 
 	// Envelope Operations
-	CreateEnvelope(ctx context.Context, name string) (*Envelope, error)
+	CreateEnvelope(ctx context.Context, e Envelope) (*Envelope, error)
+	GetEnvelope(ctx context.Context, id uuid.UUID) (*Envelope, error)
 	ListEnvelopes(ctx context.Context) ([]Envelope, error)
-	DeleteEnvelope(ctx context.Context, id uuid.UUID) error
+	UpdateEnvelope(ctx context.Context, e Envelope) (*Envelope, error)
+	DeleteEnvelope(ctx context.Context, id uuid.UUID, version int64) error
+
+	// Trash Operations
+	ListTrash(ctx context.Context) (*Trash, error)
+	RestoreTransaction(ctx context.Context, id uuid.UUID) (*Transaction, error)
+	RestoreEnvelope(ctx context.Context, id uuid.UUID) (*Envelope, error)
+	PurgeTrash(ctx context.Context, retention time.Duration) (int64, error)
+
+	// User Operations
+	ListUsers(ctx context.Context) ([]User, error)
+	GetUser(ctx context.Context, id uuid.UUID) (*User, error)
+	ProvisionUser(ctx context.Context, u User) (*User, error)
+	GetMemberSpending(ctx context.Context, periodID uuid.UUID) ([]MemberSpending, error)
+
+	// Audit Operations
+	ListAuditEntries(ctx context.Context, filter AuditFilter) ([]AuditEntry, error)
+
+	// Access Token Operations
+	CreateAccessToken(ctx context.Context, name string, scope TokenScope, expiresAt *time.Time) (*AccessToken, error)
+	ListAccessTokens(ctx context.Context) ([]AccessToken, error)
+	RevokeAccessToken(ctx context.Context, id uuid.UUID) error
+	AuthenticateAccessToken(ctx context.Context, token string) (*AccessToken, error)
+
+	// Household Operations
+	GetUserHouseholdID(ctx context.Context, userID uuid.UUID) (uuid.UUID, error)
+	GetHousehold(ctx context.Context) (*Household, error)
+	CreateInvitation(ctx context.Context, role Role) (*HouseholdInvitation, error)
+	JoinHousehold(ctx context.Context, code string) (*Household, error)
+	UpdateMember(ctx context.Context, m Member) (*Member, error)
+
+	// Recurring Transaction Operations
+	CreateRecurringTransaction(ctx context.Context, rt RecurringTransaction) (*RecurringTransaction, error)
+	GetRecurringTransaction(ctx context.Context, id uuid.UUID) (*RecurringTransaction, error)
+	ListRecurringTransactions(ctx context.Context) ([]RecurringTransaction, error)
+	UpdateRecurringTransaction(ctx context.Context, rt RecurringTransaction) (*RecurringTransaction, error)
+	DeleteRecurringTransaction(ctx context.Context, id uuid.UUID, version int64) error
+
+	// Rule Operations
+	CreateRule(ctx context.Context, r Rule) (*Rule, error)
+	GetRule(ctx context.Context, id uuid.UUID) (*Rule, error)
+	ListRules(ctx context.Context) ([]Rule, error)
+	UpdateRule(ctx context.Context, r Rule) (*Rule, error)
+	DeleteRule(ctx context.Context, id uuid.UUID, version int64) error
+	ApplyRules(ctx context.Context, periodID uuid.UUID, commit bool) (*RuleApplication, error)
 }
 
 type TransactionFilter struct {
-	PeriodID   *uuid.UUID
-	EnvelopeID *uuid.UUID
+	PeriodID    *uuid.UUID
+	EnvelopeID  *uuid.UUID // Matches split lines as well
+	TransferID  *uuid.UUID
+	ExternalRef *string
+	DateFrom    *time.Time // Inclusive
+	DateTo      *time.Time // Inclusive
+	MinAmount   *int64     // Inclusive, in cents
+	MaxAmount   *int64     // Inclusive, in cents
+	Category    *string    // Matches split lines as well
+	Description *string    // Case-insensitive substring
+	Sign        *TransactionSign
+	CreatedBy   *uuid.UUID
+
+	IncludeDeleted bool // Matches transactions in the trash as well
+
+	Sort  TransactionSort    // Defaults to SortDateDesc
+	Limit int                // Zero means no limit
+	After *TransactionCursor // Keyset position to continue after
 }
 
+// TransactionManager runs fn in a transaction, rolled back when fn fails. WithTx may be nested,
+// the inner work is then committed with the outer transaction or rolled back on its own.
 type TransactionManager interface {
 	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
+// Repository persists the domain. Except for users, households, invitations, access tokens, SaveMember
+// and PurgeDeleted, every method is scoped to the household carried by the context (see WithHouseholdID).
+// Deleting transactions and envelopes moves them to the trash, which reads leave out unless stated otherwise.
+//
+// Periods, the period schedule, envelopes, transactions, recurring transactions and rules are versioned. Saving and deleting them
+// take the version the change is based on, zero when saving a new entity, and fail with ErrConflict when the
+// stored version differs. Saving updates the Version of the entity, as does moving it to and from the trash.
 type Repository interface {
 	// Domain methods
 	SaveUser(ctx context.Context, u *User) error
 	GetUser(ctx context.Context, id uuid.UUID) (*User, error)
+	GetUserBySubject(ctx context.Context, subject string) (*User, error)
+	ListUsers(ctx context.Context) ([]User, error)
+
+	SaveHousehold(ctx context.Context, h *Household) error
+	GetHousehold(ctx context.Context, id uuid.UUID) (*Household, error)
+	// ClaimHousehold hands out the household holding data from before tenancy, once.
+	ClaimHousehold(ctx context.Context) (uuid.UUID, error)
+	SaveMember(ctx context.Context, householdID uuid.UUID, m *Member) error
+	GetMember(ctx context.Context, userID uuid.UUID) (*Member, error)
+	ListMembers(ctx context.Context) ([]Member, error)
+	GetUserHouseholdID(ctx context.Context, userID uuid.UUID) (uuid.UUID, error)
+	SaveInvitation(ctx context.Context, inv *HouseholdInvitation) error
+	GetInvitationByCodeHash(ctx context.Context, codeHash string) (*HouseholdInvitation, error)
+
+	SaveAccessToken(ctx context.Context, t *AccessToken) error
+	GetAccessToken(ctx context.Context, id uuid.UUID) (*AccessToken, error)
+	GetAccessTokenByHash(ctx context.Context, tokenHash string) (*AccessToken, error)
+	ListAccessTokens(ctx context.Context, userID uuid.UUID) ([]AccessToken, error)
 
 	SavePeriod(ctx context.Context, p *Period) error
 	GetPeriod(ctx context.Context, id uuid.UUID) (*Period, error)
 	GetCurrentPeriod(ctx context.Context) (*Period, error)
 	ListPeriods(ctx context.Context) ([]Period, error)
+	DeletePeriod(ctx context.Context, id uuid.UUID, version int64) error
+	SavePeriodSchedule(ctx context.Context, sch *PeriodSchedule) error
+	GetPeriodSchedule(ctx context.Context) (*PeriodSchedule, error)
+	// ReplaceHolidays replaces the holidays imported into the household.
+	ReplaceHolidays(ctx context.Context, holidays []Holiday) error
+	ListHolidays(ctx context.Context) ([]Holiday, error)
 
 	SaveEnvelope(ctx context.Context, e *Envelope) error
+	GetEnvelope(ctx context.Context, id uuid.UUID) (*Envelope, error)
 	ListEnvelopes(ctx context.Context) ([]Envelope, error)
-	DeleteEnvelope(ctx context.Context, id uuid.UUID) error
+	DeleteEnvelope(ctx context.Context, id uuid.UUID, version int64) error
+	ListDeletedEnvelopes(ctx context.Context) ([]Envelope, error)
+	RestoreEnvelope(ctx context.Context, id uuid.UUID) error
 
 	SaveTransaction(ctx context.Context, t *Transaction) error
 	ListTransactions(ctx context.Context, filter TransactionFilter) ([]Transaction, error)
+	SearchTransactions(ctx context.Context, query string, limit int) ([]TransactionMatch, error)
 	GetTransaction(ctx context.Context, id uuid.UUID) (*Transaction, error)
-	DeleteTransaction(ctx context.Context, id uuid.UUID) error
+	// TransactionExists reports whether the transaction exists, in the trash or not.
+	TransactionExists(ctx context.Context, id uuid.UUID) (bool, error)
+	DeleteTransaction(ctx context.Context, id uuid.UUID, version int64) error
+	ListDeletedTransactions(ctx context.Context) ([]Transaction, error)
+	RestoreTransaction(ctx context.Context, id uuid.UUID) error
+	PurgeDeleted(ctx context.Context, before time.Time) ([]PurgedItem, error)
 
 	GetPeriodStats(ctx context.Context, periodID uuid.UUID) ([]EnvelopeStat, error)
+	// ListEnvelopeFlows lists the flows of the periods starting before the given time, earliest period first.
+	// Envelopes without transactions in a period have no flow for it.
+	ListEnvelopeFlows(ctx context.Context, before time.Time) ([]EnvelopeFlow, error)
+	GetMemberStats(ctx context.Context, periodID uuid.UUID) ([]MemberSpending, error)
+
+	SaveAuditEntry(ctx context.Context, e *AuditEntry) error
+	ListAuditEntries(ctx context.Context, filter AuditFilter) ([]AuditEntry, error)
+
+	// ReserveIdempotencyKey records the request unless the user made one with its key after since,
+	// reporting whether it did. Requests of the user made before since are forgotten.
+	ReserveIdempotencyKey(ctx context.Context, userID uuid.UUID, req *IdempotentRequest, since time.Time) (bool, error)
+	GetIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (*IdempotentRequest, error)
+	CompleteIdempotencyKey(ctx context.Context, userID uuid.UUID, key string, response []byte) error
+
+	SaveRecurringTransaction(ctx context.Context, rt *RecurringTransaction) error
+	GetRecurringTransaction(ctx context.Context, id uuid.UUID) (*RecurringTransaction, error)
+	ListRecurringTransactions(ctx context.Context) ([]RecurringTransaction, error)
+	DeleteRecurringTransaction(ctx context.Context, id uuid.UUID, version int64) error
+
+	SaveRule(ctx context.Context, r *Rule) error
+	GetRule(ctx context.Context, id uuid.UUID) (*Rule, error)
+	ListRules(ctx context.Context) ([]Rule, error)
+	DeleteRule(ctx context.Context, id uuid.UUID, version int64) error
 }
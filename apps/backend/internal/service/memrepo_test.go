@@ -0,0 +1,297 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// memRepo keeps the data of a single household in memory, with its user as the owner.
+// It covers what the service tests exercise; other Repository methods are not used.
+type memRepo struct {
+	Repository
+	periods      map[uuid.UUID]Period
+	envelopes    map[uuid.UUID]Envelope
+	transactions map[uuid.UUID]Transaction
+	rules        []Rule
+	recurring    []RecurringTransaction
+	schedule     *PeriodSchedule
+	audit        []AuditEntry
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{
+		periods:      map[uuid.UUID]Period{},
+		envelopes:    map[uuid.UUID]Envelope{},
+		transactions: map[uuid.UUID]Transaction{},
+	}
+}
+
+// newMemService returns a service on top of repo and a context authenticated as the household owner.
+func newMemService(repo *memRepo) (*dobbyFinancier, context.Context) {
+	s := &dobbyFinancier{repo: repo, txManager: inlineTx{}, authz: &authorizer{repo: repo}, periodGaps: PeriodGapsAllow}
+	ctx := WithHouseholdID(WithUserID(context.Background(), uuid.New()), uuid.New())
+	return s, ctx
+}
+
+// inlineTx runs transactional work directly, for tests whose fake repositories need no rollback.
+type inlineTx struct{}
+
+func (inlineTx) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (r *memRepo) GetMember(ctx context.Context, userID uuid.UUID) (*Member, error) {
+	return &Member{User: User{ID: userID}, Role: RoleOwner}, nil
+}
+
+func (r *memRepo) SaveAuditEntry(ctx context.Context, e *AuditEntry) error {
+	r.audit = append(r.audit, *e)
+	return nil
+}
+
+func (r *memRepo) GetPeriodSchedule(ctx context.Context) (*PeriodSchedule, error) {
+	if r.schedule == nil {
+		return nil, ErrNotFound
+	}
+	sch := *r.schedule
+	return &sch, nil
+}
+
+func (r *memRepo) ListHolidays(ctx context.Context) ([]Holiday, error) {
+	return nil, nil
+}
+
+func (r *memRepo) ListRules(ctx context.Context) ([]Rule, error) {
+	return r.rules, nil
+}
+
+func (r *memRepo) ListRecurringTransactions(ctx context.Context) ([]RecurringTransaction, error) {
+	return r.recurring, nil
+}
+
+func (r *memRepo) SavePeriod(ctx context.Context, p *Period) error {
+	if r.periods[p.ID].Version != p.Version {
+		return ErrConflict
+	}
+	p.Version++
+	r.periods[p.ID] = *p
+	return nil
+}
+
+func (r *memRepo) GetPeriod(ctx context.Context, id uuid.UUID) (*Period, error) {
+	p, ok := r.periods[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &p, nil
+}
+
+func (r *memRepo) ListPeriods(ctx context.Context) ([]Period, error) {
+	res := make([]Period, 0, len(r.periods))
+	for _, p := range r.periods {
+		res = append(res, p)
+	}
+	slices.SortFunc(res, func(a, b Period) int { return b.StartDate.Compare(a.StartDate) })
+	return res, nil
+}
+
+// DeletePeriod fails like the foreign key of transactions does while any are in the period.
+// Transactions in the trash go with the period.
+func (r *memRepo) DeletePeriod(ctx context.Context, id uuid.UUID, version int64) error {
+	p, ok := r.periods[id]
+	if !ok {
+		return ErrNotFound
+	}
+	if p.Version != version {
+		return ErrConflict
+	}
+	for _, t := range r.transactions {
+		if t.PeriodID == id && t.DeletedAt == nil {
+			return ErrConflict
+		}
+	}
+	for _, t := range r.transactions {
+		if t.PeriodID == id {
+			delete(r.transactions, t.ID)
+		}
+	}
+	delete(r.periods, id)
+	return nil
+}
+
+func (r *memRepo) SaveEnvelope(ctx context.Context, e *Envelope) error {
+	if r.envelopes[e.ID].Version != e.Version {
+		return ErrConflict
+	}
+	e.Version++
+	r.envelopes[e.ID] = *e
+	return nil
+}
+
+func (r *memRepo) GetEnvelope(ctx context.Context, id uuid.UUID) (*Envelope, error) {
+	e, ok := r.envelopes[id]
+	if !ok || e.DeletedAt != nil {
+		return nil, ErrNotFound
+	}
+	return &e, nil
+}
+
+func (r *memRepo) ListEnvelopes(ctx context.Context) ([]Envelope, error) {
+	var res []Envelope
+	for _, e := range r.envelopes {
+		if e.DeletedAt == nil {
+			res = append(res, e)
+		}
+	}
+	slices.SortFunc(res, func(a, b Envelope) int { return strings.Compare(a.Name, b.Name) })
+	return res, nil
+}
+
+func (r *memRepo) SaveTransaction(ctx context.Context, t *Transaction) error {
+	existing, ok := r.transactions[t.ID]
+	if existing.Version != t.Version || ok && existing.DeletedAt != nil {
+		return ErrConflict
+	}
+	t.Version++
+	t.Splits = slices.Clone(t.Splits)
+	r.transactions[t.ID] = *t
+	return nil
+}
+
+func (r *memRepo) GetTransaction(ctx context.Context, id uuid.UUID) (*Transaction, error) {
+	t, ok := r.transactions[id]
+	if !ok || t.DeletedAt != nil {
+		return nil, ErrNotFound
+	}
+	return &t, nil
+}
+
+func (r *memRepo) TransactionExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	_, ok := r.transactions[id]
+	return ok, nil
+}
+
+// ListTransactions sorts and pages like the keyset query does, with the ID breaking ties.
+func (r *memRepo) ListTransactions(ctx context.Context, filter TransactionFilter) ([]Transaction, error) {
+	compare := func(a, b Transaction) int {
+		var c int
+		switch filter.Sort {
+		case SortDateAsc:
+			c = a.Date.Compare(b.Date)
+		case SortAmountDesc:
+			c = cmp.Compare(b.Amount, a.Amount)
+		case SortAmountAsc:
+			c = cmp.Compare(a.Amount, b.Amount)
+		default:
+			c = b.Date.Compare(a.Date)
+		}
+		if c != 0 {
+			return c
+		}
+		if filter.Sort == SortDateAsc || filter.Sort == SortAmountAsc {
+			return strings.Compare(a.ID.String(), b.ID.String())
+		}
+		return strings.Compare(b.ID.String(), a.ID.String())
+	}
+
+	var res []Transaction
+	for _, t := range r.transactions {
+		switch {
+		case t.DeletedAt != nil && !filter.IncludeDeleted:
+		case filter.PeriodID != nil && t.PeriodID != *filter.PeriodID:
+		case filter.TransferID != nil && (t.TransferID == nil || *t.TransferID != *filter.TransferID):
+		case filter.ExternalRef != nil && (t.ExternalRef == nil || *t.ExternalRef != *filter.ExternalRef):
+		case filter.After != nil && compare(t, Transaction{ID: filter.After.ID, Date: filter.After.Date, Amount: filter.After.Amount}) <= 0:
+		default:
+			res = append(res, t)
+		}
+	}
+	slices.SortFunc(res, compare)
+	if filter.Limit > 0 && len(res) > filter.Limit {
+		res = res[:filter.Limit]
+	}
+	return res, nil
+}
+
+func (r *memRepo) DeleteTransaction(ctx context.Context, id uuid.UUID, version int64) error {
+	t, ok := r.transactions[id]
+	if !ok || t.DeletedAt != nil {
+		return ErrNotFound
+	}
+	if t.Version != version {
+		return ErrConflict
+	}
+	now := time.Now()
+	t.DeletedAt = &now
+	t.Version++
+	r.transactions[id] = t
+	return nil
+}
+
+func (r *memRepo) ListDeletedTransactions(ctx context.Context) ([]Transaction, error) {
+	var res []Transaction
+	for _, t := range r.transactions {
+		if t.DeletedAt != nil {
+			res = append(res, t)
+		}
+	}
+	return res, nil
+}
+
+func (r *memRepo) RestoreTransaction(ctx context.Context, id uuid.UUID) error {
+	t, ok := r.transactions[id]
+	if !ok || t.DeletedAt == nil {
+		return ErrNotFound
+	}
+	t.DeletedAt = nil
+	t.Version++
+	r.transactions[id] = t
+	return nil
+}
+
+func (r *memRepo) GetPeriodStats(ctx context.Context, periodID uuid.UUID) ([]EnvelopeStat, error) {
+	envelopes, _ := r.ListEnvelopes(ctx)
+	stats := make([]EnvelopeStat, len(envelopes))
+	for i, e := range envelopes {
+		stats[i].Envelope = e
+		for _, t := range r.transactions {
+			if t.PeriodID != periodID || t.DeletedAt != nil {
+				continue
+			}
+			for _, line := range t.Lines() {
+				switch {
+				case line.EnvelopeID != e.ID:
+				case line.Amount > 0 || t.TransferID != nil:
+					stats[i].Allocated += line.Amount
+				default:
+					stats[i].Spent -= line.Amount
+				}
+			}
+		}
+		stats[i].Remaining = stats[i].Allocated - stats[i].Spent
+	}
+	return stats, nil
+}
+
+func (r *memRepo) ListEnvelopeFlows(ctx context.Context, before time.Time) ([]EnvelopeFlow, error) {
+	periods, _ := r.ListPeriods(ctx)
+	slices.Reverse(periods)
+	var flows []EnvelopeFlow
+	for _, p := range periods {
+		if !p.StartDate.Before(before) {
+			continue
+		}
+		stats, _ := r.GetPeriodStats(ctx, p.ID)
+		for _, stat := range stats {
+			if stat.Allocated != 0 || stat.Spent != 0 {
+				flows = append(flows, EnvelopeFlow{PeriodID: p.ID, EnvelopeID: stat.Envelope.ID, Net: stat.Remaining})
+			}
+		}
+	}
+	return flows, nil
+}
@@ -8,22 +8,132 @@ import (
 
 // User represents a household member.
 type User struct {
-	ID   uuid.UUID
-	Name string
+	ID      uuid.UUID
+	Subject string // Identity provider subject the user signs in with, empty for users created before sign-in
+	Name    string
+	Email   string
 }
 
+// Household is a group of users sharing envelopes, periods and transactions.
+type Household struct {
+	ID      uuid.UUID
+	Name    string
+	Members []Member
+}
+
+// Role is the access level of a household member.
+type Role string
+
+const (
+	RoleOwner       Role = "owner"       // Everything, including managing members
+	RoleEditor      Role = "editor"      // Everything except managing members
+	RoleContributor Role = "contributor" // Reads everything, records transactions in granted envelopes only
+	RoleViewer      Role = "viewer"      // Reads everything
+)
+
+// Valid reports whether r is a known role.
+func (r Role) Valid() bool {
+	switch r {
+	case RoleOwner, RoleEditor, RoleContributor, RoleViewer:
+		return true
+	}
+	return false
+}
+
+// Member is a user together with their access to the household.
+type Member struct {
+	User
+	Role        Role
+	EnvelopeIDs []uuid.UUID // Envelopes granted to a contributor
+}
+
+// HouseholdInvitation lets another user join a household with a one-time code.
+type HouseholdInvitation struct {
+	ID          uuid.UUID
+	HouseholdID uuid.UUID
+	Code        string `json:"-"` // Only known right after creation, just the hash is stored
+	CodeHash    string `json:"-"`
+	Role        Role   // Role the joining user gets
+	CreatedBy   uuid.UUID
+	ExpiresAt   time.Time
+	AcceptedBy  *uuid.UUID
+	AcceptedAt  *time.Time
+}
+
+// AccessToken is a long-lived credential a user creates for scripts and automations.
+type AccessToken struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Token      string `json:"-"` // Only known right after creation, just the hash is stored
+	TokenHash  string `json:"-"`
+	Scope      TokenScope
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// TokenScope limits what requests authenticated with an access token may do.
+type TokenScope string
+
+const (
+	ScopeRead  TokenScope = "read"  // Only reads household data
+	ScopeWrite TokenScope = "write" // Anything the role of the user allows, except managing access tokens
+)
+
 // Period represents a defined financial timeframe.
 type Period struct {
 	ID                uuid.UUID
 	StartDate         time.Time
 	EndDate           time.Time
 	DefaultEnvelopeID *uuid.UUID
+	Version           int64 // Incremented on every change
+}
+
+// Contains reports whether t falls within the period. A period ends where the next one starts,
+// so the start is inclusive and the end is not.
+func (p Period) Contains(t time.Time) bool {
+	return !t.Before(p.StartDate) && t.Before(p.EndDate)
 }
 
 // Envelope represents a budget category/bucket (e.g., "Groceries").
 type Envelope struct {
-	ID   uuid.UUID
-	Name string
+	ID             uuid.UUID
+	Name           string
+	RolloverPolicy RolloverPolicy
+	DeletedAt      *time.Time // Set while the envelope is in the trash
+	Version        int64      // Incremented on every change
+}
+
+// RolloverPolicy defines what happens to an envelope balance when a period ends.
+type RolloverPolicy string
+
+const (
+	RolloverReset         RolloverPolicy = "reset"          // Balance is discarded
+	RolloverCarryPositive RolloverPolicy = "carry_positive" // Only a surplus is carried over
+	RolloverCarryAll      RolloverPolicy = "carry_all"      // Both surplus and deficit are carried over
+)
+
+// Valid reports whether p is one of the known rollover policies.
+func (p RolloverPolicy) Valid() bool {
+	switch p {
+	case RolloverReset, RolloverCarryPositive, RolloverCarryAll:
+		return true
+	}
+	return false
+}
+
+// Apply returns the part of a closing balance that is carried over to the next period.
+func (p RolloverPolicy) Apply(balance int64) int64 {
+	switch p {
+	case RolloverCarryAll:
+		return balance
+	case RolloverCarryPositive:
+		return max(balance, 0)
+	default:
+		return 0
+	}
 }
 
 // Transaction represents a financial movement.
@@ -34,7 +144,117 @@ type Transaction struct {
 	Amount      int64 // Stored in cents. Positive = Income (Budget), Negative = Expense.
 	Description string
 	Date        time.Time
+	Category    string     // Analytics tag
+	TransferID  *uuid.UUID // Set when the transaction is one leg of a Transfer
+	ExternalRef *string    // Bank-assigned unique reference of imported transactions
+
+	CreatedBy *uuid.UUID // User who recorded the transaction, nil if unknown
+	UpdatedBy *uuid.UUID // User who last changed the transaction, nil if unknown
+	DeletedAt *time.Time // Set while the transaction is in the trash
+	Version   int64      // Incremented on every change
+
+	// Splits spread the transaction across several envelopes. When present, the lines sum up to
+	// Amount, EnvelopeID mirrors the first line and envelope statistics are computed from the lines.
+	Splits []TransactionSplit
+}
+
+// TransactionSplit is one line of a split Transaction.
+type TransactionSplit struct {
+	EnvelopeID uuid.UUID
+	Amount     int64  // Stored in cents, same sign convention as Transaction.Amount
+	Category   string // Analytics tag
+}
+
+// Lines returns the split lines of the transaction, or the transaction itself as a single line if it is not split.
+func (t Transaction) Lines() []TransactionSplit {
+	if len(t.Splits) > 0 {
+		return t.Splits
+	}
+	return []TransactionSplit{{EnvelopeID: t.EnvelopeID, Amount: t.Amount, Category: t.Category}}
+}
+
+// MemberSpending summarises the transactions a household member recorded in a period. Transfers are not counted.
+// Transactions recorded by users no longer in the household are summed up in one entry for former members.
+type MemberSpending struct {
+	UserID           *uuid.UUID // Nil for former members and transactions recorded before attribution existed
+	UserName         string
+	FormerMember     bool
+	Spent            int64
+	Income           int64
+	TransactionCount int
+}
+
+// Transfer moves funds from one envelope to another within a period.
+// It is persisted as two linked Transactions (legs) sharing the same TransferID.
+type Transfer struct {
+	ID             uuid.UUID
+	PeriodID       uuid.UUID
+	FromEnvelopeID uuid.UUID
+	ToEnvelopeID   uuid.UUID
+	Amount         int64 // Stored in cents. Always positive.
+	Date           time.Time
+}
+
+// RecurringTransaction is a template for transactions that repeat on a schedule (rent, salary, subscriptions).
+// Concrete Transactions are materialised from it whenever a new Period is created.
+type RecurringTransaction struct {
+	ID          uuid.UUID
+	EnvelopeID  uuid.UUID
+	Amount      int64 // Stored in cents. Positive = Income (Budget), Negative = Expense.
+	Description string
 	Category    string // Analytics tag
+	Schedule    RecurrenceSchedule
+	Version     int64 // Incremented on every change
+}
+
+// RecurrenceKind identifies how a RecurrenceSchedule repeats.
+type RecurrenceKind string
+
+const (
+	RecurrenceMonthly RecurrenceKind = "monthly" // Every month on DayOfMonth
+	RecurrenceWeekly  RecurrenceKind = "weekly"  // Every IntervalWeeks weeks, starting from AnchorDate
+)
+
+// RecurrenceSchedule defines when a RecurringTransaction occurs.
+type RecurrenceSchedule struct {
+	Kind          RecurrenceKind
+	DayOfMonth    int       // Monthly only. Clamped to the last day of shorter months.
+	IntervalWeeks int       // Weekly only.
+	AnchorDate    time.Time // Weekly only. Date of the first occurrence.
+}
+
+// PeriodScheduleKind identifies on which days the periods of a PeriodSchedule start.
+type PeriodScheduleKind string
+
+const (
+	PeriodMonthly         PeriodScheduleKind = "monthly"           // Every month on DayOfMonth
+	PeriodLastBusinessDay PeriodScheduleKind = "last_business_day" // Every month on its last business day
+	PeriodWeekly          PeriodScheduleKind = "weekly"            // Every IntervalWeeks weeks, starting from AnchorDate
+	PeriodSemiMonthly     PeriodScheduleKind = "semi_monthly"      // Every month on DayOfMonth and SecondDayOfMonth
+)
+
+// BusinessDayAdjustment tells how a period start that is not a business day is moved.
+// Business days are weekdays that are not holidays.
+type BusinessDayAdjustment string
+
+const (
+	AdjustNone      BusinessDayAdjustment = "none"      // Not moved
+	AdjustPreceding BusinessDayAdjustment = "preceding" // Moved back to the business day before
+	AdjustFollowing BusinessDayAdjustment = "following" // Moved forward to the business day after
+)
+
+// PeriodSchedule defines the periods of a household whose dates are not given explicitly,
+// typically starting on payday.
+type PeriodSchedule struct {
+	Kind             PeriodScheduleKind
+	DayOfMonth       int       // Monthly and semi-monthly. Clamped to the last day of shorter months.
+	SecondDayOfMonth int       // Semi-monthly only. Later in the month than DayOfMonth.
+	IntervalWeeks    int       // Weekly only.
+	AnchorDate       time.Time // Weekly only. Date one of the periods starts on.
+	Adjustment       BusinessDayAdjustment
+	HolidayCountry   string // Country of the built-in holiday calendar starts are moved off, empty for none
+	TimeZone         string // IANA name of the zone periods start at midnight in
+	Version          int64
 }
 
 // PeriodSummary enriches the Period entity with calculated financial status.
@@ -42,15 +262,28 @@ type PeriodSummary struct {
 	Period                 Period
 	TotalBudget            int64 // Sum of all positive transactions (Income) across all envelopes
 	TotalSpent             int64 // Sum of all negative transactions (Expense) across all envelopes (Stored as positive)
-	TotalRemaining         int64 // TotalBudget - TotalSpent
-	ProjectedEndingBalance int64 // Forecast logic
+	TotalCarriedOver       int64 // Sum of balances carried over from previous periods across all envelopes
+	TotalRemaining         int64 // TotalCarriedOver + TotalBudget - TotalSpent
+	ProjectedEndingBalance int64 // Sum of per-envelope projections, see EnvelopeStat.ProjectedEndingBalance
 	EnvelopeStats          []EnvelopeStat
 }
 
+// EnvelopeFlow is the net amount an envelope gained (or lost, if negative) within one period.
+type EnvelopeFlow struct {
+	PeriodID   uuid.UUID
+	EnvelopeID uuid.UUID
+	Net        int64 // Allocated minus spent, in cents
+}
+
 // EnvelopeStat provides a snapshot of an envelope's performance within a specific period.
 type EnvelopeStat struct {
-	Envelope  Envelope
-	Allocated int64 // Sum of positive transactions (Income) for this envelope in this period
-	Spent     int64 // Sum of negative transactions (Expense) for this envelope in this period (Stored as positive)
-	Remaining int64 // Allocated - Spent
+	Envelope    Envelope
+	Allocated   int64 // Sum of positive transactions (Income) and net transfers for this envelope in this period
+	Spent       int64 // Sum of negative transactions (Expense), excluding transfers, for this envelope in this period (Stored as positive)
+	CarriedOver int64 // Balance carried over from the previous period according to the envelope's RolloverPolicy
+	Remaining   int64 // CarriedOver + Allocated - Spent
+
+	// ProjectedEndingBalance is the forecast Remaining at the end of the period,
+	// based on spend velocity so far, known recurring items and previous periods.
+	ProjectedEndingBalance int64
 }
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxPageSize caps the number of transactions returned in one page.
+const MaxPageSize = 500
+
+type TransactionSort string
+
+const (
+	SortDateDesc   TransactionSort = "date_desc"
+	SortDateAsc    TransactionSort = "date_asc"
+	SortAmountDesc TransactionSort = "amount_desc"
+	SortAmountAsc  TransactionSort = "amount_asc"
+)
+
+func (s TransactionSort) Valid() bool {
+	switch s {
+	case SortDateDesc, SortDateAsc, SortAmountDesc, SortAmountAsc:
+		return true
+	}
+	return false
+}
+
+type TransactionSign string
+
+const (
+	SignIncome  TransactionSign = "income"
+	SignExpense TransactionSign = "expense"
+)
+
+func (s TransactionSign) Valid() bool {
+	return s == SignIncome || s == SignExpense
+}
+
+// TransactionCursor is the keyset position of the last transaction of a page.
+// The transaction ID breaks ties between equal sort keys, so pages stay stable
+// when transactions are inserted between requests.
+type TransactionCursor struct {
+	Sort   TransactionSort `json:"s"`
+	Date   time.Time       `json:"d"`
+	Amount int64           `json:"a"`
+	ID     uuid.UUID       `json:"i"`
+}
+
+func cursorOf(t Transaction, sort TransactionSort) TransactionCursor {
+	return TransactionCursor{Sort: sort, Date: t.Date, Amount: t.Amount, ID: t.ID}
+}
+
+// Encode returns the opaque string representation of the cursor.
+func (c TransactionCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeTransactionCursor parses a cursor produced by Encode.
+func DecodeTransactionCursor(s string) (*TransactionCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrValidation)
+	}
+	var c TransactionCursor
+	if err := json.Unmarshal(data, &c); err != nil || !c.Sort.Valid() {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrValidation)
+	}
+	return &c, nil
+}
+
+// TransactionPage is one page of a transaction listing.
+type TransactionPage struct {
+	Transactions []Transaction
+	NextCursor   string // Empty on the last page
+}
+
+func (s *dobbyFinancier) ListTransactions(ctx context.Context, filter TransactionFilter, cursor string) (*TransactionPage, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	if filter.Sort == "" {
+		filter.Sort = SortDateDesc
+	}
+	if err := validateFilter(filter); err != nil {
+		return nil, err
+	}
+	if cursor != "" {
+		after, err := DecodeTransactionCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if after.Sort != filter.Sort {
+			return nil, fmt.Errorf("%w: cursor was issued for sort %q", ErrValidation, after.Sort)
+		}
+		filter.After = after
+	}
+
+	limit := filter.Limit
+	if limit > 0 {
+		// Fetch one extra row to find out whether another page follows.
+		filter.Limit++
+	}
+	txs, err := s.repo.ListTransactions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TransactionPage{Transactions: txs}
+	if limit > 0 && len(txs) > limit {
+		page.Transactions = txs[:limit]
+		page.NextCursor = cursorOf(txs[limit-1], filter.Sort).Encode()
+	}
+	return page, nil
+}
+
+func validateFilter(f TransactionFilter) error {
+	if !f.Sort.Valid() {
+		return fmt.Errorf("%w: unknown sort %q", ErrValidation, f.Sort)
+	}
+	if f.Sign != nil && !f.Sign.Valid() {
+		return fmt.Errorf("%w: unknown sign %q", ErrValidation, *f.Sign)
+	}
+	if f.Limit < 0 || f.Limit > MaxPageSize {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrValidation, MaxPageSize)
+	}
+	if f.DateFrom != nil && f.DateTo != nil && f.DateFrom.After(*f.DateTo) {
+		return fmt.Errorf("%w: dateFrom must not be after dateTo", ErrValidation)
+	}
+	if f.MinAmount != nil && f.MaxAmount != nil && *f.MinAmount > *f.MaxAmount {
+		return fmt.Errorf("%w: minAmount must not be greater than maxAmount", ErrValidation)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestTransactionCursorRoundTrip(t *testing.T) {
+	c := TransactionCursor{Sort: SortAmountAsc, Date: date(2026, 3, 14), Amount: -4200, ID: uuid.New()}
+
+	got, err := DecodeTransactionCursor(c.Encode())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Sort != c.Sort || !got.Date.Equal(c.Date) || got.Amount != c.Amount || got.ID != c.ID {
+		t.Errorf("got %+v, want %+v", got, c)
+	}
+
+	for _, s := range []string{"not base64!", "bm90IGpzb24", TransactionCursor{Sort: "name"}.Encode()} {
+		if _, err := DecodeTransactionCursor(s); !errors.Is(err, ErrValidation) {
+			t.Errorf("DecodeTransactionCursor(%q) = %v, want validation error", s, err)
+		}
+	}
+}
+
+func TestListTransactionsPages(t *testing.T) {
+	repo := newMemRepo()
+	s, ctx := newMemService(repo)
+	periodID := uuid.New()
+	// Two transactions share a date and two an amount, so only the ID orders them.
+	for i, d := range []int{1, 2, 2, 3, 4} {
+		tx := Transaction{ID: uuid.New(), PeriodID: periodID, Amount: -int64(100 * (i%3 + 1)), Date: date(2026, 3, d)}
+		repo.transactions[tx.ID] = tx
+	}
+
+	for _, sort := range []TransactionSort{SortDateDesc, SortDateAsc, SortAmountDesc, SortAmountAsc} {
+		t.Run(string(sort), func(t *testing.T) {
+			all, err := s.ListTransactions(ctx, TransactionFilter{Sort: sort}, "")
+			if err != nil || len(all.Transactions) != 5 || all.NextCursor != "" {
+				t.Fatalf("unpaged: expected all 5 transactions without a cursor, got %+v, %v", all, err)
+			}
+
+			var paged []Transaction
+			cursor := ""
+			for range 3 {
+				page, err := s.ListTransactions(ctx, TransactionFilter{Sort: sort, Limit: 2}, cursor)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				paged = append(paged, page.Transactions...)
+				cursor = page.NextCursor
+			}
+			if cursor != "" {
+				t.Errorf("expected no cursor after the last page, got %q", cursor)
+			}
+			if len(paged) != len(all.Transactions) {
+				t.Fatalf("expected %d transactions over all pages, got %d", len(all.Transactions), len(paged))
+			}
+			for i := range paged {
+				if paged[i].ID != all.Transactions[i].ID {
+					t.Errorf("position %d: expected %v, got %v", i, all.Transactions[i].ID, paged[i].ID)
+				}
+			}
+		})
+	}
+
+	// A page holding exactly the remaining transactions is the last one.
+	page, err := s.ListTransactions(ctx, TransactionFilter{Limit: 5}, "")
+	if err != nil || len(page.Transactions) != 5 || page.NextCursor != "" {
+		t.Errorf("full page: expected 5 transactions without a cursor, got %d, %q, %v", len(page.Transactions), page.NextCursor, err)
+	}
+}
+
+func TestListTransactionsRejectsCursorOfOtherSort(t *testing.T) {
+	s, ctx := newMemService(newMemRepo())
+	cursor := TransactionCursor{Sort: SortAmountAsc, ID: uuid.New()}.Encode()
+
+	if _, err := s.ListTransactions(ctx, TransactionFilter{Sort: SortDateDesc}, cursor); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation, got %v", err)
+	}
+	if _, err := s.ListTransactions(ctx, TransactionFilter{}, "garbage"); !errors.Is(err, ErrValidation) {
+		t.Errorf("malformed cursor: expected ErrValidation, got %v", err)
+	}
+}
+
+func TestValidateFilter(t *testing.T) {
+	from, to := date(2026, 3, 1), date(2026, 3, 31)
+	unknown := TransactionSign("refund")
+	income := SignIncome
+
+	tests := []struct {
+		name    string
+		filter  TransactionFilter
+		wantErr bool
+	}{
+		{name: "defaults", filter: TransactionFilter{Sort: SortDateDesc}},
+		{name: "all set", filter: TransactionFilter{Sort: SortAmountAsc, Sign: &income, Limit: MaxPageSize, DateFrom: &from, DateTo: &to, MinAmount: amount(-500), MaxAmount: amount(500)}},
+		{name: "single day", filter: TransactionFilter{Sort: SortDateDesc, DateFrom: &from, DateTo: &from}},
+		{name: "unknown sort", filter: TransactionFilter{Sort: "name"}, wantErr: true},
+		{name: "unknown sign", filter: TransactionFilter{Sort: SortDateDesc, Sign: &unknown}, wantErr: true},
+		{name: "negative limit", filter: TransactionFilter{Sort: SortDateDesc, Limit: -1}, wantErr: true},
+		{name: "limit too large", filter: TransactionFilter{Sort: SortDateDesc, Limit: MaxPageSize + 1}, wantErr: true},
+		{name: "dates reversed", filter: TransactionFilter{Sort: SortDateDesc, DateFrom: &to, DateTo: &from}, wantErr: true},
+		{name: "amounts reversed", filter: TransactionFilter{Sort: SortDateDesc, MinAmount: amount(500), MaxAmount: amount(-500)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFilter(tt.filter)
+			if tt.wantErr != errors.Is(err, ErrValidation) || !tt.wantErr && err != nil {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
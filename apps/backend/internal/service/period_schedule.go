@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// defaultPeriodSchedule is used by households that have not configured one:
+// periods start on the 5th, or the business day before it.
+var defaultPeriodSchedule = PeriodSchedule{
+	Kind:       PeriodMonthly,
+	DayOfMonth: 5,
+	Adjustment: AdjustPreceding,
+	TimeZone:   "UTC",
+}
+
+// GetPeriodSchedule returns the period schedule of the household, the default one if it has none.
+func (s *dobbyFinancier) GetPeriodSchedule(ctx context.Context) (*PeriodSchedule, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	return s.periodSchedule(ctx)
+}
+
+func (s *dobbyFinancier) periodSchedule(ctx context.Context) (*PeriodSchedule, error) {
+	sch, err := s.repo.GetPeriodSchedule(ctx)
+	if errors.Is(err, ErrNotFound) {
+		def := defaultPeriodSchedule
+		return &def, nil
+	}
+	return sch, err
+}
+
+// UpdatePeriodSchedule replaces the period schedule of the household.
+// Existing periods keep their dates, the schedule only applies to periods created afterwards.
+func (s *dobbyFinancier) UpdatePeriodSchedule(ctx context.Context, sch PeriodSchedule) (*PeriodSchedule, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	householdID, ok := HouseholdIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no household", ErrNotFound)
+	}
+	existing, err := s.repo.GetPeriodSchedule(ctx)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	var before any // Stays nil while the household uses the default schedule, recording a create
+	current := int64(0)
+	if existing != nil {
+		before, current = existing, existing.Version
+	}
+	if err := checkVersion(current, sch.Version); err != nil {
+		return nil, err
+	}
+	sch.Version = current
+	if sch.Adjustment == "" {
+		sch.Adjustment = AdjustNone
+	}
+	if err := sch.validate(); err != nil {
+		return nil, err
+	}
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SavePeriodSchedule(ctx, &sch); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditPeriodSchedule, householdID, before, sch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sch, nil
+}
+
+func (sch PeriodSchedule) validate() error {
+	switch sch.Kind {
+	case PeriodMonthly:
+		if sch.DayOfMonth < 1 || sch.DayOfMonth > 31 {
+			return fmt.Errorf("%w: monthly schedule requires a day of month between 1 and 31", ErrValidation)
+		}
+	case PeriodLastBusinessDay:
+	case PeriodWeekly:
+		if sch.IntervalWeeks < 1 {
+			return fmt.Errorf("%w: weekly schedule requires a positive week interval", ErrValidation)
+		}
+		if sch.AnchorDate.IsZero() {
+			return fmt.Errorf("%w: weekly schedule requires an anchor date", ErrValidation)
+		}
+	case PeriodSemiMonthly:
+		if sch.DayOfMonth < 1 || sch.SecondDayOfMonth <= sch.DayOfMonth || sch.SecondDayOfMonth > 31 {
+			return fmt.Errorf("%w: semi-monthly schedule requires two days of month between 1 and 31, in order", ErrValidation)
+		}
+	default:
+		return fmt.Errorf("%w: unknown schedule kind %q", ErrValidation, sch.Kind)
+	}
+	switch sch.Adjustment {
+	case AdjustNone, AdjustPreceding, AdjustFollowing:
+	default:
+		return fmt.Errorf("%w: unknown business day adjustment %q", ErrValidation, sch.Adjustment)
+	}
+	if _, err := time.LoadLocation(sch.TimeZone); err != nil || sch.TimeZone == "" {
+		return fmt.Errorf("%w: unknown time zone %q", ErrValidation, sch.TimeZone)
+	}
+	return validateHolidayCountry(sch.HolidayCountry)
+}
+
+// location is the time zone of the household calendar the schedule follows.
+func (sch PeriodSchedule) location() (*time.Location, error) {
+	loc, err := time.LoadLocation(sch.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown time zone %q", ErrValidation, sch.TimeZone)
+	}
+	return loc, nil
+}
+
+// periodAround returns the start and end of the scheduled period containing t.
+// Period starts are moved off weekends and the holidays of cal, which may be nil.
+func (sch PeriodSchedule) periodAround(t time.Time, cal HolidayCalendar) (start, end time.Time, err error) {
+	loc, err := sch.location()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	t = t.In(loc)
+	// Wide enough for scheduled starts on both sides of t, however far they were moved off weekends.
+	margin := 7*sch.IntervalWeeks + 62
+	y, m, d := t.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	for _, s := range sch.starts(day.AddDate(0, 0, -margin), day.AddDate(0, 0, margin), cal) {
+		if !s.After(t) && s.After(start) {
+			start = s
+		}
+		if s.After(t) && (end.IsZero() || s.Before(end)) {
+			end = s
+		}
+	}
+	if start.IsZero() || end.IsZero() {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: schedule has no period around %s", ErrValidation, t.Format(time.DateOnly))
+	}
+	return start, end, nil
+}
+
+// starts returns the days periods start on within [from, to], moved to business days as configured.
+func (sch PeriodSchedule) starts(from, to time.Time, cal HolidayCalendar) []time.Time {
+	var days []time.Time
+	switch sch.Kind {
+	case PeriodMonthly:
+		days = RecurrenceSchedule{Kind: RecurrenceMonthly, DayOfMonth: sch.DayOfMonth}.Occurrences(from, to)
+	case PeriodLastBusinessDay:
+		days = RecurrenceSchedule{Kind: RecurrenceMonthly, DayOfMonth: 31}.Occurrences(from, to)
+	case PeriodWeekly:
+		days = RecurrenceSchedule{Kind: RecurrenceWeekly, IntervalWeeks: sch.IntervalWeeks, AnchorDate: sch.AnchorDate}.Occurrences(from, to)
+	case PeriodSemiMonthly:
+		days = append(RecurrenceSchedule{Kind: RecurrenceMonthly, DayOfMonth: sch.DayOfMonth}.Occurrences(from, to),
+			RecurrenceSchedule{Kind: RecurrenceMonthly, DayOfMonth: sch.SecondDayOfMonth}.Occurrences(from, to)...)
+	}
+
+	adjustment := sch.Adjustment
+	if sch.Kind == PeriodLastBusinessDay {
+		adjustment = AdjustPreceding
+	}
+	for i, d := range days {
+		days[i] = adjust(d, adjustment, cal)
+	}
+	return days
+}
+
+// adjust moves d to a business day in the direction of the adjustment.
+func adjust(d time.Time, adjustment BusinessDayAdjustment, cal HolidayCalendar) time.Time {
+	step := 0
+	switch adjustment {
+	case AdjustPreceding:
+		step = -1
+	case AdjustFollowing:
+		step = 1
+	default:
+		return d
+	}
+	for !isBusinessDay(d, cal) {
+		d = d.AddDate(0, 0, step)
+	}
+	return d
+}
+
+func isBusinessDay(d time.Time, cal HolidayCalendar) bool {
+	return !isWeekend(d) && !isHoliday(cal, d)
+}
+
+// scheduledPeriod fills in the dates of a period left open, so that it is the scheduled period
+// starting or ending on the given date, or the one around now if neither is given.
+func (s *dobbyFinancier) scheduledPeriod(ctx context.Context, start, end *time.Time) (time.Time, time.Time, error) {
+	sch, err := s.periodSchedule(ctx)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	ref := time.Now()
+	switch {
+	case start != nil:
+		ref = *start
+	case end != nil:
+		ref = end.Add(-time.Nanosecond)
+	}
+	cal, err := s.holidayCalendar(ctx, sch.HolidayCountry)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	scheduledStart, scheduledEnd, err := sch.periodAround(ref, cal)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if start != nil {
+		scheduledStart = *start
+	}
+	if end != nil {
+		scheduledEnd = *end
+	}
+	return scheduledStart, scheduledEnd, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPeriodAround(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+	date := func(y int, m time.Month, d int, loc *time.Location) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	}
+
+	tests := []struct {
+		name      string
+		schedule  PeriodSchedule
+		at        time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "default, 5th on a Sunday moved back",
+			schedule:  defaultPeriodSchedule,
+			at:        date(2026, time.April, 10, time.UTC),
+			wantStart: date(2026, time.April, 3, time.UTC),
+			wantEnd:   date(2026, time.May, 5, time.UTC),
+		},
+		{
+			name:      "last business day",
+			schedule:  PeriodSchedule{Kind: PeriodLastBusinessDay, Adjustment: AdjustNone, TimeZone: "UTC"},
+			at:        date(2026, time.May, 30, time.UTC),
+			wantStart: date(2026, time.May, 29, time.UTC),
+			wantEnd:   date(2026, time.June, 30, time.UTC),
+		},
+		{
+			name:      "every other week",
+			schedule:  PeriodSchedule{Kind: PeriodWeekly, IntervalWeeks: 2, AnchorDate: date(2026, time.January, 2, time.UTC), Adjustment: AdjustNone, TimeZone: "UTC"},
+			at:        date(2026, time.January, 20, time.UTC),
+			wantStart: date(2026, time.January, 16, time.UTC),
+			wantEnd:   date(2026, time.January, 30, time.UTC),
+		},
+		{
+			name:      "semi-monthly, moved forward",
+			schedule:  PeriodSchedule{Kind: PeriodSemiMonthly, DayOfMonth: 1, SecondDayOfMonth: 15, Adjustment: AdjustFollowing, TimeZone: "Europe/Berlin"},
+			at:        date(2026, time.February, 10, berlin),
+			wantStart: date(2026, time.February, 2, berlin),
+			wantEnd:   date(2026, time.February, 16, berlin),
+		},
+		{
+			name:      "already the 5th in the time zone",
+			schedule:  PeriodSchedule{Kind: PeriodMonthly, DayOfMonth: 5, Adjustment: AdjustNone, TimeZone: "Europe/Berlin"},
+			at:        time.Date(2026, time.March, 4, 23, 30, 0, 0, time.UTC),
+			wantStart: date(2026, time.March, 5, berlin),
+			wantEnd:   date(2026, time.April, 5, berlin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := tt.schedule.periodAround(tt.at, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("expected %s to %s, got %s to %s", tt.wantStart, tt.wantEnd, start, end)
+			}
+		})
+	}
+}
+
+func TestPeriodScheduleValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule PeriodSchedule
+		wantErr  bool
+	}{
+		{"default", defaultPeriodSchedule, false},
+		{"semi-monthly out of order", PeriodSchedule{Kind: PeriodSemiMonthly, DayOfMonth: 15, SecondDayOfMonth: 1, Adjustment: AdjustNone, TimeZone: "UTC"}, true},
+		{"weekly without anchor", PeriodSchedule{Kind: PeriodWeekly, IntervalWeeks: 1, Adjustment: AdjustNone, TimeZone: "UTC"}, true},
+		{"unknown adjustment", PeriodSchedule{Kind: PeriodLastBusinessDay, Adjustment: "nearest", TimeZone: "UTC"}, true},
+		{"unknown time zone", PeriodSchedule{Kind: PeriodLastBusinessDay, Adjustment: AdjustNone, TimeZone: "Mars/Olympus"}, true},
+		{"no time zone", PeriodSchedule{Kind: PeriodLastBusinessDay, Adjustment: AdjustNone}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.validate()
+			if tt.wantErr && !errors.Is(err, ErrValidation) {
+				t.Errorf("expected ErrValidation, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
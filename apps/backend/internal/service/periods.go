@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// PeriodGapPolicy decides whether periods may leave time between them that no period covers.
+type PeriodGapPolicy string
+
+const (
+	PeriodGapsAllow  PeriodGapPolicy = "allow"
+	PeriodGapsReject PeriodGapPolicy = "reject"
+)
+
+// Valid reports whether the policy is one of the known ones.
+func (p PeriodGapPolicy) Valid() bool {
+	return p == PeriodGapsAllow || p == PeriodGapsReject
+}
+
+// PeriodGap is time between two consecutive periods that neither covers.
+type PeriodGap struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ListPeriodGaps lists the gaps between the periods of the household, earliest first.
+// Time before the first period and after the last one is not a gap.
+func (s *dobbyFinancier) ListPeriodGaps(ctx context.Context) ([]PeriodGap, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	periods, err := s.repo.ListPeriods(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return periodGaps(periods), nil
+}
+
+// periodGaps finds the gaps between periods that do not overlap.
+func periodGaps(periods []Period) []PeriodGap {
+	sorted := slices.Clone(periods)
+	slices.SortFunc(sorted, func(a, b Period) int { return a.StartDate.Compare(b.StartDate) })
+	gaps := []PeriodGap{}
+	for i := 1; i < len(sorted); i++ {
+		prev, next := sorted[i-1], sorted[i]
+		if prev.EndDate.Before(next.StartDate) {
+			gaps = append(gaps, PeriodGap{Start: prev.EndDate, End: next.StartDate})
+		}
+	}
+	return gaps
+}
+
+// fitBetweenPeriods shortens a period so that it does not overlap the periods before and after it.
+// Only periods that end before p ends and start after p starts are considered neighbours.
+func fitBetweenPeriods(p *Period, periods []Period) {
+	for _, o := range periods {
+		if o.EndDate.After(p.StartDate) && o.EndDate.Before(p.EndDate) && !o.StartDate.After(p.StartDate) {
+			p.StartDate = o.EndDate
+		}
+		if o.StartDate.Before(p.EndDate) && o.StartDate.After(p.StartDate) && !o.EndDate.Before(p.EndDate) {
+			p.EndDate = o.StartDate
+		}
+	}
+}
+
+// validatePeriod checks a new or changed period against the other periods of the household.
+// Periods are half-open, so one may start exactly where another ends. With rejectGaps,
+// the period must also leave no gap to the periods before and after it.
+func validatePeriod(p Period, periods []Period, rejectGaps bool) error {
+	if !p.StartDate.Before(p.EndDate) {
+		return fmt.Errorf("%w: period must start before it ends", ErrValidation)
+	}
+	others := make([]Period, 0, len(periods)+1)
+	for _, o := range periods {
+		if o.ID == p.ID {
+			continue
+		}
+		if p.StartDate.Before(o.EndDate) && o.StartDate.Before(p.EndDate) {
+			return fmt.Errorf("%w: period %s already covers part of %s to %s", ErrPeriodOverlap,
+				o.ID, p.StartDate.Format(time.DateOnly), p.EndDate.Format(time.DateOnly))
+		}
+		others = append(others, o)
+	}
+	if !rejectGaps {
+		return nil
+	}
+	for _, gap := range periodGaps(append(others, p)) {
+		if gap.Start.Equal(p.EndDate) || gap.End.Equal(p.StartDate) {
+			return fmt.Errorf("%w: period would leave %s to %s uncovered", ErrValidation,
+				gap.Start.Format(time.DateOnly), gap.End.Format(time.DateOnly))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestValidatePeriod(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, time.March, d, 0, 0, 0, 0, time.UTC) }
+	march := Period{ID: uuid.New(), StartDate: day(1), EndDate: day(11)}
+	late := Period{ID: uuid.New(), StartDate: day(21), EndDate: day(31)}
+	periods := []Period{march, late}
+
+	tests := []struct {
+		name       string
+		period     Period
+		rejectGaps bool
+		want       error
+	}{
+		{"adjacent", Period{StartDate: day(11), EndDate: day(21)}, true, nil},
+		{"ends before it starts", Period{StartDate: day(15), EndDate: day(15)}, false, ErrValidation},
+		{"overlaps", Period{StartDate: day(10), EndDate: day(21)}, false, ErrPeriodOverlap},
+		{"covers another", Period{StartDate: day(20), EndDate: day(31)}, false, ErrPeriodOverlap},
+		{"leaves gaps", Period{StartDate: day(12), EndDate: day(20)}, false, nil},
+		{"leaves gaps, rejected", Period{StartDate: day(12), EndDate: day(20)}, true, ErrValidation},
+		{"moves itself", Period{ID: march.ID, StartDate: day(2), EndDate: day(21)}, true, nil},
+		{"moves away from a neighbour, rejected", Period{ID: late.ID, StartDate: day(22), EndDate: day(31)}, true, ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePeriod(tt.period, periods, tt.rejectGaps)
+			if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestPeriodGaps(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, time.March, d, 0, 0, 0, 0, time.UTC) }
+	periods := []Period{
+		{StartDate: day(21), EndDate: day(31)},
+		{StartDate: day(1), EndDate: day(11)},
+		{StartDate: day(11), EndDate: day(15)},
+	}
+	gaps := periodGaps(periods)
+	if len(gaps) != 1 || !gaps[0].Start.Equal(day(15)) || !gaps[0].End.Equal(day(21)) {
+		t.Errorf("expected a single gap from the 15th to the 21st, got %v", gaps)
+	}
+}
+
+func TestFitBetweenPeriods(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, time.March, d, 0, 0, 0, 0, time.UTC) }
+	periods := []Period{
+		{StartDate: day(1), EndDate: day(8)},
+		{StartDate: day(25), EndDate: day(31)},
+	}
+	p := &Period{StartDate: day(5), EndDate: day(28)}
+	fitBetweenPeriods(p, periods)
+	if !p.StartDate.Equal(day(8)) || !p.EndDate.Equal(day(25)) {
+		t.Errorf("expected the period to shrink to the 8th to the 25th, got %s to %s", p.StartDate, p.EndDate)
+	}
+}
+
+func TestUpdatePeriodKeepsTransactions(t *testing.T) {
+	repo := newMemRepo()
+	s, ctx := newMemService(repo)
+	period := Period{ID: uuid.New(), StartDate: date(2026, 3, 5), EndDate: date(2026, 4, 5)}
+	if err := repo.SavePeriod(ctx, &period); err != nil {
+		t.Fatalf("fixture: %v", err)
+	}
+	tx := Transaction{ID: uuid.New(), PeriodID: period.ID, EnvelopeID: uuid.New(), Amount: -1000, Date: date(2026, 3, 20)}
+	if err := repo.SaveTransaction(ctx, &tx); err != nil {
+		t.Fatalf("fixture: %v", err)
+	}
+	if err := repo.DeleteTransaction(ctx, tx.ID, tx.Version); err != nil {
+		t.Fatalf("fixture: %v", err)
+	}
+
+	shrunk := period
+	shrunk.EndDate = date(2026, 3, 15)
+	if _, err := s.UpdatePeriod(ctx, shrunk); !errors.Is(err, ErrConflict) {
+		t.Errorf("leaving out a trashed transaction: expected ErrConflict, got %v", err)
+	}
+	if !repo.periods[period.ID].EndDate.Equal(period.EndDate) {
+		t.Errorf("expected the period left unchanged")
+	}
+
+	extended := period
+	extended.EndDate = date(2026, 4, 10)
+	if _, err := s.UpdatePeriod(ctx, extended); err != nil {
+		t.Errorf("extending the period: unexpected error %v", err)
+	}
+}
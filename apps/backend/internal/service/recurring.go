@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (s *dobbyFinancier) CreateRecurringTransaction(ctx context.Context, rt RecurringTransaction) (*RecurringTransaction, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	rt.ID = uuid.New()
+	if err := s.validateRecurringTransaction(ctx, rt); err != nil {
+		return nil, err
+	}
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveRecurringTransaction(ctx, &rt); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditRecurringTransaction, rt.ID, nil, rt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rt, nil
+}
+
+func (s *dobbyFinancier) GetRecurringTransaction(ctx context.Context, id uuid.UUID) (*RecurringTransaction, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRecurringTransaction(ctx, id)
+}
+
+func (s *dobbyFinancier) ListRecurringTransactions(ctx context.Context) ([]RecurringTransaction, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.ListRecurringTransactions(ctx)
+}
+
+func (s *dobbyFinancier) UpdateRecurringTransaction(ctx context.Context, rt RecurringTransaction) (*RecurringTransaction, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	existing, err := s.repo.GetRecurringTransaction(ctx, rt.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(existing.Version, rt.Version); err != nil {
+		return nil, err
+	}
+	rt.Version = existing.Version
+	if err := s.validateRecurringTransaction(ctx, rt); err != nil {
+		return nil, err
+	}
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveRecurringTransaction(ctx, &rt); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditRecurringTransaction, rt.ID, existing, rt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rt, nil
+}
+
+func (s *dobbyFinancier) DeleteRecurringTransaction(ctx context.Context, id uuid.UUID, version int64) error {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return err
+	}
+	rt, err := s.repo.GetRecurringTransaction(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(rt.Version, version); err != nil {
+		return err
+	}
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.DeleteRecurringTransaction(ctx, id, rt.Version); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditRecurringTransaction, id, rt, nil)
+	})
+}
+
+func (s *dobbyFinancier) validateRecurringTransaction(ctx context.Context, rt RecurringTransaction) error {
+	if rt.Amount == 0 {
+		return fmt.Errorf("%w: recurring transaction amount must not be zero", ErrValidation)
+	}
+	if err := rt.Schedule.validate(); err != nil {
+		return err
+	}
+	if _, err := s.repo.GetEnvelope(ctx, rt.EnvelopeID); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("%w: envelope %s does not exist", ErrValidation, rt.EnvelopeID)
+		}
+		return err
+	}
+	return nil
+}
+
+func (sch RecurrenceSchedule) validate() error {
+	switch sch.Kind {
+	case RecurrenceMonthly:
+		if sch.DayOfMonth < 1 || sch.DayOfMonth > 31 {
+			return fmt.Errorf("%w: monthly schedule requires a day of month between 1 and 31", ErrValidation)
+		}
+	case RecurrenceWeekly:
+		if sch.IntervalWeeks < 1 {
+			return fmt.Errorf("%w: weekly schedule requires a positive week interval", ErrValidation)
+		}
+		if sch.AnchorDate.IsZero() {
+			return fmt.Errorf("%w: weekly schedule requires an anchor date", ErrValidation)
+		}
+	default:
+		return fmt.Errorf("%w: unknown schedule kind %q", ErrValidation, sch.Kind)
+	}
+	return nil
+}
+
+// Occurrences returns the dates (at midnight, in the location of from) on which
+// the schedule fires within [from, to], in chronological order.
+func (sch RecurrenceSchedule) Occurrences(from, to time.Time) []time.Time {
+	loc := from.Location()
+	var res []time.Time
+
+	switch sch.Kind {
+	case RecurrenceMonthly:
+		y, m, _ := from.Date()
+		for month := time.Date(y, m, 1, 0, 0, 0, 0, loc); !month.After(to); month = month.AddDate(0, 1, 0) {
+			lastDay := month.AddDate(0, 1, -1).Day()
+			d := time.Date(month.Year(), month.Month(), min(sch.DayOfMonth, lastDay), 0, 0, 0, 0, loc)
+			if !d.Before(from) && !d.After(to) {
+				res = append(res, d)
+			}
+		}
+	case RecurrenceWeekly:
+		step := 7 * sch.IntervalWeeks
+		ay, am, ad := sch.AnchorDate.Date()
+		d := time.Date(ay, am, ad, 0, 0, 0, 0, loc)
+		if d.Before(from) {
+			// Jump straight to the last occurrence before from instead of iterating from the anchor.
+			skipped := int(from.Sub(d).Hours()/24) / step
+			d = d.AddDate(0, 0, skipped*step)
+		}
+		for ; !d.After(to); d = d.AddDate(0, 0, step) {
+			if !d.Before(from) {
+				res = append(res, d)
+			}
+		}
+	}
+	return res
+}
+
+// occurrenceID derives a stable transaction ID for an occurrence of a recurring transaction,
+// so materialising the same period repeatedly never creates duplicates.
+func occurrenceID(recurringID uuid.UUID, date time.Time) uuid.UUID {
+	return uuid.NewSHA1(recurringID, []byte(date.Format(time.DateOnly)))
+}
+
+// materializeRecurring generates concrete Transactions in period p for every recurring transaction
+// occurrence that falls within it. Occurrences that were already generated are skipped, even if the
+// resulting transaction has been edited or moved to the trash since.
+func (s *dobbyFinancier) materializeRecurring(ctx context.Context, p *Period) error {
+	templates, err := s.repo.ListRecurringTransactions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rt := range templates {
+		for _, date := range rt.Schedule.Occurrences(p.StartDate, p.EndDate) {
+			if !p.Contains(date) {
+				continue // The end date belongs to the next period.
+			}
+			id := occurrenceID(rt.ID, date)
+			exists, err := s.repo.TransactionExists(ctx, id)
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+
+			t := Transaction{
+				ID:          id,
+				PeriodID:    p.ID,
+				EnvelopeID:  rt.EnvelopeID,
+				Amount:      rt.Amount,
+				Description: rt.Description,
+				Date:        date,
+				Category:    rt.Category,
+			}
+			stampCreated(ctx, &t)
+			if err := s.repo.SaveTransaction(ctx, &t); err != nil {
+				return err
+			}
+			if err := s.audit(ctx, AuditTransaction, t.ID, nil, t); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
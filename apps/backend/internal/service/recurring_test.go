@@ -0,0 +1,115 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRecurrenceScheduleOccurrences(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule RecurrenceSchedule
+		from, to time.Time
+		want     []time.Time
+	}{
+		{
+			name:     "monthly within period spanning two months",
+			schedule: RecurrenceSchedule{Kind: RecurrenceMonthly, DayOfMonth: 1},
+			from:     date(2026, time.January, 5),
+			to:       date(2026, time.February, 5),
+			want:     []time.Time{date(2026, time.February, 1)},
+		},
+		{
+			name:     "monthly clamped to end of short month",
+			schedule: RecurrenceSchedule{Kind: RecurrenceMonthly, DayOfMonth: 31},
+			from:     date(2026, time.February, 1),
+			to:       date(2026, time.March, 1),
+			want:     []time.Time{date(2026, time.February, 28)},
+		},
+		{
+			name:     "weekly every two weeks from anchor before period",
+			schedule: RecurrenceSchedule{Kind: RecurrenceWeekly, IntervalWeeks: 2, AnchorDate: date(2026, time.January, 2)},
+			from:     date(2026, time.February, 5),
+			to:       date(2026, time.March, 5),
+			want:     []time.Time{date(2026, time.February, 13), date(2026, time.February, 27)},
+		},
+		{
+			name:     "weekly anchor after period",
+			schedule: RecurrenceSchedule{Kind: RecurrenceWeekly, IntervalWeeks: 1, AnchorDate: date(2026, time.April, 1)},
+			from:     date(2026, time.February, 5),
+			to:       date(2026, time.March, 5),
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.schedule.Occurrences(tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d: expected %v, got %v", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestOccurrenceIDIsStable(t *testing.T) {
+	id := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	a := occurrenceID(id, date(2026, time.March, 1))
+	b := occurrenceID(id, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC))
+	if a != b {
+		t.Errorf("expected identical IDs for the same occurrence, got %s and %s", a, b)
+	}
+	if c := occurrenceID(id, date(2026, time.April, 1)); c == a {
+		t.Errorf("expected different IDs for different occurrences, got %s for both", c)
+	}
+}
+
+func TestMaterializeRecurringIsIdempotent(t *testing.T) {
+	repo := newMemRepo()
+	s, ctx := newMemService(repo)
+	rt := RecurringTransaction{
+		ID: uuid.New(), EnvelopeID: uuid.New(), Amount: -1500, Description: "Gym", Category: "Sport",
+		Schedule: RecurrenceSchedule{Kind: RecurrenceWeekly, IntervalWeeks: 1, AnchorDate: date(2026, 3, 2)},
+	}
+	repo.recurring = []RecurringTransaction{rt}
+	p := &Period{ID: uuid.New(), StartDate: date(2026, 3, 2), EndDate: date(2026, 3, 16)}
+
+	if err := s.materializeRecurring(ctx, p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.transactions) != 2 {
+		t.Fatalf("expected 2 occurrences, the end date belonging to the next period, got %d", len(repo.transactions))
+	}
+
+	// One occurrence is edited, the other one moved to the trash; neither comes back.
+	edited := repo.transactions[occurrenceID(rt.ID, date(2026, 3, 2))]
+	edited.Amount = -2000
+	if err := repo.SaveTransaction(ctx, &edited); err != nil {
+		t.Fatalf("fixture: %v", err)
+	}
+	trashed := repo.transactions[occurrenceID(rt.ID, date(2026, 3, 9))]
+	if err := repo.DeleteTransaction(ctx, trashed.ID, trashed.Version); err != nil {
+		t.Fatalf("fixture: %v", err)
+	}
+
+	if err := s.materializeRecurring(ctx, p); err != nil {
+		t.Fatalf("again: unexpected error: %v", err)
+	}
+	if len(repo.transactions) != 2 || repo.transactions[edited.ID].Amount != -2000 || repo.transactions[trashed.ID].DeletedAt == nil {
+		t.Errorf("expected materialising again to leave existing occurrences alone, got %+v", repo.transactions)
+	}
+	if len(repo.audit) != 2 {
+		t.Errorf("expected only the first run audited, got %d entries", len(repo.audit))
+	}
+}
@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PatternType tells how Rule.DescriptionPattern is matched against transaction descriptions.
+type PatternType string
+
+const (
+	PatternSubstring PatternType = "substring" // Case-insensitive substring match
+	PatternRegex     PatternType = "regex"     // Case-insensitive regular expression match
+)
+
+// Rule automatically categorises transactions. All of its non-empty conditions must match
+// for the rule to apply; it then sets Category and/or EnvelopeID on the transaction.
+type Rule struct {
+	ID       uuid.UUID
+	Name     string
+	Priority int // Rules with lower priority are evaluated first
+
+	// Conditions
+	DescriptionPattern string // Empty matches any description
+	PatternType        PatternType
+	MinAmount          *int64         // Inclusive, compared to the signed amount in cents
+	MaxAmount          *int64         // Inclusive, compared to the signed amount in cents
+	Weekdays           []time.Weekday // Empty matches any day
+
+	// Actions
+	Category   string     // Empty leaves the category untouched
+	EnvelopeID *uuid.UUID // Nil leaves the envelope untouched
+
+	Version int64 // Incremented on every change
+}
+
+// RuleChange describes how re-applying rules changes (or would change) a transaction.
+type RuleChange struct {
+	Before Transaction
+	After  Transaction
+}
+
+// RuleApplication is the outcome of re-applying rules to a period, either previewed or committed.
+type RuleApplication struct {
+	Committed bool
+	Changes   []RuleChange
+}
+
+type compiledRule struct {
+	Rule
+	re *regexp.Regexp
+}
+
+// ruleSet is a list of rules ready for evaluation, ordered by priority.
+type ruleSet struct {
+	rules []compiledRule
+	loc   *time.Location // Weekdays are matched on the calendar of the household
+}
+
+func compileRules(rules []Rule, loc *time.Location) (ruleSet, error) {
+	rs := ruleSet{rules: make([]compiledRule, 0, len(rules)), loc: loc}
+	for _, r := range rules {
+		cr := compiledRule{Rule: r}
+		if r.PatternType == PatternRegex && r.DescriptionPattern != "" {
+			re, err := regexp.Compile("(?i)" + r.DescriptionPattern)
+			if err != nil {
+				return ruleSet{}, fmt.Errorf("%w: rule %q has an invalid pattern: %v", ErrValidation, r.Name, err)
+			}
+			cr.re = re
+		}
+		rs.rules = append(rs.rules, cr)
+	}
+	sort.SliceStable(rs.rules, func(i, j int) bool { return rs.rules[i].Priority < rs.rules[j].Priority })
+	return rs, nil
+}
+
+func (r compiledRule) matches(t *Transaction, loc *time.Location) bool {
+	if r.DescriptionPattern != "" {
+		if r.re != nil {
+			if !r.re.MatchString(t.Description) {
+				return false
+			}
+		} else if !strings.Contains(strings.ToLower(t.Description), strings.ToLower(r.DescriptionPattern)) {
+			return false
+		}
+	}
+	if r.MinAmount != nil && t.Amount < *r.MinAmount {
+		return false
+	}
+	if r.MaxAmount != nil && t.Amount > *r.MaxAmount {
+		return false
+	}
+	if len(r.Weekdays) > 0 {
+		found := false
+		weekday := t.Date.In(loc).Weekday()
+		for _, wd := range r.Weekdays {
+			if weekday == wd {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// apply sets Category and EnvelopeID from the first matching rule that provides them.
+// Unless overwrite is set, only fields that are empty on t are filled in.
+func (rs ruleSet) apply(t *Transaction, overwrite bool) {
+	needCategory := overwrite || t.Category == ""
+	needEnvelope := overwrite || t.EnvelopeID == uuid.Nil
+	for _, r := range rs.rules {
+		if !needCategory && !needEnvelope {
+			return
+		}
+		if !r.matches(t, rs.loc) {
+			continue
+		}
+		if needCategory && r.Category != "" {
+			t.Category = r.Category
+			needCategory = false
+		}
+		if needEnvelope && r.EnvelopeID != nil {
+			t.EnvelopeID = *r.EnvelopeID
+			needEnvelope = false
+		}
+	}
+}
+
+func (s *dobbyFinancier) loadRules(ctx context.Context) (ruleSet, error) {
+	rules, err := s.repo.ListRules(ctx)
+	if err != nil {
+		return ruleSet{}, err
+	}
+	sch, err := s.periodSchedule(ctx)
+	if err != nil {
+		return ruleSet{}, err
+	}
+	loc, err := sch.location()
+	if err != nil {
+		return ruleSet{}, err
+	}
+	return compileRules(rules, loc)
+}
+
+// applyToLines re-evaluates rules against each line of a split transaction, as if it were
+// a transaction of its own with the description and date of t, and returns the new lines.
+func (rs ruleSet) applyToLines(t Transaction) []TransactionSplit {
+	lines := make([]TransactionSplit, len(t.Splits))
+	for i, line := range t.Splits {
+		lt := t
+		lt.Splits, lt.Amount, lt.Category, lt.EnvelopeID = nil, line.Amount, line.Category, line.EnvelopeID
+		rs.apply(&lt, true)
+		lines[i] = TransactionSplit{EnvelopeID: lt.EnvelopeID, Amount: line.Amount, Category: lt.Category}
+	}
+	return lines
+}
+
+func (s *dobbyFinancier) CreateRule(ctx context.Context, r Rule) (*Rule, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	r.ID = uuid.New()
+	if r.PatternType == "" {
+		r.PatternType = PatternSubstring
+	}
+	if err := s.validateRule(ctx, r); err != nil {
+		return nil, err
+	}
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveRule(ctx, &r); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditRule, r.ID, nil, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (s *dobbyFinancier) GetRule(ctx context.Context, id uuid.UUID) (*Rule, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRule(ctx, id)
+}
+
+func (s *dobbyFinancier) ListRules(ctx context.Context) ([]Rule, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.ListRules(ctx)
+}
+
+func (s *dobbyFinancier) UpdateRule(ctx context.Context, r Rule) (*Rule, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	existing, err := s.repo.GetRule(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(existing.Version, r.Version); err != nil {
+		return nil, err
+	}
+	r.Version = existing.Version
+	if err := s.validateRule(ctx, r); err != nil {
+		return nil, err
+	}
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveRule(ctx, &r); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditRule, r.ID, existing, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (s *dobbyFinancier) DeleteRule(ctx context.Context, id uuid.UUID, version int64) error {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return err
+	}
+	r, err := s.repo.GetRule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(r.Version, version); err != nil {
+		return err
+	}
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.DeleteRule(ctx, id, r.Version); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditRule, id, r, nil)
+	})
+}
+
+func (s *dobbyFinancier) validateRule(ctx context.Context, r Rule) error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("%w: rule name must not be empty", ErrValidation)
+	}
+	if r.PatternType != PatternSubstring && r.PatternType != PatternRegex {
+		return fmt.Errorf("%w: unknown pattern type %q", ErrValidation, r.PatternType)
+	}
+	if _, err := compileRules([]Rule{r}, time.UTC); err != nil {
+		return err
+	}
+	if r.MinAmount != nil && r.MaxAmount != nil && *r.MinAmount > *r.MaxAmount {
+		return fmt.Errorf("%w: minimum amount is greater than maximum amount", ErrValidation)
+	}
+	for _, wd := range r.Weekdays {
+		if wd < time.Sunday || wd > time.Saturday {
+			return fmt.Errorf("%w: invalid weekday %d", ErrValidation, wd)
+		}
+	}
+	if r.Category == "" && r.EnvelopeID == nil {
+		return fmt.Errorf("%w: rule must set a category or an envelope", ErrValidation)
+	}
+	if r.EnvelopeID != nil {
+		if _, err := s.repo.GetEnvelope(ctx, *r.EnvelopeID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return fmt.Errorf("%w: envelope %s does not exist", ErrValidation, r.EnvelopeID)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyRules re-evaluates all rules against the transactions of a period, overwriting the
+// fields matching rules set. Split transactions are re-evaluated line by line, transfer legs
+// are left alone. Unless commit is set, changes are only reported and nothing is saved.
+func (s *dobbyFinancier) ApplyRules(ctx context.Context, periodID uuid.UUID, commit bool) (*RuleApplication, error) {
+	action := ActionRead
+	if commit {
+		action = ActionBudget
+	}
+	if err := s.authz.Authorize(ctx, action); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetPeriod(ctx, periodID); err != nil {
+		return nil, err
+	}
+	rs, err := s.loadRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := s.repo.ListTransactions(ctx, TransactionFilter{PeriodID: &periodID})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RuleApplication{}
+	for _, t := range txs {
+		if t.TransferID != nil {
+			continue
+		}
+		after := t
+		if len(t.Splits) > 0 {
+			after.Splits = rs.applyToLines(t)
+			after.EnvelopeID = after.Splits[0].EnvelopeID // As set by validateSplits
+		} else {
+			rs.apply(&after, true)
+		}
+		if after.Category != t.Category || after.EnvelopeID != t.EnvelopeID || !slices.Equal(after.Splits, t.Splits) {
+			res.Changes = append(res.Changes, RuleChange{Before: t, After: after})
+		}
+	}
+
+	if !commit {
+		return res, nil
+	}
+
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		for i := range res.Changes {
+			change := &res.Changes[i]
+			stampUpdated(ctx, &change.After)
+			if err := s.repo.SaveTransaction(ctx, &change.After); err != nil {
+				return err
+			}
+			if err := s.audit(ctx, AuditTransaction, change.After.ID, change.Before, change.After); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Committed = true
+	return res, nil
+}
+
+// categorize fills in empty Category and EnvelopeID of t from rules, then falls back
+// to the default envelope of period p. It fails if t ends up without an envelope.
+func categorize(rs ruleSet, t *Transaction, p *Period) error {
+	rs.apply(t, false)
+	if t.EnvelopeID == uuid.Nil && p.DefaultEnvelopeID != nil {
+		t.EnvelopeID = *p.DefaultEnvelopeID
+	}
+	if t.EnvelopeID == uuid.Nil {
+		return fmt.Errorf("%w: no envelope given, matched by rules or set as period default", ErrValidation)
+	}
+	return nil
+}
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func amount(v int64) *int64 { return &v }
+
+func TestCompileRulesOrdersByPriority(t *testing.T) {
+	rs, err := compileRules([]Rule{
+		{Name: "late", Priority: 20},
+		{Name: "early", Priority: 10},
+		{Name: "also late", Priority: 20},
+	}, time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	for _, r := range rs.rules {
+		names = append(names, r.Name)
+	}
+	if len(names) != 3 || names[0] != "early" || names[1] != "late" || names[2] != "also late" {
+		t.Errorf("expected rules by priority, ties in given order, got %v", names)
+	}
+
+	if _, err := compileRules([]Rule{{Name: "broken", PatternType: PatternRegex, DescriptionPattern: "("}}, time.UTC); !errors.Is(err, ErrValidation) {
+		t.Errorf("invalid pattern: expected ErrValidation, got %v", err)
+	}
+}
+
+func TestRuleMatches(t *testing.T) {
+	// Sunday 23:30 in UTC is already Monday an hour east of it.
+	sundayNight := time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC)
+	eastOfUTC := time.FixedZone("UTC+1", 60*60)
+
+	tests := []struct {
+		name string
+		rule Rule
+		loc  *time.Location
+		tx   Transaction
+		want bool
+	}{
+		{
+			name: "no conditions",
+			tx:   Transaction{Description: "anything", Amount: -100},
+			want: true,
+		},
+		{
+			name: "substring ignores case",
+			rule: Rule{DescriptionPattern: "lidl", PatternType: PatternSubstring},
+			tx:   Transaction{Description: "LIDL Belgrade 042"},
+			want: true,
+		},
+		{
+			name: "substring is not a regular expression",
+			rule: Rule{DescriptionPattern: "lid.", PatternType: PatternSubstring},
+			tx:   Transaction{Description: "LIDL Belgrade"},
+		},
+		{
+			name: "regex ignores case",
+			rule: Rule{DescriptionPattern: `^lidl \w+ \d+$`, PatternType: PatternRegex},
+			tx:   Transaction{Description: "LIDL Belgrade 042"},
+			want: true,
+		},
+		{
+			name: "regex does not match",
+			rule: Rule{DescriptionPattern: `^maxi`, PatternType: PatternRegex},
+			tx:   Transaction{Description: "LIDL Belgrade 042"},
+		},
+		{
+			name: "minimum amount is inclusive",
+			rule: Rule{MinAmount: amount(-500)},
+			tx:   Transaction{Amount: -500},
+			want: true,
+		},
+		{
+			name: "below minimum amount",
+			rule: Rule{MinAmount: amount(-500)},
+			tx:   Transaction{Amount: -501},
+		},
+		{
+			name: "maximum amount is inclusive",
+			rule: Rule{MaxAmount: amount(-100)},
+			tx:   Transaction{Amount: -100},
+			want: true,
+		},
+		{
+			name: "above maximum amount",
+			rule: Rule{MaxAmount: amount(-100)},
+			tx:   Transaction{Amount: -99},
+		},
+		{
+			name: "weekday",
+			rule: Rule{Weekdays: []time.Weekday{time.Saturday, time.Sunday}},
+			tx:   Transaction{Date: sundayNight},
+			want: true,
+		},
+		{
+			name: "weekday of the household calendar",
+			rule: Rule{Weekdays: []time.Weekday{time.Saturday, time.Sunday}},
+			loc:  eastOfUTC,
+			tx:   Transaction{Date: sundayNight},
+		},
+		{
+			name: "weekday of the household calendar matches",
+			rule: Rule{Weekdays: []time.Weekday{time.Monday}},
+			loc:  eastOfUTC,
+			tx:   Transaction{Date: sundayNight},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := tt.loc
+			if loc == nil {
+				loc = time.UTC
+			}
+			rs, err := compileRules([]Rule{tt.rule}, loc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := rs.rules[0].matches(&tt.tx, rs.loc); got != tt.want {
+				t.Errorf("expected match %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRuleSetApply(t *testing.T) {
+	food, transport := uuid.New(), uuid.New()
+	rs, err := compileRules([]Rule{
+		{Name: "bus", Priority: 1, DescriptionPattern: "bus", Category: "transport", EnvelopeID: &transport},
+		{Name: "fallback category", Priority: 2, Category: "misc"},
+		{Name: "fallback envelope", Priority: 3, EnvelopeID: &food},
+	}, time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		tx           Transaction
+		overwrite    bool
+		wantCategory string
+		wantEnvelope uuid.UUID
+	}{
+		{
+			name:         "first matching rule wins",
+			tx:           Transaction{Description: "City bus"},
+			wantCategory: "transport",
+			wantEnvelope: transport,
+		},
+		{
+			name:         "later rules fill in what earlier ones leave",
+			tx:           Transaction{Description: "Bakery"},
+			wantCategory: "misc",
+			wantEnvelope: food,
+		},
+		{
+			name:         "set fields are kept",
+			tx:           Transaction{Description: "City bus", Category: "commute", EnvelopeID: food},
+			wantCategory: "commute",
+			wantEnvelope: food,
+		},
+		{
+			name:         "overwrite replaces set fields",
+			tx:           Transaction{Description: "City bus", Category: "commute", EnvelopeID: food},
+			overwrite:    true,
+			wantCategory: "transport",
+			wantEnvelope: transport,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := tt.tx
+			rs.apply(&tx, tt.overwrite)
+			if tx.Category != tt.wantCategory || tx.EnvelopeID != tt.wantEnvelope {
+				t.Errorf("expected %q in %v, got %q in %v", tt.wantCategory, tt.wantEnvelope, tx.Category, tx.EnvelopeID)
+			}
+		})
+	}
+}
+
+func TestCategorize(t *testing.T) {
+	rent, fallback := uuid.New(), uuid.New()
+	rs, err := compileRules([]Rule{{Name: "rent", DescriptionPattern: "rent", EnvelopeID: &rent}}, time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		tx      Transaction
+		period  Period
+		want    uuid.UUID
+		wantErr bool
+	}{
+		{
+			name:   "matched by rule",
+			tx:     Transaction{Description: "Rent March"},
+			period: Period{DefaultEnvelopeID: &fallback},
+			want:   rent,
+		},
+		{
+			name:   "period default envelope",
+			tx:     Transaction{Description: "Groceries"},
+			period: Period{DefaultEnvelopeID: &fallback},
+			want:   fallback,
+		},
+		{
+			name:    "no envelope at all",
+			tx:      Transaction{Description: "Groceries"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := tt.tx
+			err := categorize(rs, &tx, &tt.period)
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Fatalf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tx.EnvelopeID != tt.want {
+				t.Errorf("expected envelope %v, got %v", tt.want, tx.EnvelopeID)
+			}
+		})
+	}
+}
+
+func TestApplyRules(t *testing.T) {
+	repo := newMemRepo()
+	s, ctx := newMemService(repo)
+	food, home, savings := uuid.New(), uuid.New(), uuid.New()
+	period := Period{ID: uuid.New(), StartDate: date(2026, 3, 5), EndDate: date(2026, 4, 5), Version: 1}
+	repo.periods[period.ID] = period
+	repo.rules = []Rule{
+		{Name: "groceries", DescriptionPattern: "market", Category: "groceries", EnvelopeID: &food},
+		{Name: "cleaning", MinAmount: amount(-300), MaxAmount: amount(-1), Category: "cleaning", EnvelopeID: &home},
+	}
+
+	transferID := uuid.New()
+	plain := Transaction{ID: uuid.New(), PeriodID: period.ID, EnvelopeID: home, Amount: -2000, Description: "Market", Date: date(2026, 3, 6), Version: 1}
+	untouched := Transaction{ID: uuid.New(), PeriodID: period.ID, EnvelopeID: food, Amount: -2000, Description: "Market", Category: "groceries", Date: date(2026, 3, 7), Version: 1}
+	transfer := Transaction{ID: uuid.New(), PeriodID: period.ID, EnvelopeID: savings, Amount: -2000, Description: "Market", Date: date(2026, 3, 8), TransferID: &transferID, Version: 1}
+	split := Transaction{ID: uuid.New(), PeriodID: period.ID, EnvelopeID: home, Amount: -1000, Description: "Corner shop", Category: "shopping", Date: date(2026, 3, 9), Version: 1,
+		Splits: []TransactionSplit{{EnvelopeID: home, Amount: -800, Category: "shopping"}, {EnvelopeID: food, Amount: -200, Category: "shopping"}}}
+	for _, tx := range []Transaction{plain, untouched, transfer, split} {
+		repo.transactions[tx.ID] = tx
+	}
+
+	preview, err := s.ApplyRules(ctx, period.ID, false)
+	if err != nil {
+		t.Fatalf("preview: unexpected error: %v", err)
+	}
+	if preview.Committed || len(preview.Changes) != 2 {
+		t.Fatalf("preview: expected 2 uncommitted changes, got %+v", preview)
+	}
+	if repo.transactions[plain.ID].Version != 1 || len(repo.audit) != 0 {
+		t.Fatalf("preview: expected nothing saved")
+	}
+
+	byID := map[uuid.UUID]Transaction{}
+	for _, c := range preview.Changes {
+		byID[c.After.ID] = c.After
+	}
+	if got := byID[plain.ID]; got.Category != "groceries" || got.EnvelopeID != food {
+		t.Errorf("expected plain transaction in groceries/%v, got %q/%v", food, got.Category, got.EnvelopeID)
+	}
+	got := byID[split.ID]
+	if got.Category != "shopping" || got.EnvelopeID != home {
+		t.Errorf("expected split transaction itself to keep shopping/%v, got %q/%v", home, got.Category, got.EnvelopeID)
+	}
+	if len(got.Splits) != 2 || got.Splits[0] != split.Splits[0] || got.Splits[1] != (TransactionSplit{EnvelopeID: home, Amount: -200, Category: "cleaning"}) {
+		t.Errorf("expected only the small line to be re-categorised, got %+v", got.Splits)
+	}
+
+	committed, err := s.ApplyRules(ctx, period.ID, true)
+	if err != nil {
+		t.Fatalf("commit: unexpected error: %v", err)
+	}
+	if !committed.Committed || len(committed.Changes) != 2 || len(repo.audit) != 2 {
+		t.Fatalf("commit: expected 2 committed and audited changes, got %+v and %d audit entries", committed, len(repo.audit))
+	}
+	if saved := repo.transactions[split.ID]; saved.Version != 2 || saved.Splits[1].Category != "cleaning" {
+		t.Errorf("commit: expected re-categorised split lines saved, got %+v", saved)
+	}
+	if repo.transactions[transfer.ID].Version != 1 {
+		t.Errorf("commit: expected transfer legs left alone")
+	}
+
+	again, err := s.ApplyRules(ctx, period.ID, false)
+	if err != nil || len(again.Changes) != 0 {
+		t.Errorf("expected no changes left after commit, got %+v, %v", again, err)
+	}
+}
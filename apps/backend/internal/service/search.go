@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	defaultSearchLimit = 50
+	maxSearchLimit     = 200
+
+	maxSnippetRunes = 80
+	snippetLead     = 20 // Runes of context kept before the first match
+)
+
+// TransactionMatch is a transaction found by a full-text search together with its relevance.
+type TransactionMatch struct {
+	Transaction Transaction
+	Rank        float64
+}
+
+// SearchResult is a ranked search hit with a highlighted excerpt of its description.
+type SearchResult struct {
+	Transaction Transaction
+	Rank        float64
+	Snippet     string      // Excerpt of the description around the first match
+	Highlights  []TextRange // Matched parts of Snippet
+}
+
+// TextRange is a half-open range of rune offsets.
+type TextRange struct {
+	Start, End int
+}
+
+func (s *dobbyFinancier) SearchTransactions(ctx context.Context, query string, limit int) ([]SearchResult, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("%w: search query must not be empty", ErrValidation)
+	}
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	if limit < 0 || limit > maxSearchLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrValidation, maxSearchLimit)
+	}
+
+	matches, err := s.repo.SearchTransactions(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]SearchResult, len(matches))
+	for i, m := range matches {
+		snippet, highlights := highlight(m.Transaction.Description, query)
+		res[i] = SearchResult{
+			Transaction: m.Transaction,
+			Rank:        m.Rank,
+			Snippet:     snippet,
+			Highlights:  highlights,
+		}
+	}
+	return res, nil
+}
+
+// Serbian Cyrillic letters and Latin diacritics folded to a single ASCII letter.
+// Keep in sync with the search_fold database function.
+const (
+	foldFrom = "абвгдежзијклмнопрстћуфхцчшčćšž"
+	foldTo   = "abvgdezzijklmnoprstcufhccsccsz"
+)
+
+var foldTable = func() map[rune]string {
+	table := map[rune]string{'љ': "lj", 'њ': "nj", 'џ': "dz", 'ђ': "dj", 'đ': "dj"}
+	to := []rune(foldTo)
+	for i, r := range []rune(foldFrom) {
+		table[r] = string(to[i])
+	}
+	return table
+}()
+
+func foldRune(r rune) string {
+	r = unicode.ToLower(r)
+	if f, ok := foldTable[r]; ok {
+		return f
+	}
+	return string(r)
+}
+
+// searchFold lower-cases s and transliterates it to the alphabet descriptions are indexed in.
+func searchFold(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		b.WriteString(foldRune(r))
+	}
+	return b.String()
+}
+
+// highlight finds the query terms in description regardless of case, script and diacritics.
+// It returns an excerpt of the description around the first match and the matched ranges within it.
+func highlight(description, query string) (string, []TextRange) {
+	runes := []rune(description)
+
+	// Fold the description keeping track of the original rune every folded rune comes from.
+	var folded []rune
+	var origin []int
+	for i, r := range runes {
+		for _, f := range foldRune(r) {
+			folded = append(folded, f)
+			origin = append(origin, i)
+		}
+	}
+
+	marked := make([]bool, len(runes))
+	terms := strings.FieldsFunc(searchFold(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, term := range terms {
+		tr := []rune(term)
+		for i := 0; i+len(tr) <= len(folded); i++ {
+			if string(folded[i:i+len(tr)]) == term {
+				for j := origin[i]; j <= origin[i+len(tr)-1]; j++ {
+					marked[j] = true
+				}
+			}
+		}
+	}
+
+	start, end := 0, len(runes)
+	if len(runes) > maxSnippetRunes {
+		for i, m := range marked {
+			if m {
+				start = max(0, min(i-snippetLead, len(runes)-maxSnippetRunes))
+				break
+			}
+		}
+		end = start + maxSnippetRunes
+	}
+
+	var ranges []TextRange
+	for i := start; i < end; i++ {
+		if !marked[i] {
+			continue
+		}
+		if n := len(ranges); n > 0 && ranges[n-1].End == i-start {
+			ranges[n-1].End++
+		} else {
+			ranges = append(ranges, TextRange{Start: i - start, End: i - start + 1})
+		}
+	}
+
+	snippet := string(runes[start:end])
+	if start > 0 {
+		snippet = "…" + snippet
+		for i := range ranges {
+			ranges[i].Start++
+			ranges[i].End++
+		}
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	return snippet, ranges
+}
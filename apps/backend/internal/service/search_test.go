@@ -0,0 +1,74 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSearchFold(t *testing.T) {
+	tests := map[string]string{
+		"Апотека Бену": "apoteka benu",
+		"APOTEKA benu": "apoteka benu",
+		"Ђорђе Љубић":  "djordje ljubic",
+		"Đorđe Ljubić": "djordje ljubic",
+		"Џак кромпира": "dzak krompira",
+		"Šećer i čaj":  "secer i caj",
+		"Maxi 24/7 NS": "maxi 24/7 ns",
+	}
+	for in, want := range tests {
+		if got := searchFold(in); got != want {
+			t.Errorf("searchFold(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		query       string
+		snippet     string
+		want        []TextRange
+	}{
+		{
+			name:        "latin query in cyrillic description",
+			description: "Апотека Бену",
+			query:       "apoteka",
+			snippet:     "Апотека Бену",
+			want:        []TextRange{{0, 7}},
+		},
+		{
+			name:        "digraph letters and multiple terms",
+			description: "Љубић market",
+			query:       "ljub mark",
+			snippet:     "Љубић market",
+			want:        []TextRange{{0, 3}, {6, 10}},
+		},
+		{
+			name:        "no match",
+			description: "Groceries",
+			query:       "pharmacy",
+			snippet:     "Groceries",
+		},
+		{
+			name:        "long description is clipped around the match",
+			description: strings.Repeat("x", 100) + " pharmacy " + strings.Repeat("y", 100),
+			query:       "pharmacy",
+			snippet:     "…" + strings.Repeat("x", 19) + " pharmacy " + strings.Repeat("y", 51) + "…",
+			want:        []TextRange{{21, 29}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snippet, got := highlight(tt.description, tt.query)
+			if snippet != tt.snippet {
+				t.Errorf("snippet = %q, want %q", snippet, tt.snippet)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("highlights = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessTokenPrefix marks personal access tokens, telling them apart from identity provider tokens.
+const AccessTokenPrefix = "dobby_pat_"
+
+const (
+	defaultAccessTokenTTL = 90 * 24 * time.Hour
+	maxAccessTokenTTL     = 366 * 24 * time.Hour
+	// lastUsedResolution limits how often using a token is written back to the database.
+	lastUsedResolution = time.Minute
+)
+
+var errInvalidAccessToken = fmt.Errorf("%w: invalid access token", ErrForbidden)
+
+type tokenScopeKey struct{}
+
+// WithTokenScope returns a context of a request authenticated with an access token of the given scope.
+func WithTokenScope(ctx context.Context, scope TokenScope) context.Context {
+	return context.WithValue(ctx, tokenScopeKey{}, scope)
+}
+
+// TokenScopeFromContext returns the scope of the access token the request was authenticated with, if any.
+func TokenScopeFromContext(ctx context.Context) (TokenScope, bool) {
+	scope, ok := ctx.Value(tokenScopeKey{}).(TokenScope)
+	return scope, ok
+}
+
+// CreateAccessToken issues a personal access token for the authenticated user.
+// It expires after 90 days unless expiresAt says otherwise, but one year at most.
+func (s *dobbyFinancier) CreateAccessToken(ctx context.Context, name string, scope TokenScope, expiresAt *time.Time) (*AccessToken, error) {
+	if err := s.authz.Authorize(ctx, ActionManageTokens); err != nil {
+		return nil, err
+	}
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", ErrForbidden)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: token name must not be empty", ErrValidation)
+	}
+	if scope != ScopeRead && scope != ScopeWrite {
+		return nil, fmt.Errorf("%w: unknown token scope %q", ErrValidation, scope)
+	}
+	now := time.Now()
+	expires := now.Add(defaultAccessTokenTTL)
+	if expiresAt != nil {
+		expires = *expiresAt
+	}
+	if !expires.After(now) {
+		return nil, fmt.Errorf("%w: token expiry must be in the future", ErrValidation)
+	}
+	if expires.After(now.Add(maxAccessTokenTTL)) {
+		return nil, fmt.Errorf("%w: tokens expire within a year at most", ErrValidation)
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	token := AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	t := &AccessToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Token:     token,
+		TokenHash: hashAccessToken(token),
+		Scope:     scope,
+		CreatedAt: now,
+		ExpiresAt: expires,
+	}
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveAccessToken(ctx, t); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditAccessToken, t.ID, nil, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ListAccessTokens lists the access tokens of the authenticated user that have not been revoked.
+func (s *dobbyFinancier) ListAccessTokens(ctx context.Context) ([]AccessToken, error) {
+	if err := s.authz.Authorize(ctx, ActionManageTokens); err != nil {
+		return nil, err
+	}
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", ErrForbidden)
+	}
+	return s.repo.ListAccessTokens(ctx, userID)
+}
+
+// RevokeAccessToken makes an access token of the authenticated user unusable.
+func (s *dobbyFinancier) RevokeAccessToken(ctx context.Context, id uuid.UUID) error {
+	if err := s.authz.Authorize(ctx, ActionManageTokens); err != nil {
+		return err
+	}
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("%w: no authenticated user", ErrForbidden)
+	}
+
+	t, err := s.repo.GetAccessToken(ctx, id)
+	if err != nil {
+		return err
+	}
+	if t.UserID != userID || t.RevokedAt != nil {
+		return ErrNotFound
+	}
+	before := *t
+	now := time.Now()
+	t.RevokedAt = &now
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveAccessToken(ctx, t); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditAccessToken, t.ID, before, t)
+	})
+}
+
+// AuthenticateAccessToken resolves a presented access token, failing with ErrForbidden
+// if it is unknown, revoked or expired. It records when the token was last used.
+func (s *dobbyFinancier) AuthenticateAccessToken(ctx context.Context, token string) (*AccessToken, error) {
+	t, err := s.repo.GetAccessTokenByHash(ctx, hashAccessToken(token))
+	if errors.Is(err, ErrNotFound) {
+		return nil, errInvalidAccessToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if t.RevokedAt != nil || !now.Before(t.ExpiresAt) {
+		return nil, errInvalidAccessToken
+	}
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) >= lastUsedResolution {
+		t.LastUsedAt = &now
+		if err := s.repo.SaveAccessToken(ctx, t); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+func hashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// tokenRepo keeps access tokens in memory; other Repository methods are not used.
+type tokenRepo struct {
+	Repository
+	tokens map[string]*AccessToken
+	saves  int
+}
+
+func (r *tokenRepo) GetAccessTokenByHash(ctx context.Context, tokenHash string) (*AccessToken, error) {
+	t, ok := r.tokens[tokenHash]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	copied := *t
+	return &copied, nil
+}
+
+func (r *tokenRepo) SaveAccessToken(ctx context.Context, t *AccessToken) error {
+	copied := *t
+	r.tokens[t.TokenHash] = &copied
+	r.saves++
+	return nil
+}
+
+func TestAuthenticateAccessToken(t *testing.T) {
+	now := time.Now()
+	yesterday := now.Add(-24 * time.Hour)
+	repo := &tokenRepo{tokens: map[string]*AccessToken{}}
+	add := func(token string, expires time.Time, revoked *time.Time) {
+		repo.tokens[hashAccessToken(token)] = &AccessToken{
+			ID: uuid.New(), TokenHash: hashAccessToken(token), Scope: ScopeRead, ExpiresAt: expires, RevokedAt: revoked,
+		}
+	}
+	add("dobby_pat_valid", now.Add(time.Hour), nil)
+	add("dobby_pat_expired", yesterday, nil)
+	add("dobby_pat_revoked", now.Add(time.Hour), &yesterday)
+	s := &dobbyFinancier{repo: repo}
+	ctx := context.Background()
+
+	for _, token := range []string{"dobby_pat_unknown", "dobby_pat_expired", "dobby_pat_revoked"} {
+		if _, err := s.AuthenticateAccessToken(ctx, token); !errors.Is(err, ErrForbidden) {
+			t.Errorf("%s: expected ErrForbidden, got %v", token, err)
+		}
+	}
+
+	pat, err := s.AuthenticateAccessToken(ctx, "dobby_pat_valid")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pat.LastUsedAt == nil || repo.saves != 1 {
+		t.Fatalf("expected last use to be recorded, got %v after %d saves", pat.LastUsedAt, repo.saves)
+	}
+	if _, err := s.AuthenticateAccessToken(ctx, "dobby_pat_valid"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.saves != 1 {
+		t.Errorf("expected repeated use within %v not to be written, got %d saves", lastUsedResolution, repo.saves)
+	}
+}
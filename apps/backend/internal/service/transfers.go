@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const transferCategory = "Transfer"
+
+func (s *dobbyFinancier) TransferFunds(ctx context.Context, from, to uuid.UUID, amount int64, periodID uuid.UUID) (*Transfer, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w: transfer amount must be positive", ErrValidation)
+	}
+	if from == to {
+		return nil, fmt.Errorf("%w: cannot transfer funds to the same envelope", ErrValidation)
+	}
+
+	period, err := s.repo.GetPeriod(ctx, periodID)
+	if err != nil {
+		return nil, err
+	}
+	fromEnv, err := s.repo.GetEnvelope(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toEnv, err := s.repo.GetEnvelope(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now()
+	if !period.Contains(date) {
+		date = period.StartDate
+	}
+
+	tr := &Transfer{
+		ID:             uuid.New(),
+		PeriodID:       period.ID,
+		FromEnvelopeID: fromEnv.ID,
+		ToEnvelopeID:   toEnv.ID,
+		Amount:         amount,
+		Date:           date,
+	}
+	outgoing := Transaction{
+		ID:          uuid.New(),
+		PeriodID:    tr.PeriodID,
+		EnvelopeID:  tr.FromEnvelopeID,
+		Amount:      -amount,
+		Description: "Transfer to " + toEnv.Name,
+		Date:        date,
+		Category:    transferCategory,
+		TransferID:  &tr.ID,
+	}
+	incoming := Transaction{
+		ID:          uuid.New(),
+		PeriodID:    tr.PeriodID,
+		EnvelopeID:  tr.ToEnvelopeID,
+		Amount:      amount,
+		Description: "Transfer from " + fromEnv.Name,
+		Date:        date,
+		Category:    transferCategory,
+		TransferID:  &tr.ID,
+	}
+
+	stampCreated(ctx, &outgoing)
+	stampCreated(ctx, &incoming)
+
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		for _, leg := range []*Transaction{&outgoing, &incoming} {
+			if err := s.repo.SaveTransaction(ctx, leg); err != nil {
+				return err
+			}
+			if err := s.audit(ctx, AuditTransaction, leg.ID, nil, leg); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tr, nil
+}
+
+// transferCounterpart returns the other leg of the transfer t belongs to.
+func (s *dobbyFinancier) transferCounterpart(ctx context.Context, t *Transaction) (*Transaction, error) {
+	legs, err := s.repo.ListTransactions(ctx, TransactionFilter{TransferID: t.TransferID})
+	if err != nil {
+		return nil, err
+	}
+	for _, leg := range legs {
+		if leg.ID != t.ID {
+			return &leg, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: counterpart of transfer %s", ErrNotFound, t.TransferID)
+}
+
+// saveTransferLeg saves t, previously existing, and mirrors its amount, period and date
+// onto the other leg of the transfer, so both legs always cancel each other out.
+func (s *dobbyFinancier) saveTransferLeg(ctx context.Context, existing, t *Transaction) error {
+	if t.Amount == 0 {
+		return fmt.Errorf("%w: transfer amount must not be zero", ErrValidation)
+	}
+	counterpart, err := s.transferCounterpart(ctx, t)
+	if err != nil {
+		return err
+	}
+	if counterpart.EnvelopeID == t.EnvelopeID {
+		return fmt.Errorf("%w: cannot transfer funds to the same envelope", ErrValidation)
+	}
+
+	counterpartBefore := *counterpart
+	counterpart.Amount = -t.Amount
+	counterpart.PeriodID = t.PeriodID
+	counterpart.Date = t.Date
+	stampUpdated(ctx, counterpart)
+
+	if err := s.repo.SaveTransaction(ctx, t); err != nil {
+		return err
+	}
+	if err := s.audit(ctx, AuditTransaction, t.ID, existing, t); err != nil {
+		return err
+	}
+	if err := s.repo.SaveTransaction(ctx, counterpart); err != nil {
+		return err
+	}
+	return s.audit(ctx, AuditTransaction, counterpart.ID, counterpartBefore, counterpart)
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// transferFixture is a household with a current period and two envelopes to move money between.
+type transferFixture struct {
+	repo              *memRepo
+	s                 *dobbyFinancier
+	ctx               context.Context
+	period            Period
+	groceries, saving Envelope
+}
+
+func newTransferFixture(t *testing.T) *transferFixture {
+	t.Helper()
+	f := &transferFixture{repo: newMemRepo()}
+	f.s, f.ctx = newMemService(f.repo)
+	f.period = Period{ID: uuid.New(), StartDate: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 4, 5, 0, 0, 0, 0, time.UTC)}
+	f.groceries = Envelope{ID: uuid.New(), Name: "Groceries"}
+	f.saving = Envelope{ID: uuid.New(), Name: "Saving"}
+	for _, err := range []error{
+		f.repo.SavePeriod(f.ctx, &f.period),
+		f.repo.SaveEnvelope(f.ctx, &f.groceries),
+		f.repo.SaveEnvelope(f.ctx, &f.saving),
+	} {
+		if err != nil {
+			t.Fatalf("fixture: %v", err)
+		}
+	}
+	return f
+}
+
+// legs returns the outgoing and incoming leg of the transfer, in or out of the trash.
+func (f *transferFixture) legs(t *testing.T, transferID uuid.UUID) (outgoing, incoming Transaction) {
+	t.Helper()
+	legs, err := f.repo.ListTransactions(f.ctx, TransactionFilter{TransferID: &transferID, IncludeDeleted: true})
+	if err != nil || len(legs) != 2 {
+		t.Fatalf("expected 2 legs, got %d, %v", len(legs), err)
+	}
+	if legs[0].Amount > 0 {
+		legs[0], legs[1] = legs[1], legs[0]
+	}
+	return legs[0], legs[1]
+}
+
+func TestTransferFunds(t *testing.T) {
+	f := newTransferFixture(t)
+
+	tr, err := f.s.TransferFunds(f.ctx, f.groceries.ID, f.saving.ID, 2500, f.period.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !f.period.Contains(tr.Date) {
+		t.Errorf("expected the transfer dated within the period, got %v", tr.Date)
+	}
+
+	outgoing, incoming := f.legs(t, tr.ID)
+	if outgoing.EnvelopeID != f.groceries.ID || outgoing.Amount != -2500 || outgoing.Description != "Transfer to Saving" {
+		t.Errorf("unexpected outgoing leg %+v", outgoing)
+	}
+	if incoming.EnvelopeID != f.saving.ID || incoming.Amount != 2500 || incoming.Description != "Transfer from Groceries" {
+		t.Errorf("unexpected incoming leg %+v", incoming)
+	}
+	for _, leg := range []Transaction{outgoing, incoming} {
+		if leg.Category != transferCategory || leg.PeriodID != f.period.ID || !leg.Date.Equal(tr.Date) {
+			t.Errorf("expected leg in the transfer category, period and date, got %+v", leg)
+		}
+	}
+	if len(f.repo.audit) != 2 {
+		t.Errorf("expected both legs audited, got %d entries", len(f.repo.audit))
+	}
+}
+
+func TestTransferFundsValidation(t *testing.T) {
+	f := newTransferFixture(t)
+
+	tests := []struct {
+		name     string
+		from, to uuid.UUID
+		amount   int64
+		period   uuid.UUID
+		want     error
+	}{
+		{"zero amount", f.groceries.ID, f.saving.ID, 0, f.period.ID, ErrValidation},
+		{"negative amount", f.groceries.ID, f.saving.ID, -100, f.period.ID, ErrValidation},
+		{"same envelope", f.groceries.ID, f.groceries.ID, 100, f.period.ID, ErrValidation},
+		{"unknown envelope", f.groceries.ID, uuid.New(), 100, f.period.ID, ErrNotFound},
+		{"unknown period", f.groceries.ID, f.saving.ID, 100, uuid.New(), ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := f.s.TransferFunds(f.ctx, tt.from, tt.to, tt.amount, tt.period); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+	if len(f.repo.transactions) != 0 {
+		t.Errorf("expected no legs saved, got %d", len(f.repo.transactions))
+	}
+}
+
+func TestUpdateTransferLegMirrorsCounterpart(t *testing.T) {
+	f := newTransferFixture(t)
+	tr, err := f.s.TransferFunds(f.ctx, f.groceries.ID, f.saving.ID, 2500, f.period.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	outgoing, _ := f.legs(t, tr.ID)
+
+	changed := outgoing
+	changed.Amount = -4000
+	changed.Date = time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	if _, err := f.s.UpdateTransaction(f.ctx, changed); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	outgoing, incoming := f.legs(t, tr.ID)
+	if outgoing.Amount != -4000 || incoming.Amount != 4000 || !incoming.Date.Equal(changed.Date) {
+		t.Errorf("expected the incoming leg to mirror %d on %v, got %d on %v", changed.Amount, changed.Date, incoming.Amount, incoming.Date)
+	}
+
+	zero := outgoing
+	zero.Amount = 0
+	if _, err := f.s.UpdateTransaction(f.ctx, zero); !errors.Is(err, ErrValidation) {
+		t.Errorf("zero amount: expected ErrValidation, got %v", err)
+	}
+	same := outgoing
+	same.EnvelopeID = f.saving.ID
+	if _, err := f.s.UpdateTransaction(f.ctx, same); !errors.Is(err, ErrValidation) {
+		t.Errorf("same envelope: expected ErrValidation, got %v", err)
+	}
+	if o, i := f.legs(t, tr.ID); o.Version != outgoing.Version || i.Version != incoming.Version {
+		t.Errorf("expected rejected changes to save neither leg")
+	}
+}
+
+func TestDeleteAndRestoreTransfer(t *testing.T) {
+	f := newTransferFixture(t)
+	tr, err := f.s.TransferFunds(f.ctx, f.groceries.ID, f.saving.ID, 2500, f.period.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	outgoing, incoming := f.legs(t, tr.ID)
+
+	if err := f.s.DeleteTransaction(f.ctx, incoming.ID, incoming.Version); err != nil {
+		t.Fatalf("delete: unexpected error: %v", err)
+	}
+	outgoing, incoming = f.legs(t, tr.ID)
+	if outgoing.DeletedAt == nil || incoming.DeletedAt == nil {
+		t.Fatalf("expected both legs in the trash")
+	}
+
+	restored, err := f.s.RestoreTransaction(f.ctx, outgoing.ID)
+	if err != nil {
+		t.Fatalf("restore: unexpected error: %v", err)
+	}
+	if restored.ID != outgoing.ID {
+		t.Errorf("expected the restored leg %v, got %v", outgoing.ID, restored.ID)
+	}
+	outgoing, incoming = f.legs(t, tr.ID)
+	if outgoing.DeletedAt != nil || incoming.DeletedAt != nil {
+		t.Errorf("expected both legs restored")
+	}
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Trash holds the deleted transactions and envelopes of a household until they are restored or purged.
+type Trash struct {
+	Transactions []Transaction
+	Envelopes    []Envelope
+}
+
+// PurgedItem is a transaction or envelope permanently deleted from the trash of a household.
+type PurgedItem struct {
+	HouseholdID uuid.UUID
+	Entity      AuditEntity // AuditTransaction or AuditEnvelope
+	ID          uuid.UUID
+	Item        any // The Transaction or Envelope as it was in the trash
+}
+
+// ListTrash lists what was deleted in the household, most recently deleted first.
+func (s *dobbyFinancier) ListTrash(ctx context.Context) (*Trash, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	txs, err := s.repo.ListDeletedTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	envelopes, err := s.repo.ListDeletedEnvelopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Trash{Transactions: txs, Envelopes: envelopes}, nil
+}
+
+// RestoreTransaction takes a transaction out of the trash. Restoring either leg of a transfer
+// restores the whole transfer. The envelopes of the transaction must not be in the trash.
+func (s *dobbyFinancier) RestoreTransaction(ctx context.Context, id uuid.UUID) (*Transaction, error) {
+	deleted, err := s.repo.ListDeletedTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	legs := trashedLegs(deleted, id)
+	if len(legs) == 0 {
+		return nil, ErrNotFound
+	}
+	if err := s.authorizeTransactionChange(ctx, legs...); err != nil {
+		return nil, err
+	}
+	for _, envelopeID := range envelopeIDs(legs...) {
+		if _, err := s.repo.GetEnvelope(ctx, envelopeID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return nil, fmt.Errorf("%w: envelope %s is deleted, restore it first", ErrConflict, envelopeID)
+			}
+			return nil, err
+		}
+	}
+
+	var restored *Transaction
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		for _, leg := range legs {
+			if err := s.repo.RestoreTransaction(ctx, leg.ID); err != nil {
+				return err
+			}
+			after := leg
+			after.DeletedAt = nil
+			after.Version++
+			if err := s.audit(ctx, AuditTransaction, leg.ID, leg, after); err != nil {
+				return err
+			}
+			if leg.ID == id {
+				restored = &after
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// trashedLegs finds the transaction with the given ID among deleted ones,
+// along with the other leg if it belongs to a transfer.
+func trashedLegs(deleted []Transaction, id uuid.UUID) []Transaction {
+	var t *Transaction
+	for i := range deleted {
+		if deleted[i].ID == id {
+			t = &deleted[i]
+		}
+	}
+	if t == nil {
+		return nil
+	}
+	if t.TransferID == nil {
+		return []Transaction{*t}
+	}
+	var legs []Transaction
+	for _, leg := range deleted {
+		if leg.TransferID != nil && *leg.TransferID == *t.TransferID {
+			legs = append(legs, leg)
+		}
+	}
+	return legs
+}
+
+// RestoreEnvelope takes an envelope out of the trash.
+func (s *dobbyFinancier) RestoreEnvelope(ctx context.Context, id uuid.UUID) (*Envelope, error) {
+	if err := s.authz.Authorize(ctx, ActionBudget); err != nil {
+		return nil, err
+	}
+	deleted, err := s.repo.ListDeletedEnvelopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var restored *Envelope
+	for i := range deleted {
+		if deleted[i].ID == id {
+			restored = &deleted[i]
+		}
+	}
+	if restored == nil {
+		return nil, ErrNotFound
+	}
+
+	before := *restored
+	restored.DeletedAt = nil
+	restored.Version++
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.RestoreEnvelope(ctx, id); err != nil {
+			return err
+		}
+		return s.audit(ctx, AuditEnvelope, id, before, restored)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// PurgeTrash permanently deletes what has been in the trash for longer than retention, in every household.
+// It is run by the retention job rather than on behalf of a user, and reports how many items were purged.
+// Every purged item is recorded as deleted in the audit log of its household, without an actor.
+func (s *dobbyFinancier) PurgeTrash(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		return 0, fmt.Errorf("%w: retention must be positive", ErrValidation)
+	}
+	var purged []PurgedItem
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		if purged, err = s.repo.PurgeDeleted(ctx, time.Now().Add(-retention)); err != nil {
+			return err
+		}
+		for _, item := range purged {
+			if err := s.audit(WithHouseholdID(ctx, item.HouseholdID), item.Entity, item.ID, item.Item, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(purged)), nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTrashedLegs(t *testing.T) {
+	transfer := uuid.New()
+	single := Transaction{ID: uuid.New()}
+	out := Transaction{ID: uuid.New(), TransferID: &transfer}
+	in := Transaction{ID: uuid.New(), TransferID: &transfer}
+	deleted := []Transaction{single, out, in}
+
+	if got := trashedLegs(deleted, single.ID); len(got) != 1 || got[0].ID != single.ID {
+		t.Errorf("single transaction: got %v", got)
+	}
+	if got := trashedLegs(deleted, in.ID); len(got) != 2 || got[0].ID != out.ID || got[1].ID != in.ID {
+		t.Errorf("transfer leg: expected both legs, got %v", got)
+	}
+	if got := trashedLegs(deleted, uuid.New()); got != nil {
+		t.Errorf("unknown transaction: expected nothing, got %v", got)
+	}
+}
+
+func TestPurgeTrashRequiresRetention(t *testing.T) {
+	s := &dobbyFinancier{}
+	if _, err := s.PurgeTrash(context.Background(), 0); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected ErrValidation, got %v", err)
+	}
+}
+
+// purgeRepo purges the trash of the in-memory household as if it were one of many.
+type purgeRepo struct {
+	*memRepo
+	householdID uuid.UUID
+	auditedIn   []uuid.UUID // Household of each audit entry
+}
+
+func (r *purgeRepo) PurgeDeleted(ctx context.Context, before time.Time) ([]PurgedItem, error) {
+	var purged []PurgedItem
+	for id, t := range r.transactions {
+		if t.DeletedAt != nil && t.DeletedAt.Before(before) {
+			purged = append(purged, PurgedItem{HouseholdID: r.householdID, Entity: AuditTransaction, ID: id, Item: t})
+			delete(r.transactions, id)
+		}
+	}
+	return purged, nil
+}
+
+func (r *purgeRepo) SaveAuditEntry(ctx context.Context, e *AuditEntry) error {
+	householdID, _ := HouseholdIDFromContext(ctx)
+	r.auditedIn = append(r.auditedIn, householdID)
+	return r.memRepo.SaveAuditEntry(ctx, e)
+}
+
+func TestPurgeTrashAudits(t *testing.T) {
+	repo := &purgeRepo{memRepo: newMemRepo(), householdID: uuid.New()}
+	s := &dobbyFinancier{repo: repo, txManager: inlineTx{}}
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now()
+	for _, deletedAt := range []*time.Time{&old, &recent, nil} {
+		tx := Transaction{ID: uuid.New(), Amount: -100, DeletedAt: deletedAt}
+		repo.transactions[tx.ID] = tx
+	}
+
+	// The retention job runs without a user or household.
+	purged, err := s.PurgeTrash(context.Background(), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if purged != 1 || len(repo.transactions) != 2 {
+		t.Fatalf("expected the old transaction purged, got %d purged and %d left", purged, len(repo.transactions))
+	}
+	if len(repo.audit) != 1 || len(repo.auditedIn) != 1 {
+		t.Fatalf("expected one audit entry, got %d", len(repo.audit))
+	}
+	if e := repo.audit[0]; e.Action != AuditDelete || e.Entity != AuditTransaction || e.ActorID != nil || e.Before == nil {
+		t.Errorf("expected a delete without actor, got %+v", e)
+	}
+	if repo.auditedIn[0] != repo.householdID {
+		t.Errorf("expected the entry in household %v, got %v", repo.householdID, repo.auditedIn[0])
+	}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type userIDKey struct{}
+
+// WithUserID returns a context carrying the ID of the authenticated user.
+func WithUserID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, userIDKey{}, id)
+}
+
+// UserIDFromContext returns the ID of the authenticated user, if any.
+func UserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(userIDKey{}).(uuid.UUID)
+	return id, ok
+}
+
+// stampCreated attributes a new transaction to the authenticated user.
+func stampCreated(ctx context.Context, t *Transaction) {
+	if id, ok := UserIDFromContext(ctx); ok {
+		t.CreatedBy = &id
+		t.UpdatedBy = &id
+	}
+}
+
+// stampUpdated records the authenticated user as the last editor of a transaction.
+func stampUpdated(ctx context.Context, t *Transaction) {
+	if id, ok := UserIDFromContext(ctx); ok {
+		t.UpdatedBy = &id
+	}
+}
+
+func (s *dobbyFinancier) ListUsers(ctx context.Context) ([]User, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.ListUsers(ctx)
+}
+
+func (s *dobbyFinancier) GetUser(ctx context.Context, id uuid.UUID) (*User, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.GetUser(ctx, id)
+}
+
+// GetMemberSpending breaks down the spending of a period by the household member who recorded it.
+func (s *dobbyFinancier) GetMemberSpending(ctx context.Context, periodID uuid.UUID) ([]MemberSpending, error) {
+	if err := s.authz.Authorize(ctx, ActionRead); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetPeriod(ctx, periodID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetMemberStats(ctx, periodID)
+}
+
+// ProvisionUser creates the user signing in with u.Subject on first sight and keeps
+// their name and email in sync with the identity provider afterwards.
+// Empty claims never overwrite known values. New users start in a household of their own,
+// except the first one, who takes over the household holding the data from before tenancy.
+func (s *dobbyFinancier) ProvisionUser(ctx context.Context, u User) (*User, error) {
+	if u.Subject == "" {
+		return nil, fmt.Errorf("%w: subject is required", ErrValidation)
+	}
+
+	existing, err := s.repo.GetUserBySubject(ctx, u.Subject)
+	if errors.Is(err, ErrNotFound) {
+		u.ID = uuid.New()
+		if u.Name == "" {
+			u.Name = u.Email
+		}
+		if u.Name == "" {
+			u.Name = u.Subject
+		}
+		err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+			if err := s.repo.SaveUser(ctx, &u); err != nil {
+				return err
+			}
+			return s.createHousehold(ctx, &u)
+		})
+		if errors.Is(err, ErrConflict) {
+			// Provisioned concurrently by another request.
+			return s.repo.GetUserBySubject(ctx, u.Subject)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &u, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Users that lost their household, e.g. when created before tenancy, get a new one.
+	if _, err := s.repo.GetUserHouseholdID(ctx, existing.ID); errors.Is(err, ErrNotFound) {
+		if err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+			return s.createHousehold(ctx, existing)
+		}); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	updated := *existing
+	if u.Name != "" {
+		updated.Name = u.Name
+	}
+	if u.Email != "" {
+		updated.Email = u.Email
+	}
+	if updated == *existing {
+		return existing, nil
+	}
+	if err := s.repo.SaveUser(ctx, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
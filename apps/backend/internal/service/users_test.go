@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestStampTransaction(t *testing.T) {
+	author, editor := uuid.New(), uuid.New()
+
+	var tx Transaction
+	stampCreated(context.Background(), &tx)
+	if tx.CreatedBy != nil || tx.UpdatedBy != nil {
+		t.Fatalf("expected no attribution without an authenticated user, got %v/%v", tx.CreatedBy, tx.UpdatedBy)
+	}
+
+	stampCreated(WithUserID(context.Background(), author), &tx)
+	if tx.CreatedBy == nil || *tx.CreatedBy != author || tx.UpdatedBy == nil || *tx.UpdatedBy != author {
+		t.Fatalf("expected transaction to be attributed to its author, got %v/%v", tx.CreatedBy, tx.UpdatedBy)
+	}
+
+	stampUpdated(WithUserID(context.Background(), editor), &tx)
+	if *tx.CreatedBy != author || *tx.UpdatedBy != editor {
+		t.Errorf("expected author %v and editor %v, got %v/%v", author, editor, *tx.CreatedBy, *tx.UpdatedBy)
+	}
+}
+
+// provisioningRepo keeps users and memberships in memory, with one household left to claim.
+type provisioningRepo struct {
+	Repository
+	users      map[string]User
+	households []Household
+	members    map[uuid.UUID]uuid.UUID // user ID to household ID
+	claimable  uuid.UUID
+}
+
+func (r *provisioningRepo) GetUserBySubject(ctx context.Context, subject string) (*User, error) {
+	u, ok := r.users[subject]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &u, nil
+}
+
+func (r *provisioningRepo) SaveUser(ctx context.Context, u *User) error {
+	r.users[u.Subject] = *u
+	return nil
+}
+
+func (r *provisioningRepo) ClaimHousehold(ctx context.Context) (uuid.UUID, error) {
+	if r.claimable == uuid.Nil {
+		return uuid.Nil, ErrNotFound
+	}
+	id := r.claimable
+	r.claimable = uuid.Nil
+	return id, nil
+}
+
+func (r *provisioningRepo) SaveHousehold(ctx context.Context, h *Household) error {
+	r.households = append(r.households, *h)
+	return nil
+}
+
+func (r *provisioningRepo) SaveMember(ctx context.Context, householdID uuid.UUID, m *Member) error {
+	if m.Role != RoleOwner {
+		return errors.New("expected the first member of a household to own it")
+	}
+	r.members[m.User.ID] = householdID
+	return nil
+}
+
+func TestProvisionUserClaimsLegacyHousehold(t *testing.T) {
+	legacy := uuid.New()
+	repo := &provisioningRepo{users: map[string]User{}, members: map[uuid.UUID]uuid.UUID{}, claimable: legacy}
+	s := &dobbyFinancier{repo: repo, txManager: inlineTx{}}
+
+	first, err := s.ProvisionUser(context.Background(), User{Subject: "first", Name: "First"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.members[first.ID] != legacy || len(repo.households) != 0 {
+		t.Fatalf("expected the first user to join household %v, got %v and %d new households",
+			legacy, repo.members[first.ID], len(repo.households))
+	}
+
+	second, err := s.ProvisionUser(context.Background(), User{Subject: "second", Name: "Second"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.households) != 1 || repo.members[second.ID] != repo.households[0].ID {
+		t.Errorf("expected the second user to get a household of their own, got %v", repo.members[second.ID])
+	}
+}
@@ -0,0 +1,8 @@
+-- migrate:up
+ALTER TABLE transactions
+  ADD COLUMN IF NOT EXISTS transfer_id UUID;
+CREATE INDEX IF NOT EXISTS idx_transactions_transfer_id ON transactions(transfer_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_transactions_transfer_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_id;
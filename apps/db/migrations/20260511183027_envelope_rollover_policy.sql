@@ -0,0 +1,11 @@
+-- migrate:up
+ALTER TABLE envelopes
+  ADD COLUMN IF NOT EXISTS rollover_policy VARCHAR(32);
+UPDATE envelopes SET rollover_policy = 'reset' WHERE rollover_policy IS NULL;
+ALTER TABLE envelopes
+  ALTER COLUMN rollover_policy SET NOT NULL,
+  ADD CONSTRAINT chk_envelopes_rollover_policy CHECK (rollover_policy IN ('reset', 'carry_positive', 'carry_all'));
+
+-- migrate:down
+ALTER TABLE envelopes DROP CONSTRAINT IF EXISTS chk_envelopes_rollover_policy;
+ALTER TABLE envelopes DROP COLUMN IF EXISTS rollover_policy;
@@ -0,0 +1,22 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS recurring_transactions (
+    id UUID PRIMARY KEY,
+    envelope_id UUID NOT NULL,
+    category VARCHAR(255) NOT NULL,
+    amount BIGINT NOT NULL,
+    description TEXT NOT NULL,
+    schedule_kind VARCHAR(32) NOT NULL,
+    day_of_month SMALLINT,
+    interval_weeks SMALLINT,
+    anchor_date DATE,
+    CONSTRAINT fk_recurring_transactions_envelope FOREIGN KEY (envelope_id) REFERENCES envelopes(id),
+    CONSTRAINT chk_recurring_transactions_schedule CHECK (
+        (schedule_kind = 'monthly' AND day_of_month BETWEEN 1 AND 31)
+        OR (schedule_kind = 'weekly' AND interval_weeks >= 1 AND anchor_date IS NOT NULL)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_recurring_transactions_envelope_id ON recurring_transactions(envelope_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS recurring_transactions;
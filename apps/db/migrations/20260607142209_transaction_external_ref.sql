@@ -0,0 +1,8 @@
+-- migrate:up
+ALTER TABLE transactions
+  ADD COLUMN IF NOT EXISTS external_ref VARCHAR(255);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_external_ref ON transactions(external_ref);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_transactions_external_ref;
+ALTER TABLE transactions DROP COLUMN IF EXISTS external_ref;
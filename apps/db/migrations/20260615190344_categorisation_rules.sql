@@ -0,0 +1,21 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS rules (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    priority INTEGER NOT NULL,
+    description_pattern TEXT,
+    pattern_type VARCHAR(32) NOT NULL,
+    min_amount BIGINT,
+    max_amount BIGINT,
+    weekdays SMALLINT[] NOT NULL,
+    category VARCHAR(255),
+    envelope_id UUID,
+    CONSTRAINT fk_rules_envelope FOREIGN KEY (envelope_id) REFERENCES envelopes(id),
+    CONSTRAINT chk_rules_pattern_type CHECK (pattern_type IN ('substring', 'regex')),
+    CONSTRAINT chk_rules_action CHECK (category IS NOT NULL OR envelope_id IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rules_envelope_id ON rules(envelope_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS rules;
@@ -0,0 +1,16 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS transaction_splits (
+    transaction_id UUID NOT NULL,
+    line_no SMALLINT NOT NULL,
+    envelope_id UUID NOT NULL,
+    category VARCHAR(255) NOT NULL,
+    amount BIGINT NOT NULL,
+    PRIMARY KEY (transaction_id, line_no),
+    CONSTRAINT fk_transaction_splits_transaction FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_splits_envelope FOREIGN KEY (envelope_id) REFERENCES envelopes(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_envelope_id ON transaction_splits(envelope_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS transaction_splits;
@@ -0,0 +1,9 @@
+-- migrate:up
+CREATE INDEX IF NOT EXISTS idx_transactions_date_id ON transactions(date, id);
+CREATE INDEX IF NOT EXISTS idx_transactions_amount_id ON transactions(amount, id);
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_category ON transaction_splits(category);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_transaction_splits_category;
+DROP INDEX IF EXISTS idx_transactions_amount_id;
+DROP INDEX IF EXISTS idx_transactions_date_id;
@@ -0,0 +1,24 @@
+-- migrate:up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- search_fold lower-cases text and folds Serbian Cyrillic and Latin diacritics to plain ASCII,
+-- so that "Апотека", "apoteka" and "APOTEKA" match each other.
+-- Keep in sync with service.searchFold.
+CREATE OR REPLACE FUNCTION search_fold(input TEXT) RETURNS TEXT
+LANGUAGE SQL IMMUTABLE STRICT PARALLEL SAFE AS $$
+    SELECT translate(
+        replace(replace(replace(replace(replace(lower(input),
+            'љ', 'lj'), 'њ', 'nj'), 'џ', 'dz'), 'ђ', 'dj'), 'đ', 'dj'),
+        'абвгдежзијклмнопрстћуфхцчшčćšž',
+        'abvgdezzijklmnoprstcufhccsccsz')
+$$;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_description_fts ON transactions
+    USING GIN (to_tsvector('simple', search_fold(description)));
+CREATE INDEX IF NOT EXISTS idx_transactions_description_trgm ON transactions
+    USING GIN (search_fold(description) gin_trgm_ops);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_transactions_description_trgm;
+DROP INDEX IF EXISTS idx_transactions_description_fts;
+DROP FUNCTION IF EXISTS search_fold(TEXT);
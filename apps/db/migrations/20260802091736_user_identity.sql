@@ -0,0 +1,11 @@
+-- migrate:up
+ALTER TABLE users
+  ADD COLUMN IF NOT EXISTS subject VARCHAR(255),
+  ADD COLUMN IF NOT EXISTS email VARCHAR(255);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_subject ON users(subject);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_users_subject;
+ALTER TABLE users
+  DROP COLUMN IF EXISTS email,
+  DROP COLUMN IF EXISTS subject;
@@ -0,0 +1,16 @@
+-- migrate:up
+ALTER TABLE transactions
+  ADD COLUMN IF NOT EXISTS created_by UUID,
+  ADD COLUMN IF NOT EXISTS updated_by UUID;
+ALTER TABLE transactions
+  ADD CONSTRAINT fk_transactions_created_by FOREIGN KEY (created_by) REFERENCES users(id),
+  ADD CONSTRAINT fk_transactions_updated_by FOREIGN KEY (updated_by) REFERENCES users(id);
+CREATE INDEX IF NOT EXISTS idx_transactions_created_by ON transactions(created_by);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_transactions_created_by;
+ALTER TABLE transactions
+  DROP CONSTRAINT IF EXISTS fk_transactions_updated_by,
+  DROP CONSTRAINT IF EXISTS fk_transactions_created_by,
+  DROP COLUMN IF EXISTS updated_by,
+  DROP COLUMN IF EXISTS created_by;
@@ -0,0 +1,136 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS households (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL
+);
+
+-- A user belongs to exactly one household.
+CREATE TABLE IF NOT EXISTS household_members (
+    user_id UUID PRIMARY KEY,
+    household_id UUID NOT NULL,
+    CONSTRAINT fk_household_members_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_household_members_household FOREIGN KEY (household_id) REFERENCES households(id)
+);
+CREATE INDEX IF NOT EXISTS idx_household_members_household_id ON household_members(household_id);
+
+CREATE TABLE IF NOT EXISTS household_invitations (
+    id UUID PRIMARY KEY,
+    household_id UUID NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    created_by UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_by UUID,
+    accepted_at TIMESTAMPTZ,
+    CONSTRAINT fk_household_invitations_household FOREIGN KEY (household_id) REFERENCES households(id),
+    CONSTRAINT fk_household_invitations_created_by FOREIGN KEY (created_by) REFERENCES users(id),
+    CONSTRAINT fk_household_invitations_accepted_by FOREIGN KEY (accepted_by) REFERENCES users(id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_household_invitations_code_hash ON household_invitations(code_hash);
+
+-- Data created before tenancy existed becomes the first household.
+INSERT INTO households (id, name)
+SELECT '6f1c0d2e-8a4b-4e3f-9b7a-2c5d8e1f4a30', 'Household'
+WHERE EXISTS (SELECT 1 FROM users) OR EXISTS (SELECT 1 FROM envelopes) OR EXISTS (SELECT 1 FROM financial_periods)
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO household_members (user_id, household_id)
+SELECT id, '6f1c0d2e-8a4b-4e3f-9b7a-2c5d8e1f4a30' FROM users
+ON CONFLICT (user_id) DO NOTHING;
+
+ALTER TABLE financial_periods ADD COLUMN IF NOT EXISTS household_id UUID;
+ALTER TABLE envelopes ADD COLUMN IF NOT EXISTS household_id UUID;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS household_id UUID;
+ALTER TABLE transaction_splits ADD COLUMN IF NOT EXISTS household_id UUID;
+ALTER TABLE recurring_transactions ADD COLUMN IF NOT EXISTS household_id UUID;
+ALTER TABLE rules ADD COLUMN IF NOT EXISTS household_id UUID;
+
+UPDATE financial_periods SET household_id = '6f1c0d2e-8a4b-4e3f-9b7a-2c5d8e1f4a30' WHERE household_id IS NULL;
+UPDATE envelopes SET household_id = '6f1c0d2e-8a4b-4e3f-9b7a-2c5d8e1f4a30' WHERE household_id IS NULL;
+UPDATE transactions SET household_id = '6f1c0d2e-8a4b-4e3f-9b7a-2c5d8e1f4a30' WHERE household_id IS NULL;
+UPDATE transaction_splits SET household_id = '6f1c0d2e-8a4b-4e3f-9b7a-2c5d8e1f4a30' WHERE household_id IS NULL;
+UPDATE recurring_transactions SET household_id = '6f1c0d2e-8a4b-4e3f-9b7a-2c5d8e1f4a30' WHERE household_id IS NULL;
+UPDATE rules SET household_id = '6f1c0d2e-8a4b-4e3f-9b7a-2c5d8e1f4a30' WHERE household_id IS NULL;
+
+ALTER TABLE financial_periods ALTER COLUMN household_id SET NOT NULL,
+  ADD CONSTRAINT fk_financial_periods_household FOREIGN KEY (household_id) REFERENCES households(id),
+  ADD CONSTRAINT uq_financial_periods_household_id UNIQUE (household_id, id);
+ALTER TABLE envelopes ALTER COLUMN household_id SET NOT NULL,
+  ADD CONSTRAINT fk_envelopes_household FOREIGN KEY (household_id) REFERENCES households(id),
+  ADD CONSTRAINT uq_envelopes_household_id UNIQUE (household_id, id);
+ALTER TABLE transactions ALTER COLUMN household_id SET NOT NULL,
+  ADD CONSTRAINT uq_transactions_household_id UNIQUE (household_id, id);
+ALTER TABLE transaction_splits ALTER COLUMN household_id SET NOT NULL;
+ALTER TABLE recurring_transactions ALTER COLUMN household_id SET NOT NULL;
+ALTER TABLE rules ALTER COLUMN household_id SET NOT NULL;
+
+-- References between rows include the household, so they can never cross household boundaries.
+ALTER TABLE financial_periods
+  DROP CONSTRAINT IF EXISTS financial_periods_default_envelope_id_fkey,
+  ADD CONSTRAINT fk_financial_periods_default_envelope FOREIGN KEY (household_id, default_envelope_id)
+    REFERENCES envelopes(household_id, id) ON DELETE SET NULL (default_envelope_id);
+ALTER TABLE transactions
+  DROP CONSTRAINT IF EXISTS fk_transactions_period,
+  DROP CONSTRAINT IF EXISTS fk_transactions_envelope,
+  ADD CONSTRAINT fk_transactions_period FOREIGN KEY (household_id, financial_period_id) REFERENCES financial_periods(household_id, id),
+  ADD CONSTRAINT fk_transactions_envelope FOREIGN KEY (household_id, envelope_id) REFERENCES envelopes(household_id, id);
+ALTER TABLE transaction_splits
+  DROP CONSTRAINT IF EXISTS fk_transaction_splits_transaction,
+  DROP CONSTRAINT IF EXISTS fk_transaction_splits_envelope,
+  ADD CONSTRAINT fk_transaction_splits_transaction FOREIGN KEY (household_id, transaction_id)
+    REFERENCES transactions(household_id, id) ON DELETE CASCADE,
+  ADD CONSTRAINT fk_transaction_splits_envelope FOREIGN KEY (household_id, envelope_id) REFERENCES envelopes(household_id, id);
+ALTER TABLE recurring_transactions
+  DROP CONSTRAINT IF EXISTS fk_recurring_transactions_envelope,
+  ADD CONSTRAINT fk_recurring_transactions_envelope FOREIGN KEY (household_id, envelope_id) REFERENCES envelopes(household_id, id);
+ALTER TABLE rules
+  DROP CONSTRAINT IF EXISTS fk_rules_envelope,
+  ADD CONSTRAINT fk_rules_envelope FOREIGN KEY (household_id, envelope_id) REFERENCES envelopes(household_id, id);
+
+-- Bank references only need to be unique within a household.
+DROP INDEX IF EXISTS idx_transactions_external_ref;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_household_external_ref ON transactions(household_id, external_ref);
+
+CREATE INDEX IF NOT EXISTS idx_financial_periods_household_id ON financial_periods(household_id);
+CREATE INDEX IF NOT EXISTS idx_recurring_transactions_household_id ON recurring_transactions(household_id);
+CREATE INDEX IF NOT EXISTS idx_rules_household_id ON rules(household_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_rules_household_id;
+DROP INDEX IF EXISTS idx_recurring_transactions_household_id;
+DROP INDEX IF EXISTS idx_financial_periods_household_id;
+DROP INDEX IF EXISTS idx_transactions_household_external_ref;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_external_ref ON transactions(external_ref);
+
+ALTER TABLE rules
+  DROP CONSTRAINT IF EXISTS fk_rules_envelope,
+  ADD CONSTRAINT fk_rules_envelope FOREIGN KEY (envelope_id) REFERENCES envelopes(id);
+ALTER TABLE recurring_transactions
+  DROP CONSTRAINT IF EXISTS fk_recurring_transactions_envelope,
+  ADD CONSTRAINT fk_recurring_transactions_envelope FOREIGN KEY (envelope_id) REFERENCES envelopes(id);
+ALTER TABLE transaction_splits
+  DROP CONSTRAINT IF EXISTS fk_transaction_splits_envelope,
+  DROP CONSTRAINT IF EXISTS fk_transaction_splits_transaction,
+  ADD CONSTRAINT fk_transaction_splits_transaction FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+  ADD CONSTRAINT fk_transaction_splits_envelope FOREIGN KEY (envelope_id) REFERENCES envelopes(id);
+ALTER TABLE transactions
+  DROP CONSTRAINT IF EXISTS fk_transactions_envelope,
+  DROP CONSTRAINT IF EXISTS fk_transactions_period,
+  ADD CONSTRAINT fk_transactions_period FOREIGN KEY (financial_period_id) REFERENCES financial_periods(id),
+  ADD CONSTRAINT fk_transactions_envelope FOREIGN KEY (envelope_id) REFERENCES envelopes(id);
+ALTER TABLE financial_periods
+  DROP CONSTRAINT IF EXISTS fk_financial_periods_default_envelope,
+  ADD CONSTRAINT financial_periods_default_envelope_id_fkey FOREIGN KEY (default_envelope_id)
+    REFERENCES envelopes(id) ON DELETE SET NULL;
+
+ALTER TABLE rules DROP COLUMN IF EXISTS household_id;
+ALTER TABLE recurring_transactions DROP COLUMN IF EXISTS household_id;
+ALTER TABLE transaction_splits DROP COLUMN IF EXISTS household_id;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS uq_transactions_household_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS household_id;
+ALTER TABLE envelopes DROP CONSTRAINT IF EXISTS uq_envelopes_household_id;
+ALTER TABLE envelopes DROP COLUMN IF EXISTS household_id;
+ALTER TABLE financial_periods DROP CONSTRAINT IF EXISTS uq_financial_periods_household_id;
+ALTER TABLE financial_periods DROP COLUMN IF EXISTS household_id;
+
+DROP TABLE IF EXISTS household_invitations;
+DROP TABLE IF EXISTS household_members;
+DROP TABLE IF EXISTS households;
@@ -0,0 +1,32 @@
+-- migrate:up
+-- Members had full access before roles existed, so they all become owners.
+ALTER TABLE household_members ADD COLUMN IF NOT EXISTS role VARCHAR(32);
+UPDATE household_members SET role = 'owner' WHERE role IS NULL;
+ALTER TABLE household_members ALTER COLUMN role SET NOT NULL,
+  ADD CONSTRAINT chk_household_members_role CHECK (role IN ('owner', 'editor', 'contributor', 'viewer'));
+
+ALTER TABLE household_invitations ADD COLUMN IF NOT EXISTS role VARCHAR(32);
+UPDATE household_invitations SET role = 'editor' WHERE role IS NULL;
+ALTER TABLE household_invitations ALTER COLUMN role SET NOT NULL,
+  ADD CONSTRAINT chk_household_invitations_role CHECK (role IN ('owner', 'editor', 'contributor', 'viewer'));
+
+-- Envelopes a contributor may record transactions in.
+CREATE TABLE IF NOT EXISTS envelope_grants (
+    user_id UUID NOT NULL,
+    envelope_id UUID NOT NULL,
+    household_id UUID NOT NULL,
+    PRIMARY KEY (user_id, envelope_id),
+    CONSTRAINT fk_envelope_grants_member FOREIGN KEY (user_id) REFERENCES household_members(user_id) ON DELETE CASCADE,
+    CONSTRAINT fk_envelope_grants_envelope FOREIGN KEY (household_id, envelope_id)
+      REFERENCES envelopes(household_id, id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_envelope_grants_household_id ON envelope_grants(household_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS envelope_grants;
+ALTER TABLE household_invitations
+  DROP CONSTRAINT IF EXISTS chk_household_invitations_role,
+  DROP COLUMN IF EXISTS role;
+ALTER TABLE household_members
+  DROP CONSTRAINT IF EXISTS chk_household_members_role,
+  DROP COLUMN IF EXISTS role;
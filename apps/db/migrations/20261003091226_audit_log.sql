@@ -0,0 +1,22 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS audit_log (
+    id UUID PRIMARY KEY,
+    household_id UUID NOT NULL,
+    actor_id UUID,
+    at TIMESTAMPTZ NOT NULL,
+    entity VARCHAR(32) NOT NULL,
+    entity_id UUID NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    before JSONB,
+    after JSONB,
+    CONSTRAINT fk_audit_log_household FOREIGN KEY (household_id) REFERENCES households(id),
+    CONSTRAINT fk_audit_log_actor FOREIGN KEY (actor_id) REFERENCES users(id),
+    CONSTRAINT chk_audit_log_action CHECK (action IN ('create', 'update', 'delete'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_household_at ON audit_log(household_id, at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity_id ON audit_log(entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor_id ON audit_log(actor_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS audit_log;
@@ -0,0 +1,12 @@
+-- migrate:up
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE envelopes ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_deleted_at ON transactions(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_envelopes_deleted_at ON envelopes(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_envelopes_deleted_at;
+DROP INDEX IF EXISTS idx_transactions_deleted_at;
+ALTER TABLE envelopes DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE transactions DROP COLUMN IF EXISTS deleted_at;
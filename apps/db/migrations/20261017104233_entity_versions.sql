@@ -0,0 +1,25 @@
+-- migrate:up
+ALTER TABLE financial_periods ADD COLUMN IF NOT EXISTS version BIGINT;
+ALTER TABLE envelopes ADD COLUMN IF NOT EXISTS version BIGINT;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS version BIGINT;
+ALTER TABLE recurring_transactions ADD COLUMN IF NOT EXISTS version BIGINT;
+ALTER TABLE rules ADD COLUMN IF NOT EXISTS version BIGINT;
+
+UPDATE financial_periods SET version = 1 WHERE version IS NULL;
+UPDATE envelopes SET version = 1 WHERE version IS NULL;
+UPDATE transactions SET version = 1 WHERE version IS NULL;
+UPDATE recurring_transactions SET version = 1 WHERE version IS NULL;
+UPDATE rules SET version = 1 WHERE version IS NULL;
+
+ALTER TABLE financial_periods ALTER COLUMN version SET NOT NULL;
+ALTER TABLE envelopes ALTER COLUMN version SET NOT NULL;
+ALTER TABLE transactions ALTER COLUMN version SET NOT NULL;
+ALTER TABLE recurring_transactions ALTER COLUMN version SET NOT NULL;
+ALTER TABLE rules ALTER COLUMN version SET NOT NULL;
+
+-- migrate:down
+ALTER TABLE rules DROP COLUMN IF EXISTS version;
+ALTER TABLE recurring_transactions DROP COLUMN IF EXISTS version;
+ALTER TABLE transactions DROP COLUMN IF EXISTS version;
+ALTER TABLE envelopes DROP COLUMN IF EXISTS version;
+ALTER TABLE financial_periods DROP COLUMN IF EXISTS version;
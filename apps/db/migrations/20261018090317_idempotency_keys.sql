@@ -0,0 +1,16 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    household_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    operation VARCHAR(64) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    response JSONB,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (household_id, user_id, key),
+    CONSTRAINT fk_idempotency_keys_household FOREIGN KEY (household_id) REFERENCES households(id),
+    CONSTRAINT fk_idempotency_keys_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,42 @@
+-- migrate:up
+-- Periods are half-open, [start_dt, end_dt), so one may start exactly where the previous one ends.
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Periods saved before these constraints may break them. Which of them to fix is for the household
+-- to decide, so the migration stops with the offending periods listed instead of changing any.
+DO $$
+DECLARE
+    inverted TEXT;
+    overlapping TEXT;
+BEGIN
+    SELECT string_agg(format('%s [%s, %s)', id, start_dt, end_dt), E'\n' ORDER BY household_id, start_dt)
+    INTO inverted
+    FROM financial_periods
+    WHERE start_dt >= end_dt;
+
+    SELECT string_agg(format('%s [%s, %s) overlaps %s [%s, %s)', a.id, a.start_dt, a.end_dt, b.id, b.start_dt, b.end_dt),
+                      E'\n' ORDER BY a.household_id, a.start_dt)
+    INTO overlapping
+    FROM financial_periods a
+    JOIN financial_periods b ON b.household_id = a.household_id AND b.id > a.id
+    WHERE a.start_dt < b.end_dt AND b.start_dt < a.end_dt;
+
+    IF inverted IS NOT NULL OR overlapping IS NOT NULL THEN
+        RAISE EXCEPTION 'financial periods must be fixed before they can be constrained'
+            USING DETAIL = concat_ws(E'\n',
+                'Periods ending before they start:' || E'\n' || inverted,
+                'Overlapping periods:' || E'\n' || overlapping),
+                  HINT = 'Change the dates of, or delete, the listed periods and run the migration again.';
+    END IF;
+END
+$$;
+
+ALTER TABLE financial_periods
+    ADD CONSTRAINT chk_financial_periods_dates CHECK (start_dt < end_dt),
+    ADD CONSTRAINT excl_financial_periods_overlap
+        EXCLUDE USING gist (household_id WITH =, tstzrange(start_dt, end_dt, '[)') WITH &&);
+
+-- migrate:down
+ALTER TABLE financial_periods
+    DROP CONSTRAINT IF EXISTS excl_financial_periods_overlap,
+    DROP CONSTRAINT IF EXISTS chk_financial_periods_dates;
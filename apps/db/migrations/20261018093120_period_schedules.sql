@@ -0,0 +1,23 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS period_schedules (
+    household_id UUID PRIMARY KEY,
+    kind VARCHAR(32) NOT NULL,
+    day_of_month SMALLINT,
+    second_day_of_month SMALLINT,
+    interval_weeks SMALLINT,
+    anchor_date DATE,
+    adjustment VARCHAR(16) NOT NULL,
+    time_zone VARCHAR(64) NOT NULL,
+    version BIGINT NOT NULL,
+    CONSTRAINT fk_period_schedules_household FOREIGN KEY (household_id) REFERENCES households(id),
+    CONSTRAINT chk_period_schedules_kind CHECK (
+        (kind = 'monthly' AND day_of_month BETWEEN 1 AND 31)
+        OR kind = 'last_business_day'
+        OR (kind = 'weekly' AND interval_weeks >= 1 AND anchor_date IS NOT NULL)
+        OR (kind = 'semi_monthly' AND day_of_month >= 1 AND second_day_of_month > day_of_month AND second_day_of_month <= 31)
+    ),
+    CONSTRAINT chk_period_schedules_adjustment CHECK (adjustment IN ('none', 'preceding', 'following'))
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS period_schedules;
@@ -0,0 +1,15 @@
+-- migrate:up
+ALTER TABLE period_schedules ADD COLUMN IF NOT EXISTS holiday_country CHAR(2);
+
+CREATE TABLE IF NOT EXISTS holidays (
+    household_id UUID NOT NULL,
+    day DATE NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    yearly BOOLEAN NOT NULL,
+    PRIMARY KEY (household_id, day),
+    CONSTRAINT fk_holidays_household FOREIGN KEY (household_id) REFERENCES households(id)
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS holidays;
+ALTER TABLE period_schedules DROP COLUMN IF EXISTS holiday_country;
@@ -0,0 +1,11 @@
+-- migrate:up
+-- The household created for data from before tenancy has no members when nobody had signed in yet,
+-- which is every deployment that predates user provisioning. The first user to sign in claims it.
+ALTER TABLE households ADD COLUMN IF NOT EXISTS claimable BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE households h SET claimable = TRUE
+WHERE h.id = '6f1c0d2e-8a4b-4e3f-9b7a-2c5d8e1f4a30'
+  AND NOT EXISTS (SELECT 1 FROM household_members m WHERE m.household_id = h.id);
+
+-- migrate:down
+ALTER TABLE households DROP COLUMN IF EXISTS claimable;
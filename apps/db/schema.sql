@@ -15,17 +15,94 @@ SET xmloption = content;
 SET client_min_messages = warning;
 SET row_security = off;
 
+--
+-- Name: btree_gist; Type: EXTENSION; Schema: -; Owner: -
+--
+
+CREATE EXTENSION IF NOT EXISTS btree_gist WITH SCHEMA public;
+
+
+--
+-- Name: EXTENSION btree_gist; Type: COMMENT; Schema: -; Owner: -
+--
+
+COMMENT ON EXTENSION btree_gist IS 'support for indexing common datatypes in GiST';
+
+
+--
+-- Name: pg_trgm; Type: EXTENSION; Schema: -; Owner: -
+--
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public;
+
+
+--
+-- Name: EXTENSION pg_trgm; Type: COMMENT; Schema: -; Owner: -
+--
+
+COMMENT ON EXTENSION pg_trgm IS 'text similarity measurement and index searching based on trigrams';
+
+
+--
+-- Name: search_fold(text); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.search_fold(input text) RETURNS text
+    LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE
+    AS $$
+    SELECT translate(
+        replace(replace(replace(replace(replace(lower(input),
+            'љ', 'lj'), 'њ', 'nj'), 'џ', 'dz'), 'ђ', 'dj'), 'đ', 'dj'),
+        'абвгдежзијклмнопрстћуфхцчшčćšž',
+        'abvgdezzijklmnoprstcufhccsccsz')
+$$;
+
+
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+--
+-- Name: audit_log; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.audit_log (
+    id uuid NOT NULL,
+    household_id uuid NOT NULL,
+    actor_id uuid,
+    at timestamp with time zone NOT NULL,
+    entity character varying(32) NOT NULL,
+    entity_id uuid NOT NULL,
+    action character varying(16) NOT NULL,
+    before jsonb,
+    after jsonb,
+    CONSTRAINT chk_audit_log_action CHECK (((action)::text = ANY ((ARRAY['create'::character varying, 'update'::character varying, 'delete'::character varying])::text[])))
+);
+
+
+--
+-- Name: envelope_grants; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.envelope_grants (
+    user_id uuid NOT NULL,
+    envelope_id uuid NOT NULL,
+    household_id uuid NOT NULL
+);
+
+
 --
 -- Name: envelopes; Type: TABLE; Schema: public; Owner: -
 --
 
 CREATE TABLE public.envelopes (
     id uuid NOT NULL,
-    name character varying(255) NOT NULL
+    name character varying(255) NOT NULL,
+    rollover_policy character varying(32) NOT NULL,
+    household_id uuid NOT NULL,
+    deleted_at timestamp with time zone,
+    version bigint NOT NULL,
+    CONSTRAINT chk_envelopes_rollover_policy CHECK (((rollover_policy)::text = ANY ((ARRAY['reset'::character varying, 'carry_positive'::character varying, 'carry_all'::character varying])::text[])))
 );
 
 
@@ -37,7 +114,158 @@ CREATE TABLE public.financial_periods (
     id uuid NOT NULL,
     name character varying(255),
     start_dt timestamp with time zone NOT NULL,
-    end_dt timestamp with time zone NOT NULL
+    end_dt timestamp with time zone NOT NULL,
+    default_envelope_id uuid,
+    household_id uuid NOT NULL,
+    version bigint NOT NULL,
+    CONSTRAINT chk_financial_periods_dates CHECK ((start_dt < end_dt))
+);
+
+
+--
+-- Name: holidays; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.holidays (
+    household_id uuid NOT NULL,
+    day date NOT NULL,
+    name character varying(255) NOT NULL,
+    yearly boolean NOT NULL
+);
+
+
+--
+-- Name: household_invitations; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.household_invitations (
+    id uuid NOT NULL,
+    household_id uuid NOT NULL,
+    code_hash character varying(64) NOT NULL,
+    created_by uuid NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    accepted_by uuid,
+    accepted_at timestamp with time zone,
+    role character varying(32) NOT NULL,
+    CONSTRAINT chk_household_invitations_role CHECK (((role)::text = ANY ((ARRAY['owner'::character varying, 'editor'::character varying, 'contributor'::character varying, 'viewer'::character varying])::text[])))
+);
+
+
+--
+-- Name: household_members; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.household_members (
+    user_id uuid NOT NULL,
+    household_id uuid NOT NULL,
+    role character varying(32) NOT NULL,
+    CONSTRAINT chk_household_members_role CHECK (((role)::text = ANY ((ARRAY['owner'::character varying, 'editor'::character varying, 'contributor'::character varying, 'viewer'::character varying])::text[])))
+);
+
+
+--
+-- Name: households; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.households (
+    id uuid NOT NULL,
+    name character varying(255) NOT NULL,
+    claimable boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: idempotency_keys; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.idempotency_keys (
+    household_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    key character varying(255) NOT NULL,
+    operation character varying(64) NOT NULL,
+    request_hash character(64) NOT NULL,
+    response jsonb,
+    created_at timestamp with time zone NOT NULL
+);
+
+
+--
+-- Name: period_schedules; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.period_schedules (
+    household_id uuid NOT NULL,
+    kind character varying(32) NOT NULL,
+    day_of_month smallint,
+    second_day_of_month smallint,
+    interval_weeks smallint,
+    anchor_date date,
+    adjustment character varying(16) NOT NULL,
+    time_zone character varying(64) NOT NULL,
+    version bigint NOT NULL,
+    holiday_country character(2),
+    CONSTRAINT chk_period_schedules_adjustment CHECK (((adjustment)::text = ANY ((ARRAY['none'::character varying, 'preceding'::character varying, 'following'::character varying])::text[]))),
+    CONSTRAINT chk_period_schedules_kind CHECK (((((kind)::text = 'monthly'::text) AND ((day_of_month >= 1) AND (day_of_month <= 31))) OR ((kind)::text = 'last_business_day'::text) OR (((kind)::text = 'weekly'::text) AND (interval_weeks >= 1) AND (anchor_date IS NOT NULL)) OR (((kind)::text = 'semi_monthly'::text) AND (day_of_month >= 1) AND (second_day_of_month > day_of_month) AND (second_day_of_month <= 31))))
+);
+
+
+--
+-- Name: personal_access_tokens; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.personal_access_tokens (
+    id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    name character varying(255) NOT NULL,
+    token_hash character varying(64) NOT NULL,
+    scope character varying(32) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    last_used_at timestamp with time zone,
+    revoked_at timestamp with time zone,
+    CONSTRAINT chk_personal_access_tokens_scope CHECK (((scope)::text = ANY ((ARRAY['read'::character varying, 'write'::character varying])::text[])))
+);
+
+
+--
+-- Name: recurring_transactions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.recurring_transactions (
+    id uuid NOT NULL,
+    envelope_id uuid NOT NULL,
+    category character varying(255) NOT NULL,
+    amount bigint NOT NULL,
+    description text NOT NULL,
+    schedule_kind character varying(32) NOT NULL,
+    day_of_month smallint,
+    interval_weeks smallint,
+    anchor_date date,
+    household_id uuid NOT NULL,
+    version bigint NOT NULL,
+    CONSTRAINT chk_recurring_transactions_schedule CHECK (((((schedule_kind)::text = 'monthly'::text) AND ((day_of_month >= 1) AND (day_of_month <= 31))) OR (((schedule_kind)::text = 'weekly'::text) AND (interval_weeks >= 1) AND (anchor_date IS NOT NULL))))
+);
+
+
+--
+-- Name: rules; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.rules (
+    id uuid NOT NULL,
+    name character varying(255) NOT NULL,
+    priority integer NOT NULL,
+    description_pattern text,
+    pattern_type character varying(32) NOT NULL,
+    min_amount bigint,
+    max_amount bigint,
+    weekdays smallint[] NOT NULL,
+    category character varying(255),
+    envelope_id uuid,
+    household_id uuid NOT NULL,
+    version bigint NOT NULL,
+    CONSTRAINT chk_rules_action CHECK (((category IS NOT NULL) OR (envelope_id IS NOT NULL))),
+    CONSTRAINT chk_rules_pattern_type CHECK (((pattern_type)::text = ANY ((ARRAY['substring'::character varying, 'regex'::character varying])::text[])))
 );
 
 
@@ -50,6 +278,20 @@ CREATE TABLE public.schema_migrations (
 );
 
 
+--
+-- Name: transaction_splits; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.transaction_splits (
+    transaction_id uuid NOT NULL,
+    line_no smallint NOT NULL,
+    envelope_id uuid NOT NULL,
+    category character varying(255) NOT NULL,
+    amount bigint NOT NULL,
+    household_id uuid NOT NULL
+);
+
+
 --
 -- Name: transactions; Type: TABLE; Schema: public; Owner: -
 --
@@ -61,7 +303,14 @@ CREATE TABLE public.transactions (
     category character varying(255) NOT NULL,
     amount bigint NOT NULL,
     description text NOT NULL,
-    date timestamp with time zone NOT NULL
+    date timestamp with time zone NOT NULL,
+    transfer_id uuid,
+    external_ref character varying(255),
+    created_by uuid,
+    updated_by uuid,
+    household_id uuid NOT NULL,
+    deleted_at timestamp with time zone,
+    version bigint NOT NULL
 );
 
 
@@ -71,10 +320,28 @@ CREATE TABLE public.transactions (
 
 CREATE TABLE public.users (
     id uuid NOT NULL,
-    name character varying(255) NOT NULL
+    name character varying(255) NOT NULL,
+    subject character varying(255),
+    email character varying(255)
 );
 
 
+--
+-- Name: audit_log audit_log_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_log
+    ADD CONSTRAINT audit_log_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: envelope_grants envelope_grants_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.envelope_grants
+    ADD CONSTRAINT envelope_grants_pkey PRIMARY KEY (user_id, envelope_id);
+
+
 --
 -- Name: envelopes envelopes_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -83,6 +350,22 @@ ALTER TABLE ONLY public.envelopes
     ADD CONSTRAINT envelopes_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: envelopes uq_envelopes_household_id; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.envelopes
+    ADD CONSTRAINT uq_envelopes_household_id UNIQUE (household_id, id);
+
+
+--
+-- Name: financial_periods excl_financial_periods_overlap; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.financial_periods
+    ADD CONSTRAINT excl_financial_periods_overlap EXCLUDE USING gist (household_id WITH =, tstzrange(start_dt, end_dt, '[)'::text) WITH &&);
+
+
 --
 -- Name: financial_periods financial_periods_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -91,6 +374,86 @@ ALTER TABLE ONLY public.financial_periods
     ADD CONSTRAINT financial_periods_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: financial_periods uq_financial_periods_household_id; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.financial_periods
+    ADD CONSTRAINT uq_financial_periods_household_id UNIQUE (household_id, id);
+
+
+--
+-- Name: holidays holidays_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.holidays
+    ADD CONSTRAINT holidays_pkey PRIMARY KEY (household_id, day);
+
+
+--
+-- Name: household_invitations household_invitations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.household_invitations
+    ADD CONSTRAINT household_invitations_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: household_members household_members_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.household_members
+    ADD CONSTRAINT household_members_pkey PRIMARY KEY (user_id);
+
+
+--
+-- Name: households households_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.households
+    ADD CONSTRAINT households_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: idempotency_keys idempotency_keys_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.idempotency_keys
+    ADD CONSTRAINT idempotency_keys_pkey PRIMARY KEY (household_id, user_id, key);
+
+
+--
+-- Name: period_schedules period_schedules_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.period_schedules
+    ADD CONSTRAINT period_schedules_pkey PRIMARY KEY (household_id);
+
+
+--
+-- Name: personal_access_tokens personal_access_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.personal_access_tokens
+    ADD CONSTRAINT personal_access_tokens_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: recurring_transactions recurring_transactions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.recurring_transactions
+    ADD CONSTRAINT recurring_transactions_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: rules rules_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.rules
+    ADD CONSTRAINT rules_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: schema_migrations schema_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -99,6 +462,14 @@ ALTER TABLE ONLY public.schema_migrations
     ADD CONSTRAINT schema_migrations_pkey PRIMARY KEY (version);
 
 
+--
+-- Name: transaction_splits transaction_splits_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.transaction_splits
+    ADD CONSTRAINT transaction_splits_pkey PRIMARY KEY (transaction_id, line_no);
+
+
 --
 -- Name: transactions transactions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -107,6 +478,14 @@ ALTER TABLE ONLY public.transactions
     ADD CONSTRAINT transactions_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: transactions uq_transactions_household_id; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.transactions
+    ADD CONSTRAINT uq_transactions_household_id UNIQUE (household_id, id);
+
+
 --
 -- Name: users users_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -115,6 +494,160 @@ ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: idx_audit_log_actor_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_log_actor_id ON public.audit_log USING btree (actor_id);
+
+
+--
+-- Name: idx_audit_log_entity_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_log_entity_id ON public.audit_log USING btree (entity_id);
+
+
+--
+-- Name: idx_audit_log_household_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_log_household_at ON public.audit_log USING btree (household_id, at DESC);
+
+
+--
+-- Name: idx_envelope_grants_household_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_envelope_grants_household_id ON public.envelope_grants USING btree (household_id);
+
+
+--
+-- Name: idx_envelopes_deleted_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_envelopes_deleted_at ON public.envelopes USING btree (deleted_at) WHERE (deleted_at IS NOT NULL);
+
+
+--
+-- Name: idx_financial_periods_household_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_financial_periods_household_id ON public.financial_periods USING btree (household_id);
+
+
+--
+-- Name: idx_household_invitations_code_hash; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_household_invitations_code_hash ON public.household_invitations USING btree (code_hash);
+
+
+--
+-- Name: idx_household_members_household_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_household_members_household_id ON public.household_members USING btree (household_id);
+
+
+--
+-- Name: idx_personal_access_tokens_token_hash; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_personal_access_tokens_token_hash ON public.personal_access_tokens USING btree (token_hash);
+
+
+--
+-- Name: idx_personal_access_tokens_user_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_personal_access_tokens_user_id ON public.personal_access_tokens USING btree (user_id);
+
+
+--
+-- Name: idx_recurring_transactions_envelope_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_recurring_transactions_envelope_id ON public.recurring_transactions USING btree (envelope_id);
+
+
+--
+-- Name: idx_recurring_transactions_household_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_recurring_transactions_household_id ON public.recurring_transactions USING btree (household_id);
+
+
+--
+-- Name: idx_rules_envelope_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rules_envelope_id ON public.rules USING btree (envelope_id);
+
+
+--
+-- Name: idx_rules_household_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rules_household_id ON public.rules USING btree (household_id);
+
+
+--
+-- Name: idx_transaction_splits_category; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_transaction_splits_category ON public.transaction_splits USING btree (category);
+
+
+--
+-- Name: idx_transaction_splits_envelope_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_transaction_splits_envelope_id ON public.transaction_splits USING btree (envelope_id);
+
+
+--
+-- Name: idx_transactions_amount_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_transactions_amount_id ON public.transactions USING btree (amount, id);
+
+
+--
+-- Name: idx_transactions_created_by; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_transactions_created_by ON public.transactions USING btree (created_by);
+
+
+--
+-- Name: idx_transactions_date_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_transactions_date_id ON public.transactions USING btree (date, id);
+
+
+--
+-- Name: idx_transactions_deleted_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_transactions_deleted_at ON public.transactions USING btree (deleted_at) WHERE (deleted_at IS NOT NULL);
+
+
+--
+-- Name: idx_transactions_description_fts; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_transactions_description_fts ON public.transactions USING gin (to_tsvector('simple'::regconfig, public.search_fold(description)));
+
+
+--
+-- Name: idx_transactions_description_trgm; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_transactions_description_trgm ON public.transactions USING gin (public.search_fold(description) public.gin_trgm_ops);
+
+
 --
 -- Name: idx_transactions_envelope_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -122,6 +655,13 @@ ALTER TABLE ONLY public.users
 CREATE INDEX idx_transactions_envelope_id ON public.transactions USING btree (envelope_id);
 
 
+--
+-- Name: idx_transactions_household_external_ref; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_transactions_household_external_ref ON public.transactions USING btree (household_id, external_ref);
+
+
 --
 -- Name: idx_transactions_period_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -129,12 +669,202 @@ CREATE INDEX idx_transactions_envelope_id ON public.transactions USING btree (en
 CREATE INDEX idx_transactions_period_id ON public.transactions USING btree (financial_period_id);
 
 
+--
+-- Name: idx_transactions_transfer_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_transactions_transfer_id ON public.transactions USING btree (transfer_id);
+
+
+--
+-- Name: idx_users_subject; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_users_subject ON public.users USING btree (subject);
+
+
+--
+-- Name: audit_log fk_audit_log_actor; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_log
+    ADD CONSTRAINT fk_audit_log_actor FOREIGN KEY (actor_id) REFERENCES public.users(id);
+
+
+--
+-- Name: audit_log fk_audit_log_household; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_log
+    ADD CONSTRAINT fk_audit_log_household FOREIGN KEY (household_id) REFERENCES public.households(id);
+
+
+--
+-- Name: envelope_grants fk_envelope_grants_envelope; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.envelope_grants
+    ADD CONSTRAINT fk_envelope_grants_envelope FOREIGN KEY (household_id, envelope_id) REFERENCES public.envelopes(household_id, id) ON DELETE CASCADE;
+
+
+--
+-- Name: envelope_grants fk_envelope_grants_member; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.envelope_grants
+    ADD CONSTRAINT fk_envelope_grants_member FOREIGN KEY (user_id) REFERENCES public.household_members(user_id) ON DELETE CASCADE;
+
+
+--
+-- Name: envelopes fk_envelopes_household; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.envelopes
+    ADD CONSTRAINT fk_envelopes_household FOREIGN KEY (household_id) REFERENCES public.households(id);
+
+
+--
+-- Name: financial_periods fk_financial_periods_default_envelope; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.financial_periods
+    ADD CONSTRAINT fk_financial_periods_default_envelope FOREIGN KEY (household_id, default_envelope_id) REFERENCES public.envelopes(household_id, id) ON DELETE SET NULL (default_envelope_id);
+
+
+--
+-- Name: financial_periods fk_financial_periods_household; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.financial_periods
+    ADD CONSTRAINT fk_financial_periods_household FOREIGN KEY (household_id) REFERENCES public.households(id);
+
+
+--
+-- Name: holidays fk_holidays_household; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.holidays
+    ADD CONSTRAINT fk_holidays_household FOREIGN KEY (household_id) REFERENCES public.households(id);
+
+
+--
+-- Name: household_invitations fk_household_invitations_accepted_by; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.household_invitations
+    ADD CONSTRAINT fk_household_invitations_accepted_by FOREIGN KEY (accepted_by) REFERENCES public.users(id);
+
+
+--
+-- Name: household_invitations fk_household_invitations_created_by; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.household_invitations
+    ADD CONSTRAINT fk_household_invitations_created_by FOREIGN KEY (created_by) REFERENCES public.users(id);
+
+
+--
+-- Name: household_invitations fk_household_invitations_household; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.household_invitations
+    ADD CONSTRAINT fk_household_invitations_household FOREIGN KEY (household_id) REFERENCES public.households(id);
+
+
+--
+-- Name: household_members fk_household_members_household; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.household_members
+    ADD CONSTRAINT fk_household_members_household FOREIGN KEY (household_id) REFERENCES public.households(id);
+
+
+--
+-- Name: household_members fk_household_members_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.household_members
+    ADD CONSTRAINT fk_household_members_user FOREIGN KEY (user_id) REFERENCES public.users(id);
+
+
+--
+-- Name: idempotency_keys fk_idempotency_keys_household; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.idempotency_keys
+    ADD CONSTRAINT fk_idempotency_keys_household FOREIGN KEY (household_id) REFERENCES public.households(id);
+
+
+--
+-- Name: idempotency_keys fk_idempotency_keys_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.idempotency_keys
+    ADD CONSTRAINT fk_idempotency_keys_user FOREIGN KEY (user_id) REFERENCES public.users(id);
+
+
+--
+-- Name: period_schedules fk_period_schedules_household; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.period_schedules
+    ADD CONSTRAINT fk_period_schedules_household FOREIGN KEY (household_id) REFERENCES public.households(id);
+
+
+--
+-- Name: personal_access_tokens fk_personal_access_tokens_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.personal_access_tokens
+    ADD CONSTRAINT fk_personal_access_tokens_user FOREIGN KEY (user_id) REFERENCES public.users(id);
+
+
+--
+-- Name: recurring_transactions fk_recurring_transactions_envelope; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.recurring_transactions
+    ADD CONSTRAINT fk_recurring_transactions_envelope FOREIGN KEY (household_id, envelope_id) REFERENCES public.envelopes(household_id, id);
+
+
+--
+-- Name: rules fk_rules_envelope; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.rules
+    ADD CONSTRAINT fk_rules_envelope FOREIGN KEY (household_id, envelope_id) REFERENCES public.envelopes(household_id, id);
+
+
+--
+-- Name: transaction_splits fk_transaction_splits_envelope; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.transaction_splits
+    ADD CONSTRAINT fk_transaction_splits_envelope FOREIGN KEY (household_id, envelope_id) REFERENCES public.envelopes(household_id, id);
+
+
+--
+-- Name: transaction_splits fk_transaction_splits_transaction; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.transaction_splits
+    ADD CONSTRAINT fk_transaction_splits_transaction FOREIGN KEY (household_id, transaction_id) REFERENCES public.transactions(household_id, id) ON DELETE CASCADE;
+
+
+--
+-- Name: transactions fk_transactions_created_by; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.transactions
+    ADD CONSTRAINT fk_transactions_created_by FOREIGN KEY (created_by) REFERENCES public.users(id);
+
+
 --
 -- Name: transactions fk_transactions_envelope; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
 ALTER TABLE ONLY public.transactions
-    ADD CONSTRAINT fk_transactions_envelope FOREIGN KEY (envelope_id) REFERENCES public.envelopes(id);
+    ADD CONSTRAINT fk_transactions_envelope FOREIGN KEY (household_id, envelope_id) REFERENCES public.envelopes(household_id, id);
 
 
 --
@@ -142,7 +872,15 @@ ALTER TABLE ONLY public.transactions
 --
 
 ALTER TABLE ONLY public.transactions
-    ADD CONSTRAINT fk_transactions_period FOREIGN KEY (financial_period_id) REFERENCES public.financial_periods(id);
+    ADD CONSTRAINT fk_transactions_period FOREIGN KEY (household_id, financial_period_id) REFERENCES public.financial_periods(household_id, id);
+
+
+--
+-- Name: transactions fk_transactions_updated_by; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.transactions
+    ADD CONSTRAINT fk_transactions_updated_by FOREIGN KEY (updated_by) REFERENCES public.users(id);
 
 
 --
@@ -158,4 +896,26 @@ ALTER TABLE ONLY public.transactions
 
 INSERT INTO public.schema_migrations (version) VALUES
     ('20260217174202'),
-    ('20260217174257');
+    ('20260217174257'),
+    ('20260426115343'),
+    ('20260503094512'),
+    ('20260511183027'),
+    ('20260524101538'),
+    ('20260607142209'),
+    ('20260615190344'),
+    ('20260629161847'),
+    ('20260708113052'),
+    ('20260719170425'),
+    ('20260802091736'),
+    ('20260814153021'),
+    ('20260829104512'),
+    ('20260911083514'),
+    ('20260924162047'),
+    ('20261003091226'),
+    ('20261010143805'),
+    ('20261017104233'),
+    ('20261018090317'),
+    ('20261018091542'),
+    ('20261018093120'),
+    ('20261018101504'),
+    ('20261018103012');
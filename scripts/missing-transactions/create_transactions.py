@@ -4,7 +4,8 @@ import os
 
 # Configuration
 API_HOST = "api.dobby.chaianpar.dev"
-API_TOKEN = "paste-actual-token"
+# Personal access token with write scope, create one with POST /me/tokens
+API_TOKEN = os.environ.get("DOBBY_API_TOKEN", "paste-actual-token")
 ENVELOPE_ID = "2aeccd10-20f8-484f-80ab-fe920666e1da"
 INPUT_FILE = "scripts/missing-transactions/missing_transactions.json"
 